@@ -21,10 +21,14 @@ import (
 )
 
 var (
-	chatSpecFile   string
-	chatTitle      string
-	chatModel      string
-	chatStatus     string
+	chatSpecFile     string
+	chatTitle        string
+	chatModel        string
+	chatStatus       string
+	chatExportFormat string
+	chatForkAtTurn   string
+	chatForkOutput   string
+	chatRunOnce      bool
 )
 
 func GetChatCommand() *cobra.Command {
@@ -59,12 +63,52 @@ and executes them sequentially to generate the next LLM response.
 You can optionally specify chat titles to run only specific chats:
   flow chat run                     # Run all pending chats
   flow chat run testing-situation   # Run only the chat titled "testing-situation"
-  flow chat run chat1 chat2         # Run multiple specific chats`,
+  flow chat run chat1 chat2         # Run multiple specific chats
+
+With --once, exactly one turn of a single chat is run without any interactive
+prompts, ignoring max_turns, and the command fails if no chat or more than one
+chat is runnable. Use it to script a chat step:
+  flow chat run --once path/to/chat.md`,
 		RunE: runChatRun,
 	}
+	chatRunCmd.Flags().BoolVar(&chatRunOnce, "once", false, "Run exactly one turn of a single chat non-interactively, then exit")
+
+	chatExportCmd := &cobra.Command{
+		Use:   "export <jobfile>",
+		Short: "Export a chat job as a clean transcript",
+		Long: `Converts a chat job into a transcript of alternating user and assistant turns,
+with the grove control comments removed. Assistant turns include their timestamp
+and the model used. Code blocks are kept intact.
+
+Examples:
+  flow chat export my-chat.md > transcript.md
+  flow chat export my-chat.md --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: runChatExport,
+	}
+	chatExportCmd.Flags().StringVar(&chatExportFormat, "format", "markdown", "Output format: markdown or json")
+
+	chatForkCmd := &cobra.Command{
+		Use:   "fork <jobfile>",
+		Short: "Copy a chat up to an earlier turn into a new chat job",
+		Long: `Creates a new chat job containing the conversation up to and including the LLM
+response with the given turn ID (from its <!-- grove: {"id": ...} --> marker),
+so you can continue from that point. The original chat is left untouched.
+
+Examples:
+  flow chat fork my-chat.md --at-turn 595424
+  flow chat fork my-chat.md --at-turn 595424 -o my-chat-retry.md`,
+		Args: cobra.ExactArgs(1),
+		RunE: runChatFork,
+	}
+	chatForkCmd.Flags().StringVar(&chatForkAtTurn, "at-turn", "", "ID of the last LLM turn to keep (required)")
+	chatForkCmd.Flags().StringVarP(&chatForkOutput, "output", "o", "", "Path for the new chat file (default: <name>-fork-<turn>.md next to the original)")
+	chatForkCmd.MarkFlagRequired("at-turn")
 
 	chatCmd.AddCommand(chatListCmd)
 	chatCmd.AddCommand(chatRunCmd)
+	chatCmd.AddCommand(chatExportCmd)
+	chatCmd.AddCommand(chatForkCmd)
 	return chatCmd
 }
 
@@ -296,9 +340,93 @@ func runChatList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runChatExport(cmd *cobra.Command, args []string) error {
+	filePath, err := expandChatPath(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve chat path: %w", err)
+	}
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read chat file: %w", err)
+	}
+	job, err := orchestration.LoadJob(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to load chat job: %w", err)
+	}
+
+	transcript, err := orchestration.BuildChatTranscript(job, content)
+	if err != nil {
+		return err
+	}
+
+	format := chatExportFormat
+	if cli.GetOptions(cmd).JSONOutput {
+		format = "json"
+	}
+	switch format {
+	case "markdown", "md":
+		fmt.Print(transcript.Markdown())
+		return nil
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(transcript)
+	default:
+		return fmt.Errorf("unsupported format '%s' (use markdown or json)", format)
+	}
+}
+
+func runChatFork(cmd *cobra.Command, args []string) error {
+	filePath, err := expandChatPath(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve chat path: %w", err)
+	}
+	job, err := orchestration.LoadJob(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to load chat job: %w", err)
+	}
+	if job.Type != orchestration.JobTypeChat {
+		return fmt.Errorf("%s is a %s job, not a chat", filePath, job.Type)
+	}
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read chat file: %w", err)
+	}
+
+	forked, err := orchestration.TruncateChatAtTurn(content, chatForkAtTurn)
+	if err != nil {
+		return err
+	}
+	forked, err = orchestration.UpdateFrontmatter(forked, map[string]interface{}{
+		"id":         generateJobID(),
+		"title":      job.Title + " (fork)",
+		"status":     string(orchestration.JobStatusPendingUser),
+		"updated_at": time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update forked frontmatter: %w", err)
+	}
+
+	outputPath := chatForkOutput
+	if outputPath == "" {
+		ext := filepath.Ext(filePath)
+		outputPath = fmt.Sprintf("%s-fork-%s%s", strings.TrimSuffix(filePath, ext), chatForkAtTurn, ext)
+	}
+	if _, err := os.Stat(outputPath); err == nil {
+		return fmt.Errorf("output file already exists: %s", outputPath)
+	}
+	if err := os.WriteFile(outputPath, forked, 0644); err != nil {
+		return fmt.Errorf("failed to write forked chat: %w", err)
+	}
+
+	fmt.Printf("* Forked chat at turn %s: %s\n", chatForkAtTurn, outputPath)
+	return nil
+}
+
 func runChatRun(cmd *cobra.Command, args []string) error {
 	// Emit deprecation warning
 	fmt.Fprintf(os.Stderr, "%s  'flow chat run' is deprecated. Use 'flow run <file-or-title>' instead.\n", theme.IconWarning)
+	defer orchestration.RemoveFetchedSources()
 
 	var runnableChats []*orchestration.Job // Store job objects
 	var titleFilter map[string]bool
@@ -425,6 +553,17 @@ func runChatRun(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if chatRunOnce && len(runnableChats) == 0 {
+		return fmt.Errorf("--once: no runnable chat found")
+	}
+	if chatRunOnce && len(runnableChats) > 1 {
+		var titles []string
+		for _, job := range runnableChats {
+			titles = append(titles, job.Title)
+		}
+		return fmt.Errorf("--once runs a single chat but %d are runnable (%s); pass its title or file", len(runnableChats), strings.Join(titles, ", "))
+	}
+
 	if len(runnableChats) == 0 {
 		if titleFilter != nil {
 			// User specified titles but none were found
@@ -463,6 +602,10 @@ func runChatRun(cmd *cobra.Command, args []string) error {
 		ModelOverride:       "", // Use job's model
 		MaxConsecutiveSteps: 20,
 	}
+	if chatRunOnce {
+		orchConfig.SkipInteractive = true
+		orchConfig.MaxTurns = 1
+	}
 
 
 	var executionErrors []error
@@ -483,24 +626,14 @@ func runChatRun(cmd *cobra.Command, args []string) error {
 				}
 			}
 			plan = fullPlan
-			plan.Orchestration = &orchestration.Config{
-				OneshotModel:         flowCfg.OneshotModel,
-				TargetAgentContainer: flowCfg.TargetAgentContainer,
-				PlansDirectory:       flowCfg.PlansDirectory,
-				MaxConsecutiveSteps:  flowCfg.MaxConsecutiveSteps,
-			}
+			plan.Orchestration = flowCfg.orchestrationConfig()
 		} else {
 			// Fallback: Create a minimal plan for this chat job
 			// Dependencies won't be resolved in this case
 			plan = &orchestration.Plan{
 				Directory: planDir,
 				Jobs:      []*orchestration.Job{job},
-				Orchestration: &orchestration.Config{
-					OneshotModel:         flowCfg.OneshotModel,
-					TargetAgentContainer: flowCfg.TargetAgentContainer,
-					PlansDirectory:       flowCfg.PlansDirectory,
-					MaxConsecutiveSteps:  flowCfg.MaxConsecutiveSteps,
-				},
+				Orchestration: flowCfg.orchestrationConfig(),
 			}
 		}
 
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+// listPlanNames returns the names of the plans in the current workspace's
+// plans directory.
+func listPlanNames() []string {
+	plansDir, err := resolvePlansDir()
+	if err != nil {
+		return nil
+	}
+	entries, err := os.ReadDir(plansDir)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		planPath := filepath.Join(plansDir, entry.Name())
+		mdFiles, _ := filepath.Glob(filepath.Join(planPath, "*.md"))
+		if _, err := os.Stat(filepath.Join(planPath, ".grove-plan.yml")); err == nil || len(mdFiles) > 0 {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
+}
+
+// jobCompletions returns the IDs of the jobs in the plan in dir (or the active
+// plan if dir is empty), described by their titles. With filenames set, the
+// job filenames are suggested as well. Unlike resolvePlanPathWithActiveJob, it
+// never falls back to creating the rolling plan.
+func jobCompletions(dir string, filenames bool) []string {
+	if dir == "" {
+		activePlan, err := getActivePlanWithMigration()
+		if err != nil || activePlan == "" {
+			return nil
+		}
+		dir = activePlan
+	}
+	planPath, err := resolvePlanPath(dir)
+	if err != nil {
+		return nil
+	}
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return nil
+	}
+
+	var completions []string
+	for _, job := range plan.GetJobsSortedByFilename() {
+		completions = append(completions, job.ID+"\t"+job.Title)
+		if filenames {
+			completions = append(completions, job.Filename+"\t"+job.Title)
+		}
+	}
+	return completions
+}
+
+// completePlanArg completes a command's first argument with plan names.
+func completePlanArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return listPlanNames(), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeJobThenPlanArgs completes `<job> [directory]` arguments: job IDs
+// from the active plan, then plan names.
+func completeJobThenPlanArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return jobCompletions("", true), cobra.ShellCompDirectiveNoFileComp
+	case 1:
+		return listPlanNames(), cobra.ShellCompDirectiveNoFileComp
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// jobFileCompletions returns the job filenames of the plan in dir (or the
+// active plan if dir is empty), described by their titles.
+func jobFileCompletions(dir string) []string {
+	var completions []string
+	for _, c := range jobCompletions(dir, true) {
+		if name, _, _ := strings.Cut(c, "\t"); strings.HasSuffix(name, ".md") {
+			completions = append(completions, c)
+		}
+	}
+	return completions
+}
+
+// completeRunArgs completes `run` arguments with the active plan's job files,
+// falling back to file paths.
+func completeRunArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return jobFileCompletions(""), cobra.ShellCompDirectiveDefault
+}
+
+// completeAddJobFlag completes job-targeting flags of `add [directory]` with
+// job IDs from the plan given as the argument, or the active plan.
+func completeAddJobFlag(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var dir string
+	if len(args) > 0 {
+		dir = args[0]
+	}
+	return jobCompletions(dir, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTemplateNames completes job template names.
+func completeTemplateNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	templates, err := orchestration.NewTemplateManager().ListTemplates()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var completions []string
+	for _, t := range templates {
+		completions = append(completions, t.Name+"\t"+t.Description)
+	}
+	sort.Strings(completions)
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRecipeNames completes recipe names, including dynamic recipes.
+func completeRecipeNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	_, getRecipeCmd, _ := loadFlowConfigWithDynamicRecipes()
+	recipes, err := orchestration.ListAllRecipes(getRecipeCmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var completions []string
+	for _, recipe := range recipes {
+		completions = append(completions, recipe.Name+"\t"+recipe.Description)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// registerAddCompletions registers flag completions shared by `plan add` and
+// `add`.
+func registerAddCompletions(cmd *cobra.Command) {
+	_ = cmd.RegisterFlagCompletionFunc("template", completeTemplateNames)
+	_ = cmd.RegisterFlagCompletionFunc("recipe", completeRecipeNames)
+	_ = cmd.RegisterFlagCompletionFunc("depends-on", completeAddJobFlag)
+	_ = cmd.RegisterFlagCompletionFunc("after", completeAddJobFlag)
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJobCompletions(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"01-spec.md":   "---\nid: spec-1234\ntitle: Spec\ntype: oneshot\nstatus: completed\n---\n",
+		"02-design.md": "---\nid: design-5678\ntitle: Design\ntype: oneshot\nstatus: pending\n---\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := jobCompletions(dir, false)
+	want := []string{"spec-1234\tSpec", "design-5678\tDesign"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("jobCompletions(ids) = %q, want %q", got, want)
+	}
+
+	got = jobCompletions(dir, true)
+	if len(got) != 4 || got[1] != "01-spec.md\tSpec" {
+		t.Errorf("jobCompletions(filenames) = %q", got)
+	}
+}
+
+func TestJobFileCompletions(t *testing.T) {
+	dir := t.TempDir()
+	content := "---\nid: spec-1234\ntitle: Spec\ntype: oneshot\nstatus: pending\n---\n"
+	if err := os.WriteFile(filepath.Join(dir, "01-spec.md"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got := jobFileCompletions(dir)
+	if len(got) != 1 || got[0] != "01-spec.md\tSpec" {
+		t.Errorf("jobFileCompletions() = %q, want [\"01-spec.md\\tSpec\"]", got)
+	}
+}
+
+func TestListPlanNames(t *testing.T) {
+	dir := t.TempDir()
+	for _, sub := range []string{"with-config", "with-jobs", "empty", ".hidden"} {
+		if err := os.Mkdir(filepath.Join(dir, sub), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for path, content := range map[string]string{
+		"with-config/.grove-plan.yml": "model: gemini-2.5-pro\n",
+		"with-jobs/01-spec.md":        "---\nid: spec\n---\n",
+		".hidden/01-spec.md":          "---\nid: spec\n---\n",
+		"notes.md":                    "not a plan",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, path), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Outside a workspace, plans are looked up in the working directory
+	t.Chdir(dir)
+
+	got := listPlanNames()
+	if len(got) != 2 || got[0] != "with-config" || got[1] != "with-jobs" {
+		t.Errorf("listPlanNames() = %q, want [with-config with-jobs]", got)
+	}
+}
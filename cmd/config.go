@@ -2,24 +2,32 @@ package cmd
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/grovetools/core/config"
+	"github.com/grovetools/flow/pkg/orchestration"
 )
 
 //go:generate sh -c "cd .. && go run ./tools/schema-generator/"
 
 // FlowConfig defines the structure for the 'flow' section in grove.yml.
 type FlowConfig struct {
-	ChatDirectory        string                  `yaml:"chat_directory"`
-	OneshotModel         string                  `yaml:"oneshot_model"`
-	TargetAgentContainer string                  `yaml:"target_agent_container"`
-	PlansDirectory       string                  `yaml:"plans_directory"`
-	MaxConsecutiveSteps  int                     `yaml:"max_consecutive_steps"`
-	SummarizeOnComplete  bool                    `yaml:"summarize_on_complete"`
-	SummaryModel         string                  `yaml:"summary_model"`
-	SummaryPrompt        string                  `yaml:"summary_prompt"`
-	SummaryMaxChars      int                     `yaml:"summary_max_chars"`
-	RunInitByDefault     *bool                   `yaml:"run_init_by_default"` // Whether to run init actions by default (nil = true)
-	Recipes              map[string]RecipeConfig `yaml:"recipes"`
+	ChatDirectory        string                      `yaml:"chat_directory"`
+	OneshotModel         string                      `yaml:"oneshot_model"`
+	TargetAgentContainer string                      `yaml:"target_agent_container"`
+	PlansDirectory       string                      `yaml:"plans_directory"`
+	MaxConsecutiveSteps  int                         `yaml:"max_consecutive_steps"`
+	Timeout              string                      `yaml:"timeout"`       // Default LLM timeout for oneshot jobs (e.g. "10m")
+	RetryCount           *int                        `yaml:"retry_count"`   // Default LLM retry count for oneshot jobs
+	FetchTimeout         string                      `yaml:"fetch_timeout"` // Timeout for downloading URL prompt sources (e.g. "30s")
+	SummarizeOnComplete  bool                        `yaml:"summarize_on_complete"`
+	SummaryModel         string                      `yaml:"summary_model"`
+	SummaryPrompt        string                      `yaml:"summary_prompt"`
+	SummaryMaxChars      int                         `yaml:"summary_max_chars"`
+	RunInitByDefault     *bool                       `yaml:"run_init_by_default"` // Whether to run init actions by default (nil = true)
+	Recipes              map[string]RecipeConfig     `yaml:"recipes"`
+	OpenAI               *orchestration.OpenAIConfig `yaml:"openai"` // OpenAI-compatible endpoint for prefixed models
+	Redact               *orchestration.RedactConfig `yaml:"redact"` // Secret redaction for briefing files and the audit log
 }
 
 // RecipeConfig defines configuration for a specific recipe.
@@ -61,10 +69,41 @@ func loadFlowConfig() (*FlowConfig, error) {
 	if err := coreCfg.UnmarshalExtension("flow", &flowCfg); err != nil {
 		return nil, fmt.Errorf("failed to parse 'flow' configuration from grove.yml: %w", err)
 	}
+	if flowCfg.Timeout != "" {
+		if _, err := time.ParseDuration(flowCfg.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid flow.timeout %q in grove.yml: %w", flowCfg.Timeout, err)
+		}
+	}
+	if flowCfg.FetchTimeout != "" {
+		if _, err := time.ParseDuration(flowCfg.FetchTimeout); err != nil {
+			return nil, fmt.Errorf("invalid flow.fetch_timeout %q in grove.yml: %w", flowCfg.FetchTimeout, err)
+		}
+	}
+	if err := flowCfg.Redact.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid flow.redact in grove.yml: %w", err)
+	}
 
 	return &flowCfg, nil
 }
 
+// orchestrationConfig converts the flow config into the settings injected into plans.
+func (c *FlowConfig) orchestrationConfig() *orchestration.Config {
+	timeout, _ := time.ParseDuration(c.Timeout)           // validated in loadFlowConfig
+	fetchTimeout, _ := time.ParseDuration(c.FetchTimeout) // validated in loadFlowConfig
+	return &orchestration.Config{
+		OneshotModel:         c.OneshotModel,
+		TargetAgentContainer: c.TargetAgentContainer,
+		PlansDirectory:       c.PlansDirectory,
+		MaxConsecutiveSteps:  c.MaxConsecutiveSteps,
+		Timeout:              timeout,
+		RetryCount:           c.RetryCount,
+		OpenAI:               c.OpenAI,
+		FetchTimeout:         fetchTimeout,
+		Redact:               c.Redact,
+		SummaryModel:         c.SummaryModel,
+	}
+}
+
 // loadFullConfig loads the entire grove config including agent settings
 func loadFullConfig() (*AppConfig, error) {
 	coreCfg, err := config.LoadFrom(".")
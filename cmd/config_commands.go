@@ -3,7 +3,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/grovetools/core/state"
 	"github.com/spf13/cobra"
 )
 
@@ -12,46 +11,29 @@ import (
 
 // NewSetCmd creates the top-level `set` command.
 func NewSetCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "set <plan-directory>",
-		Short: "Set the active job plan directory",
-		Long: `Set the active job plan directory to avoid specifying it in every command.
+	return newSetActivePlanCmd(`Set the active job plan directory to avoid specifying it in every command.
+With no arguments, show the active plan and its directory. Use --clear to
+unset it.
 
 Examples:
   flow set user-profile-api
-  flow set ./plans/feature-x`,
-		Args: cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			planDir := args[0]
-			if err := state.Set("flow.active_plan", planDir); err != nil {
-				return fmt.Errorf("set active job: %w", err)
-			}
-			fmt.Printf("Set active job to: %s\n", planDir)
-			return nil
-		},
-	}
+  flow set ./plans/feature-x
+  flow set
+  flow set --clear`)
 }
 
 // NewCurrentCmd creates the top-level `current` command.
 func NewCurrentCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "current",
-		Short: "Show the current active job plan directory",
-		Long: `Show the current active job plan directory.
+		Use:     "current",
+		Aliases: []string{"active"},
+		Short:   "Show the current active job plan directory",
+		Long: `Show the current active job plan and the directory it resolves to.
 
 If no active job is set, this command will indicate that.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			activeJob, err := getActivePlanWithMigration()
-			if err != nil {
-				return fmt.Errorf("get active job: %w", err)
-			}
-			if activeJob == "" {
-				fmt.Println("No active job set")
-			} else {
-				fmt.Printf("Active job: %s\n", activeJob)
-			}
-			return nil
+			return showActivePlan(cmd)
 		},
 	}
 }
@@ -64,12 +46,9 @@ func NewUnsetCmd() *cobra.Command {
 		Long:  `Clear the active job plan directory.`,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Clear both old and new keys
-			if err := state.Delete("flow.active_plan"); err != nil {
-				return fmt.Errorf("clear active job: %w", err)
+			if err := clearActivePlan(); err != nil {
+				return err
 			}
-			// Also try to delete old key (ignore errors)
-			_ = state.Delete("active_plan")
 			fmt.Println("Cleared active job")
 			return nil
 		},
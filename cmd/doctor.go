@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/grovetools/core/cli"
+	"github.com/grovetools/core/config"
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/spf13/cobra"
+)
+
+// Doctor check results.
+const (
+	doctorPass = "pass"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+)
+
+// doctorCheck is the result of one environment check.
+type doctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"` // pass, warn, or fail
+	Detail string `json:"detail"`
+	Hint   string `json:"hint,omitempty"` // How to fix a warn or fail
+}
+
+// doctorBinary is an external program flow shells out to.
+type doctorBinary struct {
+	names    []string // Alternatives; the first one found passes
+	required bool     // Missing required binaries fail, others warn
+	hint     string
+}
+
+var doctorBinaries = []doctorBinary{
+	{names: []string{"git"}, required: true, hint: "Install git and make sure it is on your PATH"},
+	{names: []string{"grove"}, required: true, hint: "Install the grove CLI; worktrees and plan finish depend on it"},
+	{names: []string{"tmux"}, hint: "Install tmux to open plans and agent jobs in tmux sessions"},
+	{names: []string{"cx", "grove-context"}, hint: "Install grove-context (cx) to generate .grove/context for jobs"},
+}
+
+// NewDoctorCmd creates the `doctor` command.
+func NewDoctorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the environment for problems that stop flow from working",
+		Long: `Check that the tools and settings flow relies on are available:
+
+  - external binaries: git and grove (required), tmux and cx/grove-context (optional)
+  - Gemini and Anthropic API key resolution
+  - the plans directory for the current workspace
+
+Each check is reported as pass, warn, or fail with a hint on how to fix it.
+Exits non-zero if any required check fails.`,
+		Args: cobra.NoArgs,
+		RunE: runDoctor,
+	}
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	checks := doctorBinaryChecks(exec.LookPath)
+	checks = append(checks, doctorAPIKeyChecks()...)
+	checks = append(checks, doctorPlansDirCheck())
+
+	failed := 0
+	for _, check := range checks {
+		if check.Status == doctorFail {
+			failed++
+		}
+	}
+
+	if cli.GetOptions(cmd).JSONOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(checks); err != nil {
+			return err
+		}
+	} else {
+		for _, check := range checks {
+			icon := theme.IconSuccess
+			switch check.Status {
+			case doctorWarn:
+				icon = theme.IconWarning
+			case doctorFail:
+				icon = theme.IconError
+			}
+			fmt.Printf("%s %s: %s\n", icon, check.Name, check.Detail)
+			if check.Hint != "" {
+				fmt.Printf("    %s\n", renderMuted(check.Hint))
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d required check(s) failed", failed)
+	}
+	return nil
+}
+
+// doctorBinaryChecks looks up each of doctorBinaries with lookPath.
+func doctorBinaryChecks(lookPath func(string) (string, error)) []doctorCheck {
+	var checks []doctorCheck
+	for _, bin := range doctorBinaries {
+		check := doctorCheck{Name: bin.names[0]}
+		for _, name := range bin.names {
+			if path, err := lookPath(name); err == nil {
+				check.Status = doctorPass
+				check.Detail = path
+				break
+			}
+		}
+		if check.Status == "" {
+			check.Status = doctorWarn
+			if bin.required {
+				check.Status = doctorFail
+			}
+			check.Detail = "not found on PATH"
+			check.Hint = bin.hint
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// doctorAPIKeyChecks reports whether the provider API keys resolve. A missing
+// key only warns, since plans may not use that provider.
+func doctorAPIKeyChecks() []doctorCheck {
+	providers := []struct {
+		name, provider, hint string
+	}{
+		{"gemini api key", "gemini", "Configure a Gemini API key (e.g. GEMINI_API_KEY) to use gemini-* models"},
+		{"anthropic api key", "anthropic", "Configure an Anthropic API key (e.g. ANTHROPIC_API_KEY) to use claude-* models"},
+	}
+	var checks []doctorCheck
+	for _, p := range providers {
+		if providerAPIKeyAvailable(p.provider) {
+			checks = append(checks, doctorCheck{Name: p.name, Status: doctorPass, Detail: "resolved"})
+		} else {
+			checks = append(checks, doctorCheck{Name: p.name, Status: doctorWarn, Detail: "not configured", Hint: p.hint})
+		}
+	}
+	return checks
+}
+
+// doctorPlansDirCheck resolves the plans directory for the current workspace
+// the same way plan commands do.
+func doctorPlansDirCheck() doctorCheck {
+	check := doctorCheck{Name: "plans directory"}
+
+	node, err := workspace.GetProjectByPath(".")
+	if err != nil {
+		check.Status = doctorWarn
+		check.Detail = "not inside a grove workspace"
+		check.Hint = "Run flow from a project directory, or pass plan paths explicitly"
+		return check
+	}
+
+	coreCfg, err := config.LoadDefault()
+	if err != nil {
+		coreCfg = &config.Config{}
+	}
+	plansDir, err := workspace.NewNotebookLocator(coreCfg).GetPlansDir(node)
+	if err != nil {
+		check.Status = doctorFail
+		check.Detail = err.Error()
+		check.Hint = "Check the notebooks settings in your global grove.yml"
+		return check
+	}
+
+	check.Status = doctorPass
+	check.Detail = plansDir
+	if _, err := os.Stat(plansDir); os.IsNotExist(err) {
+		check.Detail = plansDir + " (not created yet)"
+	}
+	return check
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDoctorBinaryChecks(t *testing.T) {
+	installed := map[string]bool{"git": true, "grove-context": true}
+	lookPath := func(name string) (string, error) {
+		if installed[name] {
+			return "/usr/bin/" + name, nil
+		}
+		return "", fmt.Errorf("%s: not found", name)
+	}
+
+	got := make(map[string]doctorCheck)
+	for _, check := range doctorBinaryChecks(lookPath) {
+		got[check.Name] = check
+	}
+
+	want := map[string]string{
+		"git":   doctorPass,
+		"grove": doctorFail, // required
+		"tmux":  doctorWarn, // optional
+		"cx":    doctorPass, // satisfied by grove-context
+	}
+	for name, status := range want {
+		if got[name].Status != status {
+			t.Errorf("%s: status = %q, want %q", name, got[name].Status, status)
+		}
+	}
+	if got["cx"].Detail != "/usr/bin/grove-context" {
+		t.Errorf("cx detail = %q, want the grove-context path", got["cx"].Detail)
+	}
+	if got["grove"].Hint == "" {
+		t.Error("failed checks should carry a remediation hint")
+	}
+}
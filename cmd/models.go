@@ -4,13 +4,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/grovetools/core/cli"
+	anthropicconfig "github.com/grovetools/grove-anthropic/pkg/config"
 	anthropicmodels "github.com/grovetools/grove-anthropic/pkg/models"
+	geminiconfig "github.com/grovetools/grove-gemini/pkg/config"
 	geminimodels "github.com/grovetools/grove-gemini/pkg/models"
 	"github.com/spf13/cobra"
 )
 
+var modelsProvider string
+
 // NewModelsCmd creates the flow models command.
 func NewModelsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,9 +24,18 @@ func NewModelsCmd() *cobra.Command {
 		Short: "List available LLM models for use in jobs and chats",
 		Long: `Lists recommended LLM models that can be used in job and chat frontmatter.
 		
-While other models supported by the 'llm' tool may work, these are the primary models tested with Grove Flow.`,
+While other models supported by the 'llm' tool may work, these are the primary models tested with Grove Flow.
+
+The KEY column shows whether an API key for the model's provider can currently be
+resolved, i.e. whether the model is usable in this environment.
+
+Examples:
+  flow models
+  flow models --provider gemini
+  flow models --json`,
 		RunE: runModelsList,
 	}
+	cmd.Flags().StringVar(&modelsProvider, "provider", "", "Only list models from this provider (e.g., gemini, anthropic)")
 	return cmd
 }
 
@@ -31,6 +46,7 @@ func runModelsList(cmd *cobra.Command, args []string) error {
 		Alias    string `json:"alias,omitempty"`
 		Provider string `json:"provider"`
 		Note     string `json:"note"`
+		APIKey   bool   `json:"api_key_available"`
 	}
 
 	var models []displayModel
@@ -55,10 +71,28 @@ func runModelsList(cmd *cobra.Command, args []string) error {
 		})
 	}
 
-	// Check if JSON output is requested via global flag
-	jsonOutput, _ := cmd.Root().PersistentFlags().GetBool("json")
+	// Filter by provider and record which providers have a usable API key
+	keyAvailable := make(map[string]bool)
+	filtered := models[:0]
+	for _, m := range models {
+		if modelsProvider != "" && !strings.EqualFold(m.Provider, modelsProvider) {
+			continue
+		}
+		available, checked := keyAvailable[m.Provider]
+		if !checked {
+			available = providerAPIKeyAvailable(m.Provider)
+			keyAvailable[m.Provider] = available
+		}
+		m.APIKey = available
+		filtered = append(filtered, m)
+	}
+	models = filtered
+
+	if modelsProvider != "" && len(models) == 0 {
+		return fmt.Errorf("no models found for provider '%s'", modelsProvider)
+	}
 
-	if jsonOutput {
+	if cli.GetOptions(cmd).JSONOutput {
 		// JSON output
 		output := struct {
 			Models []displayModel `json:"models"`
@@ -72,14 +106,18 @@ func runModelsList(cmd *cobra.Command, args []string) error {
 
 	// Table output
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "ALIAS\tMODEL ID\tPROVIDER\tNOTE")
-	fmt.Fprintln(w, "-----\t--------\t--------\t----")
+	fmt.Fprintln(w, "ALIAS\tMODEL ID\tPROVIDER\tKEY\tNOTE")
+	fmt.Fprintln(w, "-----\t--------\t--------\t---\t----")
 	for _, model := range models {
 		alias := model.Alias
 		if alias == "" {
 			alias = "-"
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", alias, model.ID, model.Provider, model.Note)
+		key := "no"
+		if model.APIKey {
+			key = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", alias, model.ID, model.Provider, key, model.Note)
 	}
 	w.Flush()
 
@@ -89,3 +127,17 @@ func runModelsList(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// providerAPIKeyAvailable reports whether an API key can be resolved for provider,
+// using the same lookup the oneshot executor uses.
+func providerAPIKeyAvailable(provider string) bool {
+	switch strings.ToLower(provider) {
+	case "gemini", "google":
+		key, err := geminiconfig.ResolveAPIKey()
+		return err == nil && key != ""
+	case "anthropic":
+		key, err := anthropicconfig.ResolveAPIKey()
+		return err == nil && key != ""
+	}
+	return false
+}
@@ -28,7 +28,12 @@ var planStatusCmd = &cobra.Command{
 	Use:   "status [directory]",
 	Short: "Show plan status in an interactive TUI (use: flow status)",
 	Long: `Show the status of all jobs in an orchestration plan in an interactive TUI.
-If no directory is specified, uses the active job if set.`,
+If no directory is specified, uses the active job if set.
+
+With --watch, prints a plain-text summary instead of the TUI and reprints it
+whenever a job's status changes, until every job has completed, failed, been
+blocked, skipped or abandoned, or until Ctrl+C. Use this on CI machines and
+other terminals where the TUI cannot run.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runPlanStatus,
 }
@@ -39,7 +44,22 @@ var planRunCmd = &cobra.Command{
 	Long: `Run jobs in an orchestration plan.
 Without arguments, runs the next available jobs.
 With a single job file argument, runs that specific job.
-With multiple job file arguments, runs those jobs in parallel.`,
+With multiple job file arguments, runs those jobs in parallel.
+Up to --parallel jobs run at once (default 3, or max_parallel from the plan's
+.grove-plan.yml); interactive agent and chat jobs always run one at a time.
+With --rerun-on-change, keeps running afterwards and re-runs a pending or
+failed job each time its markdown file is saved, until interrupted with Ctrl+C.
+After a job fails, no new jobs are started and the run stops once running jobs
+finish. With --fail-fast=false, only the failed job's dependents are blocked;
+independent jobs keep running and all failures are reported at the end.
+--prompt-prefix and --prompt-suffix add a one-off instruction before or after
+every job's request for this run; the briefing file marks them as run
+instructions.
+With --only-ready, runs every job whose dependencies are already satisfied and
+stops, leaving the jobs they unblock for the next invocation, so the plan can
+be stepped through one layer at a time.
+Before running, each pending job is listed with its type, effective model,
+worktree and context source for confirmation; --yes skips this.`,
 	RunE: runPlanRun,
 }
 
@@ -72,7 +92,16 @@ var planGraphCmd = &cobra.Command{
 	Short: "Visualize job dependency graph (use: flow graph)",
 	Long: `Generate a visualization of the job dependency graph.
 Supports multiple output formats including Mermaid, DOT, and ASCII.
-If no directory is specified, uses the active job if set.`,
+Nodes are colored by job status and edges follow depends_on. Plans with
+circular dependencies are reported as an error instead of being rendered.
+If no directory is specified, uses the active job if set.
+
+Examples:
+  # Render the active plan as an SVG with Graphviz
+  flow plan graph -f dot | dot -Tsvg > plan.svg
+
+  # Mermaid for pasting into Markdown docs and PRs
+  flow plan graph -f mermaid`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runPlanGraph,
 }
@@ -92,7 +121,9 @@ var planOpenCmd = &cobra.Command{
 	Short: "Open a plan's worktree in a dedicated tmux session (use: flow open)",
 	Long: `Switches to or creates a tmux session for the plan's worktree and opens the interactive status TUI.
 This provides a one-command entry point into a plan's interactive environment.
-If no directory is specified, uses the active job if set.`,
+If no directory is specified, uses the active job if set.
+With --print, only the absolute worktree path (or the plan directory if the plan
+has no worktree) is printed: cd "$(flow plan open --print)"`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runPlanOpen,
 }
@@ -114,6 +145,7 @@ var (
 	planInitFromNote       string
 	planInitNoteTargetFile string
 	planInitRunInit        bool
+	planInitYes            bool
 	planRunDir             string
 	planRunAll             bool
 	planRunNext            bool
@@ -125,6 +157,7 @@ var (
 	planAddTitle               string
 	planAddDependsOn           []string
 	planAddPromptFile          string
+	planAddPromptStdin         bool
 	planAddPrompt              string
 	planAddInteractive         bool
 	planAddIncludeFiles        []string
@@ -134,6 +167,8 @@ var (
 	planAddRecipe              string
 	planAddRecipeVars          []string
 	planAddSourceFile          string
+	planAddAfter               string
+	planAddInsert              bool
 
 	// Graph flags
 	planGraphFormat string
@@ -161,16 +196,35 @@ func NewPlanCmd() *cobra.Command {
 	planInitCmd.Flags().StringVar(&planInitFromNote, "from-note", "", "Path to a note file whose body will be used as the prompt for the first job")
 	planInitCmd.Flags().StringVar(&planInitNoteTargetFile, "note-target-file", "", "Filename of the job within the recipe to apply the --from-note content and reference to")
 	planInitCmd.Flags().BoolVar(&planInitRunInit, "init", false, "Execute init actions from the recipe's workspace_init.yml")
+	planInitCmd.Flags().BoolVarP(&planInitYes, "yes", "y", false, "Skip prompts; with multiple --recipe-cmd recipes, use the first")
 
 	// Run command flags
 	planRunCmd.Flags().StringVarP(&planRunDir, "dir", "d", ".", "Plan directory")
 	planRunCmd.Flags().BoolVarP(&planRunAll, "all", "a", false, "Run all pending jobs")
 	planRunCmd.Flags().BoolVarP(&planRunNext, "next", "n", false, "Run next available jobs")
-	planRunCmd.Flags().IntVarP(&planRunParallel, "parallel", "p", 3, "Max parallel jobs")
+	planRunCmd.Flags().BoolVar(&planRunOnlyReady, "only-ready", false, "Run every job that is ready now, then stop without running the jobs they unblock")
+	planRunCmd.Flags().IntVarP(&planRunParallel, "parallel", "p", 3, "Max parallel jobs (overrides max_parallel in .grove-plan.yml); interactive and chat jobs always run one at a time")
 	planRunCmd.Flags().BoolVarP(&planRunWatch, "watch", "w", false, "Watch progress in real-time")
+	planRunCmd.Flags().BoolVar(&planRunRerunOnChange, "rerun-on-change", false, "After the run, keep watching the plan and re-run pending/failed jobs whenever their files change")
 	planRunCmd.Flags().BoolVarP(&planRunYes, "yes", "y", false, "Skip confirmation prompts")
 	planRunCmd.Flags().StringVar(&planRunModel, "model", "", "Override model for jobs (e.g., claude-3-5-sonnet-20240620, gpt-4)")
 	planRunCmd.Flags().BoolVar(&planRunSkipInteractive, "skip-interactive", false, "Skip interactive agent jobs (useful for CI/automation)")
+	planRunCmd.Flags().BoolVar(&planRunDryRun, "dry-run", false, "Print the assembled prompt for each job without calling the LLM or changing job status")
+	planRunCmd.Flags().DurationVar(&planRunTimeout, "timeout", 0, "Override the LLM timeout for oneshot jobs (e.g., 10m)")
+	planRunCmd.Flags().IntVar(&planRunRetryCount, "retry-count", 0, "Override the number of LLM retries for oneshot jobs")
+	planRunCmd.Flags().BoolVar(&planRunRestart, "restart", false, "Ignore the checkpoint from an interrupted --all run and start over")
+	planRunCmd.Flags().StringVar(&planRunReport, "report", "", "Write a report of each job's final status, duration, model, output path and error to this file")
+	planRunCmd.Flags().StringVar(&planRunReportFormat, "report-format", "markdown", "Report format: markdown or json")
+	planRunCmd.Flags().BoolVar(&planRunForceContext, "force-context", false, "Regenerate context for every job instead of reusing it when the worktree and rules are unchanged")
+	planRunCmd.Flags().IntVar(&planRunMaxTurns, "max-turns", 0, "Let chat jobs run up to this many turns unattended (overrides max_turns in frontmatter)")
+	planRunCmd.Flags().StringArrayVar(&planRunContextFiles, "context-file", nil, "Attach an extra file to every job's prompt for this run (repeatable)")
+	planRunCmd.Flags().BoolVar(&planRunNoContext, "no-context", false, "Skip context generation and omit .grove/context and CLAUDE.md from prompts")
+	planRunCmd.Flags().BoolVar(&planRunSaveRaw, "save-raw", false, "Save each oneshot job's verbatim LLM response to <job-id>.raw.md in the log directory")
+	planRunCmd.Flags().BoolVar(&planRunFailFast, "fail-fast", true, "Stop starting new jobs after the first failure; with --fail-fast=false, block only the failed job's dependents and run the rest")
+	planRunCmd.Flags().StringSliceVar(&planRunWorktreeMatrix, "worktree-matrix", nil, "Run the plan's jobs once per worktree (comma-separated), overriding worktrees in .grove-plan.yml")
+	planRunCmd.Flags().BoolVar(&planRunOffline, "offline", false, "Fail jobs that include URL prompt sources instead of fetching them")
+	planRunCmd.Flags().StringVar(&planRunPromptPrefix, "prompt-prefix", "", "Instruction to place before every job's request for this run (e.g., \"respond in French\")")
+	planRunCmd.Flags().StringVar(&planRunPromptSuffix, "prompt-suffix", "", "Instruction to place after every job's request for this run")
 
 	// Add-step command flags
 	planAddCmd.Flags().StringVar(&planAddTemplate, "template", "", "Name of the job template to use")
@@ -179,11 +233,12 @@ func NewPlanCmd() *cobra.Command {
    • chat             - Interactive conversation requiring user input
    • shell            - Execute shell commands directly
    • headless_agent   - Autonomous agent without user interaction
-   • interactive_agent - Agent with user interaction (default)
+   • interactive_agent - Agent with user interaction (default unless the plan sets default_job_type)
    • file             - Static file content, no execution`)
 	planAddCmd.Flags().StringVar(&planAddTitle, "title", "", "Job title")
-	planAddCmd.Flags().StringSliceVarP(&planAddDependsOn, "depends-on", "d", nil, "Dependencies (job filenames)")
-	planAddCmd.Flags().StringVarP(&planAddPromptFile, "prompt-file", "f", "", "File containing the prompt")
+	planAddCmd.Flags().StringSliceVarP(&planAddDependsOn, "depends-on", "d", nil, "Dependencies by job ID or filename (repeatable)")
+	planAddCmd.Flags().StringVarP(&planAddPromptFile, "prompt-file", "f", "", "File containing the prompt (use - to read from stdin)")
+	planAddCmd.Flags().BoolVar(&planAddPromptStdin, "prompt-stdin", false, "Read the prompt from stdin")
 	planAddCmd.Flags().StringVarP(&planAddPrompt, "prompt", "p", "", "Inline prompt text (alternative to --prompt-file)")
 	planAddCmd.Flags().BoolVarP(&planAddInteractive, "interactive", "i", false, "Interactive mode")
 	planAddCmd.Flags().StringSliceVar(&planAddIncludeFiles, "include", nil, "Comma-separated list of files to include as context")
@@ -199,6 +254,8 @@ func NewPlanCmd() *cobra.Command {
 	planAddCmd.Flags().StringVar(&planAddRecipe, "recipe", "", "Name of a recipe to add to the plan")
 	planAddCmd.Flags().StringArrayVar(&planAddRecipeVars, "recipe-vars", nil, "Variables for the recipe templates (e.g., key=value)")
 	planAddCmd.Flags().StringVar(&planAddSourceFile, "source-file", "", "Origin file path for tracking job provenance (e.g., Claude plan file)")
+	planAddCmd.Flags().StringVar(&planAddAfter, "after", "", "Insert the job after this job (ID or filename), depending on it and renumbering later jobs")
+	planAddCmd.Flags().BoolVar(&planAddInsert, "insert", false, "With --after, also make jobs that depended on the anchor depend on the new job")
 
 	// Graph command flags
 	planGraphCmd.Flags().StringVarP(&planGraphFormat, "format", "f", "mermaid", "Output format: mermaid, dot, ascii")
@@ -206,14 +263,25 @@ func NewPlanCmd() *cobra.Command {
 	planGraphCmd.Flags().IntVarP(&planGraphPort, "port", "p", 8080, "Port for web server")
 	planGraphCmd.Flags().StringVarP(&planGraphOutput, "output", "o", "", "Output file (stdout if not specified)")
 
+	// Open command flags
+	planOpenCmd.Flags().BoolVar(&planOpenPrint, "print", false, "Print the plan's worktree path instead of opening a tmux session")
+
 	// Initialize status command flags
 	InitPlanStatusFlags()
 
+	// Shell completion for plan names, job IDs, templates, and recipes
+	_ = planInitCmd.RegisterFlagCompletionFunc("recipe", completeRecipeNames)
+	registerAddCompletions(planAddCmd)
+	for _, c := range []*cobra.Command{planStatusCmd, planAddCmd, planGraphCmd, planStepCmd, planOpenCmd, planReviewCmd} {
+		c.ValidArgsFunction = completePlanArg
+	}
+	planRunCmd.ValidArgsFunction = completeRunArgs
+
 	// Register templates subcommand
-	planTemplatesListCmd.Flags().String("domain", "", "Filter templates by domain (e.g., generic, grove)")
-	planTemplatesCmd.AddCommand(planTemplatesListCmd)
+	planTemplatesCmd.AddCommand(newTemplatesListCmd())
 	planTemplatesPrintCmd.Flags().BoolVar(&planTemplatesPrintWithFrontmatter, "frontmatter", false, "Include YAML frontmatter in output")
 	planTemplatesCmd.AddCommand(planTemplatesPrintCmd)
+	planTemplatesCmd.AddCommand(newTemplatesShowCmd())
 
 	// Add subcommands
 	planCmd.AddCommand(planInitCmd)
@@ -240,7 +308,23 @@ func NewPlanCmd() *cobra.Command {
 	planCmd.AddCommand(NewPlanContextCmd())
 	planCmd.AddCommand(NewPlanHoldCmd())
 	planCmd.AddCommand(NewPlanUnholdCmd())
+	planCmd.AddCommand(NewPlanArchiveCmd())
+	planCmd.AddCommand(NewPlanUnarchiveCmd())
 	planCmd.AddCommand(NewPlanResumeCmd())
+	planCmd.AddCommand(NewPlanCostCmd())
+	planCmd.AddCommand(NewPlanRerunCmd())
+	planCmd.AddCommand(NewPlanValidateCmd())
+	planCmd.AddCommand(NewPlanDiffCmd())
+	planCmd.AddCommand(NewPlanCloneCmd())
+	planCmd.AddCommand(NewPlanRenameCmd())
+	planCmd.AddCommand(NewPlanExplainModelCmd())
+	planCmd.AddCommand(NewPlanDepsCmd())
+	planCmd.AddCommand(NewPlanLogsCmd())
+	planCmd.AddCommand(NewPlanPruneCmd())
+	planCmd.AddCommand(NewPlanExportCmd())
+	planCmd.AddCommand(NewPlanImportCmd())
+	planCmd.AddCommand(NewPlanSyncCmd())
+	planCmd.AddCommand(NewPlanFixIDsCmd())
 
 	// Return the configured jobs command
 	return planCmd
@@ -270,6 +354,7 @@ func runPlanInit(cmd *cobra.Command, args []string) error {
 		FromNote:       planInitFromNote,
 		NoteTargetFile: planInitNoteTargetFile,
 		RunInit:        planInitRunInit,
+		Yes:            planInitYes,
 	}
 
 	// Launch TUI if no directory is provided and we are in a TTY, or if --tui is explicitly set.
@@ -308,6 +393,7 @@ func runPlanAdd(cmd *cobra.Command, args []string) error {
 		Title:               planAddTitle,
 		DependsOn:           planAddDependsOn,
 		PromptFile:          planAddPromptFile,
+		PromptStdin:         planAddPromptStdin,
 		Prompt:              planAddPrompt,
 		Interactive:         planAddInteractive,
 		IncludeFiles:        planAddIncludeFiles,
@@ -317,6 +403,12 @@ func runPlanAdd(cmd *cobra.Command, args []string) error {
 		Recipe:              planAddRecipe,
 		RecipeVars:          planAddRecipeVars,
 		SourceFile:          planAddSourceFile,
+		After:               planAddAfter,
+		Insert:              planAddInsert,
+	}
+	// Leave the type unset so RunPlanAddStep can apply the plan's default_job_type
+	if !cmd.Flags().Changed("type") {
+		addStepCmd.Type = ""
 	}
 	return RunPlanAddStep(addStepCmd)
 }
@@ -351,4 +443,5 @@ type PlanInitCmd struct {
 	FromNote       string
 	NoteTargetFile string
 	RunInit        bool     // Run init actions from workspace_init.yml
+	Yes            bool     // Skip interactive prompts, taking defaults
 }
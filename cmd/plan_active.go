@@ -1,24 +1,51 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 
+	"github.com/grovetools/core/cli"
 	"github.com/grovetools/core/state"
 	"github.com/spf13/cobra"
 )
 
 // NewPlanSetCmd creates the plan set command.
 func NewPlanSetCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "set <plan-directory>",
-		Short: "Set the active job plan directory",
-		Long: `Set the active job plan directory to avoid specifying it in every command.
+	return newSetActivePlanCmd(`Set the active job plan directory to avoid specifying it in every command.
+With no arguments, show the active plan and its directory. Use --clear to
+unset it.
 
 Examples:
   flow plan set user-profile-api
-  flow plan set ./plans/feature-x`,
-		Args: cobra.ExactArgs(1),
+  flow plan set ./plans/feature-x
+  flow plan set
+  flow plan set --clear`)
+}
+
+// newSetActivePlanCmd builds the set command shared by `flow set` and `flow plan set`.
+func newSetActivePlanCmd(long string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "set [plan-directory]",
+		Short:             "Set the active job plan directory",
+		Long:              long,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if clear, _ := cmd.Flags().GetBool("clear"); clear {
+				if len(args) > 0 {
+					return fmt.Errorf("--clear does not take a plan directory")
+				}
+				if err := clearActivePlan(); err != nil {
+					return err
+				}
+				fmt.Println("Cleared active job")
+				return nil
+			}
+			if len(args) == 0 {
+				return showActivePlan(cmd)
+			}
+
 			planDir := args[0]
 			if err := state.Set("flow.active_plan", planDir); err != nil {
 				return fmt.Errorf("set active job: %w", err)
@@ -27,28 +54,22 @@ Examples:
 			return nil
 		},
 	}
+	cmd.Flags().Bool("clear", false, "Clear the active plan instead of setting it")
+	return cmd
 }
 
 // NewPlanCurrentCmd creates the plan current command.
 func NewPlanCurrentCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "current",
-		Short: "Show the current active job plan directory",
-		Long: `Show the current active job plan directory.
+		Use:     "current",
+		Aliases: []string{"active"},
+		Short:   "Show the current active job plan directory",
+		Long: `Show the current active job plan and the directory it resolves to.
 
 If no active job is set, this command will indicate that.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			activeJob, err := getActivePlanWithMigration()
-			if err != nil {
-				return fmt.Errorf("get active job: %w", err)
-			}
-			if activeJob == "" {
-				fmt.Println("No active job set")
-			} else {
-				fmt.Printf("Active job: %s\n", activeJob)
-			}
-			return nil
+			return showActivePlan(cmd)
 		},
 	}
 }
@@ -61,14 +82,60 @@ func NewPlanUnsetCmd() *cobra.Command {
 		Long:  `Clear the active job plan directory.`,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Clear both old and new keys
-			if err := state.Delete("flow.active_plan"); err != nil {
-				return fmt.Errorf("clear active job: %w", err)
+			if err := clearActivePlan(); err != nil {
+				return err
 			}
-			// Also try to delete old key (ignore errors)
-			_ = state.Delete("active_plan")
 			fmt.Println("Cleared active job")
 			return nil
 		},
 	}
 }
+
+// clearActivePlan deletes the active plan, including the legacy key.
+func clearActivePlan() error {
+	if err := state.Delete("flow.active_plan"); err != nil {
+		return fmt.Errorf("clear active job: %w", err)
+	}
+	// Also try to delete old key (ignore errors)
+	_ = state.Delete("active_plan")
+	return nil
+}
+
+// showActivePlan prints the active plan and the directory it resolves to, or
+// JSON with --json. The directory is omitted if it can't be resolved.
+func showActivePlan(cmd *cobra.Command) error {
+	activePlan, err := getActivePlanWithMigration()
+	if err != nil {
+		return fmt.Errorf("get active job: %w", err)
+	}
+
+	var planDir string
+	if activePlan != "" {
+		if dir, err := resolvePlanPath(activePlan); err == nil {
+			planDir = dir
+		}
+	}
+
+	if cli.GetOptions(cmd).JSONOutput {
+		output := struct {
+			Plan      string `json:"plan"`
+			Directory string `json:"directory,omitempty"`
+		}{
+			Plan:      activePlan,
+			Directory: planDir,
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	}
+
+	if activePlan == "" {
+		fmt.Println("No active job set")
+		return nil
+	}
+	fmt.Printf("Active job: %s\n", activePlan)
+	if planDir != "" {
+		fmt.Printf("Directory: %s\n", planDir)
+	}
+	return nil
+}
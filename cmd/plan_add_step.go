@@ -20,8 +20,9 @@ type PlanAddStepCmd struct {
 	Template            string   `flag:"" help:"Name of the job template to use"`
 	Type                string   `flag:"t" default:"interactive_agent" help:"Job type: oneshot, chat, interactive_agent, headless_agent, shell, or file"`
 	Title               string   `flag:"" help:"Job title"`
-	DependsOn           []string `flag:"d" help:"Dependencies (job filenames)"`
-	PromptFile          string   `flag:"f" help:"File containing the prompt"`
+	DependsOn           []string `flag:"d" help:"Dependencies (job IDs or filenames, repeatable)"`
+	PromptFile          string   `flag:"f" help:"File containing the prompt (use - to read from stdin)"`
+	PromptStdin         bool     `flag:"" help:"Read the prompt from stdin"`
 	IncludeFiles        []string `flag:"" sep:"," help:"Comma-separated list of files to include as context"`
 	Prompt              string   `flag:"p" help:"Inline prompt text"`
 	Interactive         bool     `flag:"i" help:"Interactive mode"`
@@ -32,6 +33,8 @@ type PlanAddStepCmd struct {
 	Recipe              string   `flag:"" help:"Name of a recipe to add to the plan"`
 	RecipeVars          []string `flag:"" help:"Variables for the recipe templates (e.g., key=value)"`
 	SourceFile          string   `flag:"" help:"Origin file path for tracking job provenance (e.g., Claude plan file)"`
+	After               string   `flag:"" help:"Insert the job after this job (ID or filename), renumbering later jobs"`
+	Insert              bool     `flag:"" help:"With --after, also make jobs that depended on the anchor depend on the new job"`
 }
 
 func (c *PlanAddStepCmd) Run() error {
@@ -79,6 +82,55 @@ func parseInlineFlag(values []string) orchestration.InlineConfig {
 	return orchestration.InlineConfig{Categories: categories}
 }
 
+// readPromptInput returns the user-provided prompt text for a new job.
+// A prompt or prompt file of "-", or --prompt-stdin, reads the prompt from stdin
+// and fails if nothing is piped in. Otherwise stdin is only read when it is piped
+// and no other prompt source was given.
+func readPromptInput(cmd *PlanAddStepCmd, plan *orchestration.Plan) (string, error) {
+	if cmd.PromptStdin || cmd.Prompt == "-" || cmd.PromptFile == "-" {
+		stat, err := os.Stdin.Stat()
+		if err != nil {
+			return "", fmt.Errorf("failed to inspect stdin: %w", err)
+		}
+		if (stat.Mode() & os.ModeCharDevice) != 0 {
+			return "", fmt.Errorf("prompt requested from stdin, but stdin is a terminal; pipe the prompt in (e.g. cat prompt.md | flow plan add -f -)")
+		}
+		content, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read prompt from stdin: %w", err)
+		}
+		return string(content), nil
+	}
+
+	if cmd.Prompt != "" {
+		return cmd.Prompt, nil
+	}
+
+	if cmd.PromptFile != "" {
+		// Resolve the prompt file path using the same logic as source files
+		resolvedPath, err := orchestration.ResolvePromptSource(cmd.PromptFile, plan)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve prompt file %s: %w", cmd.PromptFile, err)
+		}
+		content, err := os.ReadFile(resolvedPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read prompt file '%s': %w", resolvedPath, err)
+		}
+		return string(content), nil
+	}
+
+	// Read from stdin if available
+	stat, _ := os.Stdin.Stat()
+	if stat != nil && (stat.Mode()&os.ModeCharDevice) == 0 {
+		content, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read prompt from stdin: %w", err)
+		}
+		return string(content), nil
+	}
+	return "", nil
+}
+
 func RunPlanAddStep(cmd *PlanAddStepCmd) error {
 	// Resolve the plan path with active job support
 	planPath, err := resolvePlanPathWithActiveJob(cmd.Dir)
@@ -104,6 +156,51 @@ func RunPlanAddStep(cmd *PlanAddStepCmd) error {
 		return fmt.Errorf("failed to load plan: %w", err)
 	}
 
+	if len(cmd.DependsOn) > 0 {
+		deps, err := resolveDependencyRefs(plan, cmd.DependsOn)
+		if err != nil {
+			return err
+		}
+		cmd.DependsOn = deps
+	}
+
+	// Without --type, use the plan's default_job_type. With a template, keep
+	// interactive_agent so the template's own type isn't overridden.
+	if cmd.Type == "" {
+		cmd.Type = string(orchestration.JobTypeInteractiveAgent)
+		if cmd.Template == "" {
+			cmd.Type = string(plan.Config.NewJobType())
+		}
+	}
+
+	if cmd.Insert && cmd.After == "" {
+		return fmt.Errorf("--insert requires --after")
+	}
+	var anchor *orchestration.Job
+	if cmd.After != "" {
+		if cmd.Recipe != "" {
+			return fmt.Errorf("--after cannot be combined with --recipe")
+		}
+		var found bool
+		anchor, found = plan.GetJobByID(cmd.After)
+		if !found {
+			anchor, found = plan.GetJobByFilename(cmd.After)
+		}
+		if !found {
+			return fmt.Errorf("job to insert after not found: %s", cmd.After)
+		}
+		hasAnchor := false
+		for _, dep := range cmd.DependsOn {
+			if dep == anchor.Filename {
+				hasAnchor = true
+				break
+			}
+		}
+		if !hasAnchor {
+			cmd.DependsOn = append(cmd.DependsOn, anchor.Filename)
+		}
+	}
+
 	// Handle adding jobs from a recipe
 	if cmd.Recipe != "" {
 		// 1. Load the recipe
@@ -128,6 +225,13 @@ func RunPlanAddStep(cmd *PlanAddStepCmd) error {
 				}
 			}
 		}
+		unknownVars, err := recipe.ValidateVars(recipeVars)
+		if err != nil {
+			return err
+		}
+		for _, name := range unknownVars {
+			fmt.Printf("Warning: recipe '%s' does not declare variable '%s'\n", cmd.Recipe, name)
+		}
 		templateData := struct {
 			PlanName string
 			Vars     map[string]string
@@ -177,7 +281,12 @@ func RunPlanAddStep(cmd *PlanAddStepCmd) error {
 	}
 
 	// Generate job file
-	filename, err := orchestration.AddJob(plan, job)
+	var filename string
+	if anchor != nil {
+		filename, err = orchestration.InsertJobAfter(plan, job, anchor, cmd.Insert)
+	} else {
+		filename, err = orchestration.AddJob(plan, job)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to add job: %w", err)
 	}
@@ -191,6 +300,39 @@ func RunPlanAddStep(cmd *PlanAddStepCmd) error {
 	return nil
 }
 
+// resolveDependencyRefs maps each job ID or filename in refs to the filename
+// of a job in plan, the form depends_on is written in. A ".md" suffix may be
+// omitted. Duplicates are dropped; unknown references are reported together.
+func resolveDependencyRefs(plan *orchestration.Plan, refs []string) ([]string, error) {
+	var resolved, missing []string
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		job, found := plan.GetJobByFilename(ref)
+		if !found {
+			job, found = plan.GetJobByID(ref)
+		}
+		if !found && !strings.HasSuffix(ref, ".md") {
+			job, found = plan.GetJobByFilename(ref + ".md")
+		}
+		if !found {
+			missing = append(missing, ref)
+			continue
+		}
+		if !seen[job.Filename] {
+			seen[job.Filename] = true
+			resolved = append(resolved, job.Filename)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("dependency not found in plan %s: %s (use a job ID or filename)", plan.Name, strings.Join(missing, ", "))
+	}
+	return resolved, nil
+}
+
 func collectJobDetails(cmd *PlanAddStepCmd, plan *orchestration.Plan, worktreeToUse string) (*orchestration.Job, error) {
 	// Auto-detect worktree context if not explicitly provided
 	if worktreeToUse == "" {
@@ -249,6 +391,12 @@ func collectJobDetails(cmd *PlanAddStepCmd, plan *orchestration.Plan, worktreeTo
 		// Convert to relative paths
 		var relativeIncludeFiles []string
 		for _, file := range cmd.IncludeFiles {
+			// URLs are fetched when the job runs, so keep them as written
+			if orchestration.IsURLSource(file) {
+				relativeIncludeFiles = append(relativeIncludeFiles, file)
+				continue
+			}
+
 			// Resolve the file path
 			resolvedPath, err := orchestration.ResolvePromptSource(file, plan)
 			if err != nil {
@@ -301,28 +449,9 @@ func collectJobDetails(cmd *PlanAddStepCmd, plan *orchestration.Plan, worktreeTo
 		job.PromptBody = ""
 
 		// Add user-provided prompt if any
-		userPrompt := ""
-		if cmd.Prompt != "" {
-			userPrompt = cmd.Prompt
-		} else if cmd.PromptFile != "" {
-			resolvedPath, err := orchestration.ResolvePromptSource(cmd.PromptFile, plan)
-			if err != nil {
-				return nil, fmt.Errorf("failed to resolve prompt file %s: %w", cmd.PromptFile, err)
-			}
-			content, err := os.ReadFile(resolvedPath)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read prompt file '%s': %w", resolvedPath, err)
-			}
-			userPrompt = string(content)
-		} else {
-			stat, _ := os.Stdin.Stat()
-			if (stat.Mode() & os.ModeCharDevice) == 0 {
-				content, err := io.ReadAll(os.Stdin)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read prompt from stdin: %w", err)
-				}
-				userPrompt = string(content)
-			}
+		userPrompt, err := readPromptInput(cmd, plan)
+		if err != nil {
+			return nil, err
 		}
 		if userPrompt != "" {
 			if job.PromptBody == "" {
@@ -357,33 +486,9 @@ func collectJobDetails(cmd *PlanAddStepCmd, plan *orchestration.Plan, worktreeTo
 	}
 
 	// Traditional prompt handling (non-reference based)
-	prompt := ""
-	if cmd.Prompt != "" {
-		// Use inline prompt if provided
-		prompt = cmd.Prompt
-	} else if cmd.PromptFile != "" {
-		// Resolve the prompt file path using the same logic as source files
-		resolvedPath, err := orchestration.ResolvePromptSource(cmd.PromptFile, plan)
-		if err != nil {
-			return nil, fmt.Errorf("failed to resolve prompt file %s: %w", cmd.PromptFile, err)
-		}
-
-		// Read from resolved file
-		content, err := os.ReadFile(resolvedPath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read prompt file '%s': %w", resolvedPath, err)
-		}
-		prompt = string(content)
-	} else {
-		// Read from stdin if available
-		stat, _ := os.Stdin.Stat()
-		if (stat.Mode() & os.ModeCharDevice) == 0 {
-			content, err := io.ReadAll(os.Stdin)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read prompt from stdin: %w", err)
-			}
-			prompt = string(content)
-		}
+	prompt, err := readPromptInput(cmd, plan)
+	if err != nil {
+		return nil, err
 	}
 
 	// Require a prompt if no template was used (file jobs can be empty)
@@ -670,6 +775,12 @@ func collectJobDetailsFromTemplate(cmd *PlanAddStepCmd, plan *orchestration.Plan
 		// Convert to relative paths
 		var relativeIncludeFiles []string
 		for _, file := range includeFiles {
+			// URLs are fetched when the job runs, so keep them as written
+			if orchestration.IsURLSource(file) {
+				relativeIncludeFiles = append(relativeIncludeFiles, file)
+				continue
+			}
+
 			// Resolve the file path
 			resolvedPath, err := orchestration.ResolvePromptSource(file, plan)
 			if err != nil {
@@ -701,32 +812,9 @@ func collectJobDetailsFromTemplate(cmd *PlanAddStepCmd, plan *orchestration.Plan
 		job.PromptBody = strings.TrimSpace(template.Prompt)
 
 		// Add user-provided prompt if any
-		userPrompt := ""
-		if cmd.Prompt != "" {
-			userPrompt = cmd.Prompt
-		} else if cmd.PromptFile != "" {
-			// Resolve the prompt file path using the same logic as source files
-			resolvedPath, err := orchestration.ResolvePromptSource(cmd.PromptFile, plan)
-			if err != nil {
-				return nil, fmt.Errorf("failed to resolve prompt file %s: %w", cmd.PromptFile, err)
-			}
-
-			// Read prompt from resolved file
-			content, err := os.ReadFile(resolvedPath)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read prompt file: %w", err)
-			}
-			userPrompt = string(content)
-		} else {
-			// Read from stdin if available
-			stat, _ := os.Stdin.Stat()
-			if (stat.Mode() & os.ModeCharDevice) == 0 {
-				content, err := io.ReadAll(os.Stdin)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read prompt from stdin: %w", err)
-				}
-				userPrompt = string(content)
-			}
+		userPrompt, err := readPromptInput(cmd, plan)
+		if err != nil {
+			return nil, err
 		}
 
 		if userPrompt != "" {
@@ -750,8 +838,12 @@ func collectJobDetailsFromTemplate(cmd *PlanAddStepCmd, plan *orchestration.Plan
 		job.PromptBody = strings.TrimSpace(renderedPrompt)
 
 		// Append user-provided prompt to template prompt if provided
-		if cmd.Prompt != "" {
-			job.PromptBody = job.PromptBody + "\n\n" + cmd.Prompt
+		userPrompt, err := readPromptInput(cmd, plan)
+		if err != nil {
+			return nil, err
+		}
+		if userPrompt != "" {
+			job.PromptBody = job.PromptBody + "\n\n" + userPrompt
 		}
 	}
 
@@ -263,6 +263,41 @@ func TestRunPlanAddStep(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "plan default_job_type applies without --type",
+			setupPlan: func(t *testing.T, dir string) {
+				plan := &orchestration.Plan{Name: "test-plan"}
+				if err := orchestration.SavePlan(dir, plan); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(dir, ".grove-plan.yml"), []byte("default_job_type: oneshot\n"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			cmd: &PlanAddStepCmd{
+				Title:  "Review",
+				Prompt: "Review the changes",
+			},
+			checkJob: func(t *testing.T, dir string) {
+				plan, err := orchestration.LoadPlan(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var job *orchestration.Job
+				for _, j := range plan.Jobs {
+					if j.Title == "Review" {
+						job = j
+						break
+					}
+				}
+				if job == nil {
+					t.Fatal("Created job not found")
+				}
+				if job.Type != orchestration.JobTypeOneshot {
+					t.Errorf("Expected type oneshot from plan default, got %s", job.Type)
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -380,3 +415,51 @@ func createTempFile(t *testing.T, content string) string {
 
 	return f.Name()
 }
+
+func TestReadPromptInput_Stdin(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	origStdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = origStdin }()
+
+	body := "# Long prompt\n\nPiped in verbatim.\n"
+	if _, err := w.WriteString(body); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	got, err := readPromptInput(&PlanAddStepCmd{PromptFile: "-"}, &orchestration.Plan{})
+	if err != nil {
+		t.Fatalf("readPromptInput() error = %v", err)
+	}
+	if got != body {
+		t.Errorf("readPromptInput() = %q, want %q", got, body)
+	}
+}
+
+func TestResolveDependencyRefs(t *testing.T) {
+	plan := &orchestration.Plan{
+		Name: "test-plan",
+		Jobs: []*orchestration.Job{
+			{ID: "design", Filename: "01-design.md"},
+			{ID: "impl", Filename: "02-implement.md"},
+		},
+	}
+
+	got, err := resolveDependencyRefs(plan, []string{"design", "02-implement.md", "01-design", "impl"})
+	if err != nil {
+		t.Fatalf("resolveDependencyRefs() error = %v", err)
+	}
+	want := []string{"01-design.md", "02-implement.md"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("resolveDependencyRefs() = %v, want %v", got, want)
+	}
+
+	_, err = resolveDependencyRefs(plan, []string{"design", "missing", "03-nope.md"})
+	if err == nil || !strings.Contains(err.Error(), "missing, 03-nope.md") {
+		t.Errorf("expected error naming unresolved references, got %v", err)
+	}
+}
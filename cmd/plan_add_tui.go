@@ -252,6 +252,13 @@ func initialModel(plan *orchestration.Plan, initialDeps []string) tuiModel {
 	m.jobTypeList.FilterInput.Prompt = " "
 	m.jobTypeList.FilterInput.PromptStyle = theme.DefaultTheme.Bold
 	m.jobTypeList.FilterInput.TextStyle = theme.DefaultTheme.Selected
+	// Start on the plan's default job type
+	for i, jobType := range jobTypes {
+		if string(jobType.(item)) == string(plan.Config.NewJobType()) {
+			m.jobTypeList.Select(i)
+			break
+		}
+	}
 
 	// 3. Dependencies Input (List with checkboxes)
 	m.selectedDeps = make(map[string]bool)
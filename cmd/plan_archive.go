@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/grovetools/core/fs"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// planArchiveDirName is the directory, next to the plans, that archived plans move into.
+const planArchiveDirName = ".archive"
+
+// archivedStatusKey records a plan's status from before it was archived, so
+// unarchive can restore it.
+const archivedStatusKey = "archived_status"
+
+// NewPlanArchiveCmd creates the `plan archive` command.
+func NewPlanArchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "archive <name>",
+		Short: "Mark a plan as finished and move it to the archive",
+		Long: `Marks a plan as finished and moves its directory into the .archive directory
+next to your plans, without any of the git or tmux cleanup done by 'flow plan finish'.
+
+Use 'flow plan unarchive' to bring it back.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanArchive,
+	}
+}
+
+// NewPlanUnarchiveCmd creates the `plan unarchive` command.
+func NewPlanUnarchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unarchive <name>",
+		Short: "Restore an archived plan",
+		Long: `Moves a plan out of the .archive directory back among your plans and restores
+the status it had before it was archived, so it shows up in 'flow plan list' again.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanUnarchive,
+	}
+}
+
+func runPlanArchive(cmd *cobra.Command, args []string) error {
+	planPath, err := resolvePlanPath(args[0])
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+	if info, err := os.Stat(planPath); err != nil || !info.IsDir() {
+		return fmt.Errorf("plan directory does not exist: %s", planPath)
+	}
+
+	priorStatus, err := getPlanStatus(planPath)
+	if err != nil {
+		return fmt.Errorf("reading plan status: %w", err)
+	}
+	archivePath := planArchivePath(planPath)
+	if err := movePlanDir(planPath, archivePath); err != nil {
+		return err
+	}
+	if err := updatePlanConfig(archivePath, func(config map[string]interface{}) {
+		config["status"] = "finished"
+		if priorStatus != "" && priorStatus != "finished" {
+			config[archivedStatusKey] = priorStatus
+		}
+	}); err != nil {
+		return fmt.Errorf("plan archived to %s but marking it as finished failed: %w", archivePath, err)
+	}
+
+	fmt.Printf("%s Archived plan '%s' to %s\n", theme.IconSuccess, filepath.Base(planPath), archivePath)
+	return nil
+}
+
+func runPlanUnarchive(cmd *cobra.Command, args []string) error {
+	planPath, err := resolvePlanPath(args[0])
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+	archivePath := planArchivePath(planPath)
+	if info, err := os.Stat(archivePath); err != nil || !info.IsDir() {
+		return fmt.Errorf("no archived plan found at %s", archivePath)
+	}
+
+	if err := movePlanDir(archivePath, planPath); err != nil {
+		return err
+	}
+	if err := updatePlanConfig(planPath, func(config map[string]interface{}) {
+		if prior, ok := config[archivedStatusKey].(string); ok && prior != "" {
+			config["status"] = prior
+		} else {
+			delete(config, "status")
+		}
+		delete(config, archivedStatusKey)
+	}); err != nil {
+		return fmt.Errorf("plan restored to %s but resetting its status failed: %w", planPath, err)
+	}
+
+	fmt.Printf("%s Restored plan '%s' to %s\n", theme.IconSuccess, filepath.Base(planPath), planPath)
+	return nil
+}
+
+// planArchivePath returns where planPath is moved to when archived.
+func planArchivePath(planPath string) string {
+	return filepath.Join(filepath.Dir(planPath), planArchiveDirName, filepath.Base(planPath))
+}
+
+// movePlanDir moves a plan directory, refusing to overwrite an existing one.
+// It falls back to copy and delete when a rename is not possible (e.g. across devices).
+func movePlanDir(src, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("destination already exists: %s", dst)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	if err := os.Rename(src, dst); err != nil {
+		if err := fs.CopyDir(src, dst); err != nil {
+			return fmt.Errorf("failed to copy plan directory: %w", err)
+		}
+		if err := os.RemoveAll(src); err != nil {
+			return fmt.Errorf("failed to remove original plan directory: %w", err)
+		}
+	}
+	return nil
+}
+
+// getPlanStatus returns the status in a plan's .grove-plan.yml, or "" if none is set.
+func getPlanStatus(planPath string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(planPath, ".grove-plan.yml"))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return "", err
+	}
+	status, _ := config["status"].(string)
+	return status, nil
+}
+
+// setPlanStatus sets the status in a plan's .grove-plan.yml, removing it when
+// status is empty. Other settings are preserved.
+func setPlanStatus(planPath, status string) error {
+	return updatePlanConfig(planPath, func(config map[string]interface{}) {
+		if status == "" {
+			delete(config, "status")
+		} else {
+			config["status"] = status
+		}
+	})
+}
+
+// updatePlanConfig applies update to a plan's .grove-plan.yml, creating the file
+// if needed. Settings update doesn't touch are preserved; nothing is written if
+// the file was empty and update leaves the config empty.
+func updatePlanConfig(planPath string, update func(config map[string]interface{})) error {
+	configPath := filepath.Join(planPath, ".grove-plan.yml")
+	data, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	var config map[string]interface{}
+	if len(data) > 0 {
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			return err
+		}
+	}
+	if config == nil {
+		config = make(map[string]interface{})
+	}
+	update(config)
+	if len(data) == 0 && len(config) == 0 {
+		return nil
+	}
+	newData, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(configPath, newData, 0644)
+}
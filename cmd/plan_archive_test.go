@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestArchiveHelpers(t *testing.T) {
+	plansDir := t.TempDir()
+	planPath := filepath.Join(plansDir, "my-plan")
+	if err := os.MkdirAll(planPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(planPath, ".grove-plan.yml")
+	if err := os.WriteFile(configPath, []byte("model: gemini-2.5-pro\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := setPlanStatus(planPath, "finished"); err != nil {
+		t.Fatalf("setPlanStatus() error = %v", err)
+	}
+	archivePath := planArchivePath(planPath)
+	if archivePath != filepath.Join(plansDir, ".archive", "my-plan") {
+		t.Errorf("planArchivePath() = %s", archivePath)
+	}
+	if err := movePlanDir(planPath, archivePath); err != nil {
+		t.Fatalf("movePlanDir() error = %v", err)
+	}
+	if _, err := os.Stat(planPath); !os.IsNotExist(err) {
+		t.Error("plan should no longer exist at its original path")
+	}
+
+	if err := os.MkdirAll(planPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := movePlanDir(archivePath, planPath); err == nil {
+		t.Error("movePlanDir() should refuse to overwrite an existing plan")
+	}
+	os.Remove(planPath)
+
+	if err := movePlanDir(archivePath, planPath); err != nil {
+		t.Fatalf("movePlanDir() back error = %v", err)
+	}
+	if err := setPlanStatus(planPath, ""); err != nil {
+		t.Fatalf("setPlanStatus() clear error = %v", err)
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "status") || !strings.Contains(string(data), "model: gemini-2.5-pro") {
+		t.Errorf("config after unarchive = %q, want status cleared and model kept", data)
+	}
+}
+
+func TestPlanArchiveRestoresPriorStatus(t *testing.T) {
+	plansDir := t.TempDir()
+	planPath := filepath.Join(plansDir, "my-plan")
+	if err := os.MkdirAll(planPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(planPath, ".grove-plan.yml")
+	if err := os.WriteFile(configPath, []byte("status: review\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runPlanArchive(nil, []string{planPath}); err != nil {
+		t.Fatalf("runPlanArchive() error = %v", err)
+	}
+	status, err := getPlanStatus(planArchivePath(planPath))
+	if err != nil || status != "finished" {
+		t.Fatalf("archived status = %q, %v; want finished", status, err)
+	}
+
+	if err := runPlanUnarchive(nil, []string{planPath}); err != nil {
+		t.Fatalf("runPlanUnarchive() error = %v", err)
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "status: review\n" {
+		t.Errorf("config after unarchive = %q, want the prior status restored", data)
+	}
+}
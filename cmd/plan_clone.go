@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var planCloneWorktree string
+
+// NewPlanCloneCmd creates the `plan clone` command.
+func NewPlanCloneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clone <source> <dest>",
+		Short: "Start a new plan from a copy of an existing one",
+		Long: `Copy a plan's job files and .grove-plan.yml into a new plan.
+
+Every job gets a new unique ID (depends_on references are remapped), its
+status is reset to pending, and any output appended to the job file is removed.
+The plan's review/finished status is not copied.
+
+Use --worktree to point the new plan, and the jobs that used a worktree, at a
+fresh git worktree, which is created if it does not exist.
+
+Examples:
+  flow plan clone auth-refactor auth-refactor-v2
+  flow plan clone auth-refactor billing-refactor --worktree billing-refactor`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanClone,
+	}
+	cmd.Flags().StringVar(&planCloneWorktree, "worktree", "", "Create and use this git worktree for the cloned plan")
+	return cmd
+}
+
+func runPlanClone(cmd *cobra.Command, args []string) error {
+	srcPath, err := resolvePlanPath(args[0])
+	if err != nil {
+		return fmt.Errorf("could not resolve source plan: %w", err)
+	}
+	destPath, err := resolvePlanPath(args[1])
+	if err != nil {
+		return fmt.Errorf("could not resolve destination plan: %w", err)
+	}
+
+	src, err := orchestration.LoadPlan(srcPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	files, err := orchestration.ClonePlan(src, destPath, planCloneWorktree)
+	if err != nil {
+		return fmt.Errorf("failed to clone plan: %w", err)
+	}
+	fmt.Printf("%s Cloned %d job(s) from '%s' into %s\n", theme.IconSuccess, len(files), src.Name, destPath)
+
+	if planCloneWorktree != "" {
+		var workspacePath string
+		if node, err := workspace.GetProjectByPath("."); err == nil {
+			workspacePath = node.Path
+		}
+		var repos []string
+		if src.Config != nil {
+			repos = src.Config.Repos
+		}
+		worktreePath, err := createWorktreeIfRequested(planCloneWorktree, repos, workspacePath)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s Using worktree: %s\n", theme.IconSuccess, worktreePath)
+	}
+
+	fmt.Printf("\nNext: flow plan run %s\n", filepath.Base(destPath))
+	return nil
+}
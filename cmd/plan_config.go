@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/grovetools/flow/pkg/orchestration"
 	"github.com/spf13/cobra"
@@ -39,12 +40,16 @@ Examples:
   # Set multiple values
   flow plan config myplan --set model=gemini-2.0-flash --set worktree=feature/new
   
+  # Set execution defaults for oneshot jobs in the plan
+  flow plan config myplan --set timeout=10m --set retry_count=1
+  
   # Get a value
   flow plan config myplan --get model
   
   # Show all configuration
   flow plan config myplan`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var dir string
 			if len(args) > 0 {
@@ -224,6 +229,25 @@ func setConfigValues(configPath string, pairs []string) error {
 				return fmt.Errorf("invalid boolean value for prepend_dependencies: %s", value)
 			}
 			config[key] = boolVal
+		case "timeout":
+			if value == "" {
+				delete(config, key)
+				continue
+			}
+			if _, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("invalid duration value for timeout: %s", value)
+			}
+			config[key] = value
+		case "retry_count":
+			if value == "" {
+				delete(config, key)
+				continue
+			}
+			intVal, err := strconv.Atoi(value)
+			if err != nil || intVal < 0 {
+				return fmt.Errorf("invalid non-negative integer value for retry_count: %s", value)
+			}
+			config[key] = intVal
 		case "repos":
 			// Handle array - split by comma
 			if value == "" {
@@ -259,6 +283,10 @@ func setConfigValues(configPath string, pairs []string) error {
 		parts := strings.SplitN(pair, "=", 2)
 		key := strings.TrimSpace(parts[0])
 		value := strings.TrimSpace(parts[1])
+		// Execution tuning is resolved at run time, so it stays a plan-level default
+		if key == "timeout" || key == "retry_count" {
+			continue
+		}
 		if value != "" {
 			updatesToPropagate[key] = value
 		}
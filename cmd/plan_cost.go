@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/grovetools/core/cli"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var planCostModel string
+
+// planCostReport is the JSON shape of `flow plan cost`.
+type planCostReport struct {
+	Jobs    []*orchestration.PromptEstimate `json:"jobs"`
+	Models  map[string]int                  `json:"models"`
+	Total   int                             `json:"total_tokens_est"`
+	Skipped []string                        `json:"skipped,omitempty"`
+}
+
+// NewPlanCostCmd creates the `plan cost` command.
+func NewPlanCostCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cost [directory]",
+		Short: "Estimate input tokens for pending oneshot jobs",
+		Long: `Assemble the prompt for each pending oneshot job and estimate its input
+token count, grouped by the model the job would run with. No LLM is called.
+
+Context files are counted with the same token statistics 'cx stats' reports;
+the prompt text itself is estimated at roughly 4 characters per token.
+Output from dependencies that have not run yet is not included.
+If no directory is specified, uses the active job if set.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanCost,
+	}
+	cmd.Flags().StringVar(&planCostModel, "model", "", "Estimate as if run with this model override (same as 'flow run --model')")
+	return cmd
+}
+
+func runPlanCost(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	flowCfg, err := loadFlowConfig()
+	if err != nil {
+		return err
+	}
+	plan.Orchestration = flowCfg.orchestrationConfig()
+
+	executor := orchestration.NewOneShotExecutor(orchestration.NewMockLLMClient(), &orchestration.ExecutorConfig{
+		ModelOverride: planCostModel,
+	})
+
+	report := planCostReport{Models: make(map[string]int)}
+	for _, job := range plan.GetJobsSortedByFilename() {
+		if job.Status != orchestration.JobStatusPending {
+			continue
+		}
+		if job.Type != orchestration.JobTypeOneshot {
+			report.Skipped = append(report.Skipped, job.Filename)
+			continue
+		}
+
+		estimate, err := executor.EstimatePrompt(job, plan)
+		if err != nil {
+			return fmt.Errorf("estimating %s: %w", job.Filename, err)
+		}
+		report.Jobs = append(report.Jobs, estimate)
+		report.Models[estimate.Model] += estimate.Tokens
+		report.Total += estimate.Tokens
+	}
+
+	if cli.GetOptions(cmd).JSONOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	if len(report.Jobs) == 0 {
+		fmt.Println("No pending oneshot jobs to estimate.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "JOB\tMODEL\tFILES\tTOKENS (EST)")
+	for _, est := range report.Jobs {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", est.Filename, est.Model, est.Files, formatTokenEstimate(est.Tokens))
+	}
+	w.Flush()
+
+	models := make([]string, 0, len(report.Models))
+	for model := range report.Models {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODEL\tTOKENS (EST)")
+	for _, model := range models {
+		fmt.Fprintf(w, "%s\t%s\n", model, formatTokenEstimate(report.Models[model]))
+	}
+	fmt.Fprintf(w, "TOTAL\t%s\n", formatTokenEstimate(report.Total))
+	w.Flush()
+
+	if len(report.Skipped) > 0 {
+		fmt.Printf("\nSkipped %d pending non-oneshot job(s)\n", len(report.Skipped))
+	}
+	return nil
+}
+
+// formatTokenEstimate renders a token count compactly, e.g. 12.3k or 1.2M.
+func formatTokenEstimate(tokens int) string {
+	switch {
+	case tokens >= 1000000:
+		return fmt.Sprintf("~%.1fM", float64(tokens)/1000000)
+	case tokens >= 1000:
+		return fmt.Sprintf("~%.1fk", float64(tokens)/1000)
+	default:
+		return fmt.Sprintf("~%d", tokens)
+	}
+}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/grovetools/core/cli"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var planDepsTree bool
+
+// NewPlanDepsCmd creates the `plan deps` command.
+func NewPlanDepsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deps <job> [directory]",
+		Short: "Show what a job depends on and what depends on it",
+		Long: `Show the transitive upstream (prerequisites) and downstream (dependents)
+jobs for a job, with their statuses, and whether the job can run now.
+
+The job can be given by ID or filename. Use --tree to show the dependency
+chains with indentation instead of flat lists. If no directory is specified,
+uses the active job if set.
+
+Examples:
+  flow plan deps 03-implement.md
+  flow plan deps implement-auth my-plan --tree`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeJobThenPlanArgs,
+		RunE:              runPlanDeps,
+	}
+	cmd.Flags().BoolVar(&planDepsTree, "tree", false, "Show upstream and downstream jobs as indented trees")
+	return cmd
+}
+
+// depsJobJSON describes a related job in `plan deps --json` output.
+type depsJobJSON struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Status   string `json:"status"`
+}
+
+func runPlanDeps(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 1 {
+		dir = args[1]
+	}
+
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	job, found := plan.GetJobByID(args[0])
+	if !found {
+		job, found = plan.GetJobByFilename(args[0])
+	}
+	if !found {
+		return fmt.Errorf("job '%s' not found in plan '%s'", args[0], plan.Name)
+	}
+
+	graph, err := orchestration.BuildDependencyGraph(plan)
+	if err != nil {
+		return fmt.Errorf("invalid dependency graph: %w", err)
+	}
+
+	upstream := jobsInPlanOrder(plan, graph.Upstream(job.ID))
+	downstream := jobsInPlanOrder(plan, graph.Downstream(job.ID))
+	runnable := job.IsRunnable()
+
+	if cli.GetOptions(cmd).JSONOutput {
+		output := struct {
+			Job        string        `json:"job"`
+			Status     string        `json:"status"`
+			Runnable   bool          `json:"runnable"`
+			Waiting    string        `json:"waiting,omitempty"`
+			Upstream   []depsJobJSON `json:"upstream"`
+			Downstream []depsJobJSON `json:"downstream"`
+		}{
+			Job:        job.Filename,
+			Status:     string(job.Status),
+			Runnable:   runnable,
+			Waiting:    job.WaitReason(),
+			Upstream:   toDepsJSON(upstream),
+			Downstream: toDepsJSON(downstream),
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	}
+
+	fmt.Printf("%s %s (%s)\n", colorizeStatus(job.Status), job.Filename, job.Status)
+	switch {
+	case runnable:
+		fmt.Println("Runnable: yes")
+	case job.WaitReason() != "":
+		fmt.Printf("Runnable: no (%s)\n", job.WaitReason())
+	default:
+		fmt.Printf("Runnable: no (job is %s)\n", job.Status)
+	}
+
+	fmt.Printf("\nUpstream (%d):\n", len(upstream))
+	if planDepsTree {
+		printDepsTree(job.ID, 1, func(id string) []string {
+			j, _ := plan.GetJobByID(id)
+			var ids []string
+			for _, dep := range j.Dependencies {
+				if dep != nil {
+					ids = append(ids, dep.ID)
+				}
+			}
+			return ids
+		}, plan)
+	} else {
+		printDepsList(upstream)
+	}
+
+	fmt.Printf("\nDownstream (%d):\n", len(downstream))
+	if planDepsTree {
+		printDepsTree(job.ID, 1, graph.Dependents, plan)
+	} else {
+		printDepsList(downstream)
+	}
+
+	return nil
+}
+
+// jobsInPlanOrder returns the plan's jobs whose IDs are in ids, in plan order.
+func jobsInPlanOrder(plan *orchestration.Plan, ids []string) []*orchestration.Job {
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	var jobs []*orchestration.Job
+	for _, job := range plan.Jobs {
+		if wanted[job.ID] {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+func toDepsJSON(jobs []*orchestration.Job) []depsJobJSON {
+	result := make([]depsJobJSON, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, depsJobJSON{ID: job.ID, Filename: job.Filename, Status: string(job.Status)})
+	}
+	return result
+}
+
+func printDepsList(jobs []*orchestration.Job) {
+	if len(jobs) == 0 {
+		fmt.Println("  (none)")
+		return
+	}
+	for _, job := range jobs {
+		fmt.Printf("  %s %s %s\n", colorizeStatus(job.Status), job.Filename, renderMuted("("+string(job.Status)+")"))
+	}
+}
+
+// printDepsTree prints the jobs reachable from id through next, one level of
+// indentation per hop. Jobs reachable along several paths appear under each.
+func printDepsTree(id string, depth int, next func(string) []string, plan *orchestration.Plan) {
+	children := next(id)
+	if depth == 1 && len(children) == 0 {
+		fmt.Println("  (none)")
+		return
+	}
+	for _, childID := range children {
+		child, found := plan.GetJobByID(childID)
+		if !found {
+			continue
+		}
+		fmt.Printf("%s%s %s %s\n", strings.Repeat("  ", depth), colorizeStatus(child.Status), child.Filename, renderMuted("("+string(child.Status)+")"))
+		printDepsTree(childID, depth+1, next, plan)
+	}
+}
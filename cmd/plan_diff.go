@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var planDiffStat bool
+
+// NewPlanDiffCmd creates the `plan diff` command.
+func NewPlanDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [directory]",
+		Short: "Show the changes made in a plan's worktree",
+		Long: `Show the changes made on a plan's worktree branch, as 'git diff main...HEAD'
+run inside the worktree configured in the plan's .grove-plan.yml.
+
+For ecosystem worktrees (plans with 'repos'), the diff is shown for each repository.
+If no directory is specified, uses the active job if set.
+
+Examples:
+  flow plan diff
+  flow plan diff my-feature --stat`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanDiff,
+	}
+	cmd.Flags().BoolVar(&planDiffStat, "stat", false, "Show a diffstat instead of the full diff")
+	return cmd
+}
+
+func runPlanDiff(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	if plan.Config == nil || plan.Config.Worktree == "" {
+		return fmt.Errorf("plan '%s' has no worktree configured", plan.Name)
+	}
+	worktreeName := plan.Config.Worktree
+
+	// Resolve the main repository from the current directory, which may be
+	// inside one of its worktrees
+	cwd, _ := os.Getwd()
+	if _, err := mainRepoRoot(cwd); err != nil {
+		return fmt.Errorf("could not find git root: %w", err)
+	}
+
+	worktreePath, err := planWorktreePath(plan, worktreeName)
+	if err != nil {
+		fmt.Printf("%s %v\n", theme.IconWarning, err)
+		fmt.Println("It may have been removed by 'flow plan finish'. Nothing to diff.")
+		return nil
+	}
+
+	if len(plan.Config.Repos) == 0 {
+		return runWorktreeDiff(worktreePath)
+	}
+
+	for _, repo := range plan.Config.Repos {
+		repoPath := filepath.Join(worktreePath, repo)
+		fmt.Printf("\n%s %s\n", theme.IconInfo, repo)
+		if _, err := os.Stat(repoPath); os.IsNotExist(err) {
+			fmt.Printf("  %s not present in worktree, skipping\n", theme.IconWarning)
+			continue
+		}
+		if err := runWorktreeDiff(repoPath); err != nil {
+			fmt.Printf("  %s %v\n", theme.IconError, err)
+		}
+	}
+	return nil
+}
+
+// runWorktreeDiff prints `git diff main...HEAD` for the repository at path.
+func runWorktreeDiff(path string) error {
+	gitArgs := []string{"-C", path, "diff"}
+	if planDiffStat {
+		gitArgs = append(gitArgs, "--stat")
+	}
+	gitArgs = append(gitArgs, "main...HEAD")
+
+	diffCmd := exec.Command("git", gitArgs...)
+	diffCmd.Stdout = os.Stdout
+	diffCmd.Stderr = os.Stderr
+	if err := diffCmd.Run(); err != nil {
+		return fmt.Errorf("git diff failed in %s: %w", path, err)
+	}
+	return nil
+}
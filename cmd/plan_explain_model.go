@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/grovetools/core/cli"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var planExplainModelOverride string
+
+// NewPlanExplainModelCmd creates the `plan explain-model` command.
+func NewPlanExplainModelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain-model <job> [directory]",
+		Short: "Show which model a job would run with and why",
+		Long: `Show every level of the model precedence chain for a job and which one wins:
+CLI override, job frontmatter, plan config, global config, and the default.
+Nothing is run.
+
+The job can be given by ID or filename. Pass --model to see the effect of
+'flow run --model'. If no directory is specified, uses the active job if set.
+
+Examples:
+  flow plan explain-model 02-implement.md
+  flow plan explain-model implement-auth my-plan --model gemini-2.5-pro`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeJobThenPlanArgs,
+		RunE:              runPlanExplainModel,
+	}
+	cmd.Flags().StringVar(&planExplainModelOverride, "model", "", "Explain as if run with this model override (same as 'flow run --model')")
+	return cmd
+}
+
+func runPlanExplainModel(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 1 {
+		dir = args[1]
+	}
+
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	flowCfg, err := loadFlowConfig()
+	if err != nil {
+		return err
+	}
+	plan.Orchestration = flowCfg.orchestrationConfig()
+
+	job, found := plan.GetJobByID(args[0])
+	if !found {
+		job, found = plan.GetJobByFilename(args[0])
+	}
+	if !found {
+		return fmt.Errorf("job '%s' not found in plan '%s'", args[0], plan.Name)
+	}
+
+	sources, effectiveModel := orchestration.ExplainModel(job, plan, planExplainModelOverride)
+
+	if cli.GetOptions(cmd).JSONOutput {
+		output := struct {
+			Job            string                      `json:"job"`
+			Sources        []orchestration.ModelSource `json:"sources"`
+			EffectiveModel string                      `json:"effective_model"`
+		}{
+			Job:            job.Filename,
+			Sources:        sources,
+			EffectiveModel: effectiveModel,
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	}
+
+	fmt.Printf("Model resolution for %s (%s job):\n\n", job.Filename, job.Type)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "\tSOURCE\tVALUE")
+	for _, source := range sources {
+		marker, value := "", source.Value
+		if source.Selected {
+			marker = "→"
+		}
+		if value == "" {
+			value = "(not set)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", marker, source.Name, value)
+	}
+	w.Flush()
+
+	fmt.Printf("\nEffective model: %s\n", effectiveModel)
+	if job.Type != orchestration.JobTypeOneshot && job.Type != orchestration.JobTypeChat {
+		fmt.Printf("Note: %s jobs do not use this precedence chain when run.\n", job.Type)
+	}
+	return nil
+}
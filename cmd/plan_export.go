@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var (
+	planExportIncludeBriefings bool
+	planImportName             string
+)
+
+// NewPlanExportCmd creates the `plan export` command.
+func NewPlanExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <name> <out.tar.gz>",
+		Short: "Bundle a plan as a portable archive",
+		Long: `Package a plan's job files and .grove-plan.yml into a gzipped tarball that
+can be shared and unpacked with 'flow plan import'.
+
+Absolute paths in job frontmatter (include, source_file, rules_file and
+output.path) are made relative to the plan directory or the current git root
+where possible. Use --include-briefings to also bundle the briefing files saved
+for each job's runs.
+
+Examples:
+  flow plan export auth-refactor auth-refactor.tar.gz
+  flow plan export auth-refactor auth-refactor.tar.gz --include-briefings`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanExport,
+	}
+	cmd.Flags().BoolVar(&planExportIncludeBriefings, "include-briefings", false, "Also bundle each job's briefing files")
+	return cmd
+}
+
+// NewPlanImportCmd creates the `plan import` command.
+func NewPlanImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <archive>",
+		Short: "Create a plan from an archive made by 'plan export'",
+		Long: `Unpack an archive made by 'flow plan export' into the plans directory.
+
+The plan keeps the name it was exported with unless --name is given. As with
+'flow plan clone', every job gets a new unique ID (depends_on references are
+remapped) and its status is reset to pending.
+
+Examples:
+  flow plan import auth-refactor.tar.gz
+  flow plan import auth-refactor.tar.gz --name auth-refactor-review`,
+		Args: cobra.ExactArgs(1),
+		RunE: runPlanImport,
+	}
+	cmd.Flags().StringVar(&planImportName, "name", "", "Name for the imported plan (defaults to the exported plan's name)")
+	return cmd
+}
+
+func runPlanExport(cmd *cobra.Command, args []string) error {
+	planPath, err := resolvePlanPath(args[0])
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	outPath := args[1]
+	if _, err := os.Stat(outPath); err == nil {
+		return fmt.Errorf("%s already exists", outPath)
+	}
+	out, err := os.Create(outPath)
+	if err != nil {
+		return fmt.Errorf("creating archive: %w", err)
+	}
+	written, err := orchestration.ExportPlan(plan, out, planExportIncludeBriefings)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(outPath)
+		return fmt.Errorf("failed to export plan: %w", err)
+	}
+
+	fmt.Printf("%s Exported plan '%s' (%d file(s)) to %s\n", theme.IconSuccess, plan.Name, len(written), outPath)
+	return nil
+}
+
+func runPlanImport(cmd *cobra.Command, args []string) error {
+	archive, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("opening archive: %w", err)
+	}
+	defer archive.Close()
+
+	tmpDir, err := os.MkdirTemp("", "flow-plan-import-")
+	if err != nil {
+		return fmt.Errorf("creating temp directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	extracted, err := orchestration.ExtractPlanArchive(archive, tmpDir)
+	if err != nil {
+		return fmt.Errorf("failed to unpack archive: %w", err)
+	}
+	src, err := orchestration.LoadPlan(extracted)
+	if err != nil {
+		return fmt.Errorf("failed to load archived plan: %w", err)
+	}
+
+	name := planImportName
+	if name == "" {
+		name = filepath.Base(extracted)
+	}
+	if err := validateDirectoryName(name); err != nil {
+		return err
+	}
+	destPath, err := resolvePlanPath(name)
+	if err != nil {
+		return fmt.Errorf("could not resolve destination plan: %w", err)
+	}
+
+	files, err := orchestration.ImportPlan(src, destPath)
+	if err != nil {
+		return fmt.Errorf("failed to import plan: %w", err)
+	}
+	fmt.Printf("%s Imported %d job(s) into %s\n", theme.IconSuccess, len(files), destPath)
+	fmt.Printf("\nNext: flow plan run %s\n", name)
+	return nil
+}
@@ -12,10 +12,8 @@ import (
 	"text/template"
 
 	"github.com/fatih/color"
-	"github.com/grovetools/core/fs"
 	"github.com/grovetools/core/git"
 	"github.com/grovetools/core/pkg/workspace"
-	"github.com/grovetools/core/state"
 	"github.com/grovetools/core/util/sanitize"
 	gexec "github.com/grovetools/flow/pkg/exec"
 	"github.com/grovetools/flow/pkg/orchestration"
@@ -34,6 +32,8 @@ var (
 	planFinishCleanDevLinks   bool
 	planFinishRebuildBinaries bool
 	planFinishForce           bool
+	planFinishArchiveOnly     bool
+	planFinishDryRun          bool
 )
 
 // repoStatus represents the merge status of a single repository
@@ -44,6 +44,7 @@ type repoStatus struct {
 
 // cleanupItem represents a cleanup action that can be performed
 type cleanupItem struct {
+	Key         string // Stable identifier, for selecting items without relying on their order
 	Name        string
 	Check       func() (string, error)
 	Action      func() error
@@ -51,6 +52,7 @@ type cleanupItem struct {
 	IsAvailable bool
 	IsEnabled   bool
 	Details     []repoStatus // Optional detailed status information for complex items
+	Target      string       // What the action operates on (path, branch, session), shown by --dry-run
 }
 
 // parseGitmodules reads and parses the .gitmodules file
@@ -171,9 +173,14 @@ func NewPlanFinishCmd() *cobra.Command {
 		Use:   "finish [directory]",
 		Short: "Finish and clean up a plan and its associated worktree (use: flow finish)",
 		Long: `Guides through the process of cleaning up a completed plan.
-This can include removing the git worktree, deleting the branch, closing tmux sessions, and archiving the plan.`,
-		Args: cobra.MaximumNArgs(1),
-		RunE: runPlanFinish,
+This can include removing the git worktree, deleting the branch, closing tmux sessions, and archiving the plan.
+
+Use --archive-only for plans without a worktree: it only marks the plan as
+finished and archives the plan directory. Use --dry-run to preview which
+actions would run, and on which worktrees and branches, before running them.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanFinish,
 	}
 
 	cmd.Flags().BoolVarP(&planFinishYes, "yes", "y", false, "Automatically confirm all cleanup actions")
@@ -185,6 +192,8 @@ This can include removing the git worktree, deleting the branch, closing tmux se
 	cmd.Flags().BoolVar(&planFinishRebuildBinaries, "rebuild-binaries", false, "Rebuild binaries in the main repository")
 	cmd.Flags().BoolVar(&planFinishArchive, "archive", false, "Archive the plan directory to a local .archive subdirectory")
 	cmd.Flags().BoolVar(&planFinishForce, "force", false, "Force git operations (use with caution)")
+	cmd.Flags().BoolVar(&planFinishArchiveOnly, "archive-only", false, "Only mark the plan as finished and archive it, skipping all git and tmux cleanup")
+	cmd.Flags().BoolVar(&planFinishDryRun, "dry-run", false, "Show which cleanup actions would run and on what, without running them")
 
 	return cmd
 }
@@ -195,9 +204,14 @@ func NewFinishCmd() *cobra.Command {
 		Use:   "finish [directory]",
 		Short: "Finish and clean up a plan and its associated worktree",
 		Long: `Guides through the process of cleaning up a completed plan.
-This can include removing the git worktree, deleting the branch, closing tmux sessions, and archiving the plan.`,
-		Args: cobra.MaximumNArgs(1),
-		RunE: runPlanFinish,
+This can include removing the git worktree, deleting the branch, closing tmux sessions, and archiving the plan.
+
+Use --archive-only for plans without a worktree: it only marks the plan as
+finished and archives the plan directory. Use --dry-run to preview which
+actions would run, and on which worktrees and branches, before running them.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanFinish,
 	}
 
 	cmd.Flags().BoolVarP(&planFinishYes, "yes", "y", false, "Automatically confirm all cleanup actions")
@@ -209,6 +223,8 @@ This can include removing the git worktree, deleting the branch, closing tmux se
 	cmd.Flags().BoolVar(&planFinishRebuildBinaries, "rebuild-binaries", false, "Rebuild binaries in the main repository")
 	cmd.Flags().BoolVar(&planFinishArchive, "archive", false, "Archive the plan directory to a local .archive subdirectory")
 	cmd.Flags().BoolVar(&planFinishForce, "force", false, "Force git operations (use with caution)")
+	cmd.Flags().BoolVar(&planFinishArchiveOnly, "archive-only", false, "Only mark the plan as finished and archive it, skipping all git and tmux cleanup")
+	cmd.Flags().BoolVar(&planFinishDryRun, "dry-run", false, "Show which cleanup actions would run and on what, without running them")
 	return cmd
 }
 
@@ -270,13 +286,19 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 
 	branchName := worktreeName // Simple assumption: branch name matches worktree name
 	sessionName := sanitize.SanitizeForTmuxSession(worktreeName)
+	worktreeTarget := filepath.Join(gitRoot, ".grove-worktrees", worktreeName)
+	if plan.Config != nil && len(plan.Config.Repos) > 0 {
+		worktreeTarget += fmt.Sprintf(" (repos: %s)", strings.Join(plan.Config.Repos, ", "))
+	}
 
 	// Define cleanup items
 	// Use a shared variable for repo details that the Check function can populate
 	var sharedRepoDetails []repoStatus
 
 	mergeItem := &cleanupItem{
-		Name: "Merge/fast-forward submodules to main",
+		Key:    "merge-submodules",
+		Name:   "Merge/fast-forward submodules to main",
+		Target: gitRoot,
 		Check: func() (string, error) {
 			if worktreeName == "" || gitRoot == "" {
 				return "N/A", nil
@@ -533,6 +555,7 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 	items := []*cleanupItem{
 		mergeItem,
 		{
+			Key:  "docker-cleanup",
 			Name: "Cleanup Docker Compose environment",
 			Check: func() (string, error) {
 				// Check if plan was created from a recipe with Docker Compose actions
@@ -666,7 +689,9 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 			},
 		},
 		{
-			Name: "Mark plan as finished in .grove-plan.yml",
+			Key:    "mark-finished",
+			Name:   "Mark plan as finished in .grove-plan.yml",
+			Target: filepath.Join(planPath, ".grove-plan.yml"),
 			Check: func() (string, error) {
 				configPath := filepath.Join(planPath, ".grove-plan.yml")
 				data, err := os.ReadFile(configPath)
@@ -683,30 +708,13 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 				return color.YellowString("Available"), nil
 			},
 			Action: func() error {
-				configPath := filepath.Join(planPath, ".grove-plan.yml")
-				data, err := os.ReadFile(configPath)
-				if err != nil && !os.IsNotExist(err) {
-					return err
-				}
-				var config map[string]interface{}
-				if len(data) > 0 {
-					if err := yaml.Unmarshal(data, &config); err != nil {
-						return err
-					}
-				}
-				if config == nil {
-					config = make(map[string]interface{})
-				}
-				config["status"] = "finished"
-				newData, err := yaml.Marshal(config)
-				if err != nil {
-					return err
-				}
-				return os.WriteFile(configPath, newData, 0644)
+				return setPlanStatus(planPath, "finished")
 			},
 		},
 		{
-			Name: "Close tmux session",
+			Key:    "close-session",
+			Name:   "Close tmux session",
+			Target: "session " + sessionName,
 			Check: func() (string, error) {
 				if sessionName == "" {
 					return "N/A", nil
@@ -722,7 +730,9 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 			},
 		},
 		{
-			Name: "Prune git worktree",
+			Key:    "prune-worktree",
+			Name:   "Prune git worktree",
+			Target: worktreeTarget,
 			Check: func() (string, error) {
 				if worktreeName == "" || gitRoot == "" {
 					return "N/A", nil
@@ -747,11 +757,14 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 				}
 				return "Not found", nil
 			},
-			Action: func() error {
+			Action: reportReclaimedSpace(filepath.Join(gitRoot, ".grove-worktrees", worktreeName), func() error {
 				worktreePath := filepath.Join(gitRoot, ".grove-worktrees", worktreeName)
 				
 				// Check if this is an ecosystem worktree (has repos configuration)
 				if plan.Config != nil && len(plan.Config.Repos) > 0 {
+					if !planFinishYes && !confirmEcosystemCleanup(worktreeName, plan.Config.Repos, provider) {
+						return fmt.Errorf("ecosystem worktree cleanup not confirmed")
+					}
 					return cleanupEcosystemWorktree(context.Background(), gitRoot, worktreeName, plan.Config.Repos, provider)
 				}
 				
@@ -818,10 +831,12 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 				}
 				
 				return err
-			},
+			}),
 		},
 		{
-			Name: "Clean up dev binaries from worktree",
+			Key:    "clean-dev-links",
+			Name:   "Clean up dev binaries from worktree",
+			Target: "grove dev prune",
 			Check: func() (string, error) {
 				// Check if grove dev is available
 				if _, err := exec.LookPath("grove"); err != nil {
@@ -841,7 +856,9 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 			},
 		},
 		{
-			Name: "Delete submodule branches",
+			Key:    "delete-submodule-branches",
+			Name:   "Delete submodule branches",
+			Target: fmt.Sprintf("branch %s in submodules of %s", branchName, gitRoot),
 			Check: func() (string, error) {
 				if branchName == "" || gitRoot == "" {
 					return "N/A", nil
@@ -925,7 +942,9 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 			},
 		},
 		{
-			Name: "Delete local git branch",
+			Key:    "delete-branch",
+			Name:   "Delete local git branch",
+			Target: fmt.Sprintf("branch %s in %s", branchName, gitRoot),
 			Check: func() (string, error) {
 				if branchName == "" || gitRoot == "" {
 					return "N/A", nil
@@ -991,7 +1010,9 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 			},
 		},
 		{
-			Name: "Delete remote git branch",
+			Key:    "delete-remote-branch",
+			Name:   "Delete remote git branch",
+			Target: "origin/" + branchName,
 			Check: func() (string, error) {
 				if branchName == "" || gitRoot == "" {
 					return "N/A", nil
@@ -1015,7 +1036,9 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 			},
 		},
 		{
-			Name: "Rebuild main repo binaries",
+			Key:    "rebuild-binaries",
+			Name:   "Rebuild main repo binaries",
+			Target: fmt.Sprintf("make build in %s", gitRoot),
 			Check: func() (string, error) {
 				if gitRoot == "" {
 					return "N/A", nil
@@ -1045,48 +1068,36 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 			},
 		},
 		{
-			Name: "Archive plan directory",
+			Key:    "archive",
+			Name:   "Archive plan directory",
+			Target: fmt.Sprintf("%s -> %s", planPath, planArchivePath(planPath)),
 			Check: func() (string, error) {
 				// Archiving is available for any plan
 				return color.YellowString("Available"), nil
 			},
 			Action: func() error {
-				// Get the parent directory of the plan (e.g., .../plans/)
-				plansParentDir := filepath.Dir(planPath)
-				planName := filepath.Base(planPath)
-
-				// Create the .archive subdirectory in the plans parent directory
-				archiveDir := filepath.Join(plansParentDir, ".archive")
-				if err := os.MkdirAll(archiveDir, 0755); err != nil {
-					return fmt.Errorf("failed to create archive directory: %w", err)
-				}
-
-				// Determine the destination path
-				archivePath := filepath.Join(archiveDir, planName)
-
-				// Check if destination already exists
-				if _, err := os.Stat(archivePath); err == nil {
-					return fmt.Errorf("archive destination already exists: %s", archivePath)
-				}
-
-				// Move the directory (rename is more efficient than copy+delete)
-				if err := os.Rename(planPath, archivePath); err != nil {
-					// If rename fails (e.g., cross-device), fall back to copy
-					if err := fs.CopyDir(planPath, archivePath); err != nil {
-						return fmt.Errorf("failed to copy plan to archive: %w", err)
-					}
-					// Remove original after successful copy
-					if err := os.RemoveAll(planPath); err != nil {
-						return fmt.Errorf("failed to remove original plan directory: %w", err)
-					}
+				archivePath := planArchivePath(planPath)
+				if err := movePlanDir(planPath, archivePath); err != nil {
+					return err
 				}
-
 				fmt.Printf("    Archived plan to: %s\n", archivePath)
 				return nil
 			},
 		},
 	}
 
+	// --archive-only keeps just the notebook steps so plans without a worktree
+	// aren't shown a list of N/A git and tmux items.
+	if planFinishArchiveOnly {
+		var kept []*cleanupItem
+		for _, item := range items {
+			if item.Key == "mark-finished" || item.Key == "archive" {
+				kept = append(kept, item)
+			}
+		}
+		items = kept
+	}
+
 	// Populate status and availability
 	for _, item := range items {
 		status, _ := item.Check()
@@ -1114,7 +1125,7 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 	// Check if branch exists and is merged (no commits ahead of main)
 	branchIsMerged := false
 	branchExists := false
-	if branchName != "" && gitRoot != "" {
+	if branchName != "" && gitRoot != "" && !planFinishArchiveOnly {
 		// First check if the branch exists
 		branchCheckCmd := exec.Command("git", "-C", gitRoot, "show-ref", "--verify", "--quiet", "refs/heads/"+branchName)
 		if branchCheckCmd.Run() == nil {
@@ -1143,7 +1154,7 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 
 	// Determine which items to enable
 	anyExplicitFlags := planFinishDeleteBranch || planFinishDeleteRemote || planFinishPruneWorktree || planFinishCloseSession || planFinishCleanDevLinks || planFinishRebuildBinaries || planFinishArchive || planFinishForce
-	if planFinishYes {
+	if planFinishYes || planFinishArchiveOnly || (planFinishDryRun && !anyExplicitFlags) {
 		for _, item := range items {
 			item.IsEnabled = item.IsAvailable
 		}
@@ -1172,49 +1183,32 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if planFinishDryRun {
+		printFinishDryRun(plan, items)
+		return nil
+	}
+
 	// Execute on_finish hook before marking as finished
 	if plan.Config != nil && plan.Config.Status == "review" {
-		// Find the first job with a note_ref
-		var noteRef string
-		for _, job := range plan.Jobs {
-			if job.NoteRef != "" {
-				noteRef = job.NoteRef
-				break
-			}
-		}
-
 		// Execute on_finish hook if it exists
 		if plan.Config.Hooks != nil {
 			if hookCmdStr, ok := plan.Config.Hooks["on_finish"]; ok && hookCmdStr != "" {
 				fmt.Println("▶️  Executing on_finish hook...")
 
-				// Prepare template data
-				templateData := struct {
-					PlanName string
-					NoteRef  string
-				}{
-					PlanName: planName,
-					NoteRef:  noteRef,
-				}
-
-				// Render the hook command
-				tmpl, err := template.New("hook").Parse(hookCmdStr)
+				hookData := orchestration.NewPlanHookData(plan)
+				hookData.PlanName = planName
+				renderedCmd, err := orchestration.RenderHookCommand(hookCmdStr, hookData)
 				if err != nil {
-					fmt.Printf("Warning: failed to parse on_finish hook template: %v\n", err)
+					fmt.Printf("Warning: failed to render on_finish hook command: %v\n", err)
 				} else {
-					var renderedCmd bytes.Buffer
-					if err := tmpl.Execute(&renderedCmd, templateData); err != nil {
-						fmt.Printf("Warning: failed to render on_finish hook command: %v\n", err)
+					// Execute the command
+					hookCmd := exec.Command("sh", "-c", renderedCmd)
+					hookCmd.Stdout = os.Stdout
+					hookCmd.Stderr = os.Stderr
+					if err := hookCmd.Run(); err != nil {
+						fmt.Printf("Warning: on_finish hook execution failed: %v\n", err)
 					} else {
-						// Execute the command
-						hookCmd := exec.Command("sh", "-c", renderedCmd.String())
-						hookCmd.Stdout = os.Stdout
-						hookCmd.Stderr = os.Stderr
-						if err := hookCmd.Run(); err != nil {
-							fmt.Printf("Warning: on_finish hook execution failed: %v\n", err)
-						} else {
-							fmt.Println("* on_finish hook executed successfully.")
-						}
+						fmt.Println("* on_finish hook executed successfully.")
 					}
 				}
 			}
@@ -1253,11 +1247,9 @@ func runPlanFinish(cmd *cobra.Command, args []string) error {
 	// Check if the finished plan was the active plan and unset it
 	activePlan, err := getActivePlanWithMigration()
 	if err == nil && activePlan == planName {
-		if err := state.Delete("flow.active_plan"); err != nil {
+		if err := clearActivePlan(); err != nil {
 			fmt.Printf("Warning: could not unset active plan: %v\n", err)
 		} else {
-			// Also delete legacy key
-			_ = state.Delete("active_plan")
 			fmt.Println("\n* Unset active plan")
 		}
 	}
@@ -1328,4 +1320,99 @@ func cleanupEcosystemWorktree(ctx context.Context, gitRoot, worktreeName string,
 
 	fmt.Printf("    * Ecosystem worktree removed successfully\n")
 	return nil
-}
\ No newline at end of file
+}
+
+// confirmEcosystemCleanup lists each repo of an ecosystem worktree with how
+// far its branch is ahead of main, then asks before the worktrees and branches
+// are force-deleted.
+func confirmEcosystemCleanup(branchName string, repos []string, provider *workspace.Provider) bool {
+	var localWorkspaces map[string]string
+	if provider != nil {
+		localWorkspaces = provider.LocalWorkspaces()
+	}
+
+	fmt.Printf("\n    The following repos will have their worktree removed and branch '%s' force-deleted:\n", branchName)
+	for _, repo := range repos {
+		repoPath, ok := localWorkspaces[repo]
+		if !ok {
+			fmt.Printf("      - %-25s %s\n", repo, "not found in local workspaces")
+			continue
+		}
+		fmt.Printf("      - %-25s %s\n", repo, branchAheadStatus(repoPath, branchName))
+	}
+
+	fmt.Print("    Force-delete these worktrees and branches? [y/N]: ")
+	reader := bufio.NewReader(os.Stdin)
+	response, _ := reader.ReadString('\n')
+	response = strings.TrimSpace(response)
+	return response == "y" || response == "Y"
+}
+
+// branchAheadStatus describes how many commits branch has that main (or
+// master) in repoPath does not.
+func branchAheadStatus(repoPath, branch string) string {
+	if exec.Command("git", "-C", repoPath, "show-ref", "--verify", "--quiet", "refs/heads/"+branch).Run() != nil {
+		return "no branch"
+	}
+	for _, base := range []string{"main", "master"} {
+		if exec.Command("git", "-C", repoPath, "show-ref", "--verify", "--quiet", "refs/heads/"+base).Run() != nil {
+			continue
+		}
+		output, err := exec.Command("git", "-C", repoPath, "rev-list", "--count", base+".."+branch).Output()
+		if err != nil {
+			return "unknown"
+		}
+		ahead := strings.TrimSpace(string(output))
+		if ahead == "0" {
+			return color.GreenString("merged into %s", base)
+		}
+		return color.RedString("%s commits ahead of %s (unmerged)", ahead, base)
+	}
+	return "unknown (no main branch)"
+}
+
+// reportReclaimedSpace wraps a worktree-removing action so that, once the
+// worktree directory is gone, it prints how much disk space was freed.
+func reportReclaimedSpace(worktreePath string, action func() error) func() error {
+	return func() error {
+		size, sizeErr := dirSize(worktreePath)
+		if err := action(); err != nil {
+			return err
+		}
+		if sizeErr == nil && size > 0 {
+			if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
+				fmt.Printf("    Reclaimed %s\n", formatDiskSize(size))
+			}
+		}
+		return nil
+	}
+}
+
+// printFinishDryRun lists every cleanup item with its checked status and
+// whether its action would run, without running anything.
+func printFinishDryRun(plan *orchestration.Plan, items []*cleanupItem) {
+	fmt.Println("\nDry run - no actions will be performed.")
+	if plan.Config != nil && plan.Config.Status == "review" {
+		if plan.Config.Hooks != nil && plan.Config.Hooks["on_finish"] != "" {
+			fmt.Printf("  Would run on_finish hook: %s\n", plan.Config.Hooks["on_finish"])
+		}
+		fmt.Println("  Would mark plan as finished")
+	}
+
+	fmt.Println()
+	for _, item := range items {
+		marker := "skip"
+		if item.IsEnabled {
+			marker = color.YellowString("run ")
+		}
+		fmt.Printf("  [%s] %-40s %s\n", marker, item.Name, item.Status)
+		if item.IsEnabled && item.Target != "" {
+			fmt.Printf("         on: %s\n", item.Target)
+		}
+		if item.IsEnabled {
+			for _, detail := range item.Details {
+				fmt.Printf("         - %s: %s\n", detail.Name, detail.Status)
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+// NewPlanFixIDsCmd creates the `plan fix-ids` command.
+func NewPlanFixIDsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "fix-ids [name]",
+		Short: "Give jobs with duplicate IDs new unique IDs",
+		Long: `Find job files that share an ID, for example after copying a job file by hand,
+and give each copy a new unique ID. Plans with duplicate IDs fail to load
+because depends_on references to them are ambiguous.
+
+In each set of duplicates the first file (by filename) keeps the ID. A
+depends_on reference to a duplicated ID is pointed at the closest job with
+that ID before the referencing job, by filename. If no such job comes before
+it, nothing is changed and the reference has to be fixed by hand.
+
+If no plan name is provided, uses the active plan.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanFixIDs,
+	}
+}
+
+func runPlanFixIDs(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 0 {
+		dir = args[0]
+	}
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return err
+	}
+
+	fixes, err := orchestration.FixDuplicateJobIDs(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to fix job IDs: %w", err)
+	}
+	if len(fixes) == 0 {
+		fmt.Println("No duplicate job IDs found.")
+		return nil
+	}
+
+	for _, fix := range fixes {
+		fmt.Printf("%s %s: %s -> %s\n", theme.IconSuccess, fix.Filename, fix.OldID, fix.NewID)
+		if len(fix.ReferencedBy) > 0 {
+			fmt.Println(renderMuted(fmt.Sprintf("  depends_on updated in: %s", strings.Join(fix.ReferencedBy, ", "))))
+		}
+	}
+	return nil
+}
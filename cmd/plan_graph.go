@@ -57,6 +57,11 @@ func RunPlanGraph(cmd *PlanGraphCmd) error {
 		return fmt.Errorf("no jobs found in plan")
 	}
 
+	// Reject cycles up front so rendering never recurses through them
+	if _, err := orchestration.BuildDependencyGraph(plan); err != nil {
+		return fmt.Errorf("invalid dependency graph: %w", err)
+	}
+
 	// Build dependency graph
 	graph := buildDependencyGraph(plan)
 
@@ -133,19 +138,19 @@ func generateMermaidGraph(plan *orchestration.Plan, graph *DependencyGraph) stri
 
 	// Add nodes
 	for _, job := range plan.Jobs {
-		nodeID := strings.ReplaceAll(job.ID, "-", "_")
+		nodeID := mermaidNodeID(job.ID)
 		label := fmt.Sprintf("%s<br/>%s", job.Filename, getStatusSymbol(job.Status))
-		buf.WriteString(fmt.Sprintf("    %s[%s]\n", nodeID, label))
+		buf.WriteString(fmt.Sprintf("    %s[\"%s\"]\n", nodeID, strings.ReplaceAll(label, `"`, "#quot;")))
 	}
 
 	buf.WriteString("\n")
 
 	// Add edges
 	for _, job := range plan.Jobs {
-		nodeID := strings.ReplaceAll(job.ID, "-", "_")
+		nodeID := mermaidNodeID(job.ID)
 		for _, dep := range job.Dependencies {
 			if dep != nil {
-				depNodeID := strings.ReplaceAll(dep.ID, "-", "_")
+				depNodeID := mermaidNodeID(dep.ID)
 				buf.WriteString(fmt.Sprintf("    %s --> %s\n", depNodeID, nodeID))
 			}
 		}
@@ -166,7 +171,7 @@ func generateMermaidGraph(plan *orchestration.Plan, graph *DependencyGraph) stri
 	// Apply classes to nodes
 	statusGroups := make(map[orchestration.JobStatus][]string)
 	for _, job := range plan.Jobs {
-		nodeID := strings.ReplaceAll(job.ID, "-", "_")
+		nodeID := mermaidNodeID(job.ID)
 		statusGroups[job.Status] = append(statusGroups[job.Status], nodeID)
 	}
 
@@ -189,10 +194,10 @@ func generateDotGraph(plan *orchestration.Plan, graph *DependencyGraph) string {
 
 	// Add nodes
 	for _, job := range plan.Jobs {
-		label := fmt.Sprintf("%s\\n%s", job.Filename, string(job.Status))
+		label := fmt.Sprintf("%s\\n%s", dotEscape(job.Filename), string(job.Status))
 		color := getStatusColor(job.Status)
 		buf.WriteString(fmt.Sprintf("    \"%s\" [label=\"%s\", fillcolor=%s, style=filled];\n",
-			job.ID, label, color))
+			dotEscape(job.ID), label, color))
 	}
 
 	buf.WriteString("\n")
@@ -201,7 +206,7 @@ func generateDotGraph(plan *orchestration.Plan, graph *DependencyGraph) string {
 	for _, job := range plan.Jobs {
 		for _, dep := range job.Dependencies {
 			if dep != nil {
-				buf.WriteString(fmt.Sprintf("    \"%s\" -> \"%s\";\n", dep.ID, job.ID))
+				buf.WriteString(fmt.Sprintf("    \"%s\" -> \"%s\";\n", dotEscape(dep.ID), dotEscape(job.ID)))
 			}
 		}
 	}
@@ -211,6 +216,21 @@ func generateDotGraph(plan *orchestration.Plan, graph *DependencyGraph) string {
 	return buf.String()
 }
 
+// mermaidNodeID turns a job ID into a Mermaid-safe node identifier.
+func mermaidNodeID(id string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, id)
+}
+
+// dotEscape escapes a string for use inside a double-quoted DOT identifier.
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
 func generateASCIIGraph(plan *orchestration.Plan, graph *DependencyGraph) string {
 	var buf strings.Builder
 
@@ -272,6 +292,11 @@ func computeJobLevels(plan *orchestration.Plan, graph *DependencyGraph) map[stri
 		if level, ok := levels[jobID]; ok && level >= 0 {
 			return level
 		}
+		if levels[jobID] == -2 {
+			// Already on the current path; cycles are rejected earlier, but never recurse forever
+			return 0
+		}
+		levels[jobID] = -2
 
 		job := graph.Nodes[jobID]
 		if job == nil {
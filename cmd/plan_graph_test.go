@@ -288,3 +288,30 @@ func createTestPlan() *orchestration.Plan {
 		},
 	}
 }
+
+func TestComputeJobLevels_Cycle(t *testing.T) {
+	a := &orchestration.Job{ID: "a", Filename: "01-a.md"}
+	b := &orchestration.Job{ID: "b", Filename: "02-b.md"}
+	a.Dependencies = []*orchestration.Job{b}
+	b.Dependencies = []*orchestration.Job{a}
+	plan := &orchestration.Plan{
+		Jobs:     []*orchestration.Job{a, b},
+		JobsByID: map[string]*orchestration.Job{"a": a, "b": b},
+	}
+
+	// Must terminate rather than recurse forever
+	computeJobLevels(plan, buildDependencyGraph(plan))
+
+	if _, err := orchestration.BuildDependencyGraph(plan); err == nil || !strings.Contains(err.Error(), "circular dependency") {
+		t.Errorf("expected circular dependency error, got %v", err)
+	}
+}
+
+func TestGraphIdentifierEscaping(t *testing.T) {
+	if got := mermaidNodeID("02-api.v2"); got != "02_api_v2" {
+		t.Errorf("mermaidNodeID() = %q", got)
+	}
+	if got := dotEscape(`say "hi"`); got != `say \"hi\"` {
+		t.Errorf("dotEscape() = %q", got)
+	}
+}
@@ -58,6 +58,17 @@ func expandFlowPath(path string) (string, error) {
 // getRepositoryName returns the name of the repository, handling worktrees correctly.
 // For worktrees, it finds the main repository directory rather than using the worktree path.
 func getRepositoryName(dir string) (string, error) {
+	repoPath, err := mainRepoRoot(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(repoPath), nil
+}
+
+// mainRepoRoot returns the root of the main repository containing dir. Unlike
+// git.GetGitRoot, it resolves to the main checkout rather than the worktree
+// when dir is inside .grove-worktrees/<name>.
+func mainRepoRoot(dir string) (string, error) {
 	cmdBuilder := command.NewSafeBuilder()
 
 	// Get the common git directory (points to main repo even in worktrees)
@@ -83,9 +94,8 @@ func getRepositoryName(dir string) (string, error) {
 		commonDir = absDir
 	}
 
-	// The repository name is the basename of the parent directory of .git
-	repoPath := filepath.Dir(commonDir)
-	return filepath.Base(repoPath), nil
+	// The repository is the parent directory of .git
+	return filepath.Dir(commonDir), nil
 }
 
 // resolvePlanPath determines the absolute path for a plan directory.
@@ -96,11 +106,21 @@ func resolvePlanPath(planName string) (string, error) {
 		return planName, nil
 	}
 
+	plansDir, err := resolvePlansDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(plansDir, planName), nil
+}
+
+// resolvePlansDir determines the absolute path of the directory holding the
+// current workspace's plans.
+func resolvePlansDir() (string, error) {
 	// 1. Get the current workspace node.
 	node, err := workspace.GetProjectByPath(".")
 	if err != nil {
 		// Fallback: if we can't determine workspace, use local directory
-		return filepath.Abs(planName)
+		return filepath.Abs(".")
 	}
 
 	// 2. Load config and initialize the locator.
@@ -128,8 +148,7 @@ func resolvePlanPath(planName string) (string, error) {
 			if err != nil {
 				return "", fmt.Errorf("could not expand plans_directory path: %w", err)
 			}
-			fullPath := filepath.Join(expandedBasePath, planName)
-			return filepath.Abs(fullPath)
+			return filepath.Abs(expandedBasePath)
 		}
 	}
 
@@ -138,10 +157,7 @@ func resolvePlanPath(planName string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("could not resolve plans directory: %w", err)
 	}
-
-	// 5. Join with the specific plan name.
-	fullPath := filepath.Join(plansDir, planName)
-	return filepath.Abs(fullPath)
+	return filepath.Abs(plansDir)
 }
 
 // resolveChatsDir determines the absolute path to the chats directory for the current workspace.
@@ -11,7 +11,8 @@ func NewPlanHoldCmd() *cobra.Command {
 		Short: "Set a plan's status to 'hold'",
 		Long: `Sets the status of a plan to 'hold' in its .grove-plan.yml file.
 On-hold plans are hidden from most views by default to reduce clutter.`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var dir string
 			if len(args) > 0 {
@@ -34,7 +35,8 @@ func NewPlanUnholdCmd() *cobra.Command {
 		Short: "Remove a plan's on-hold status",
 		Long: `Resumes an on-hold plan by removing its status from its .grove-plan.yml file.
 This makes the plan visible in default views again.`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var dir string
 			if len(args) > 0 {
@@ -400,13 +400,11 @@ func runPlanInitFromRecipe(cmd *PlanInitCmd, planPath string, planName string) e
 				fmt.Printf("* Auto-selected recipe: %s\n", recipeName)
 			} else if cmd.Recipe == "" || cmd.Recipe == "chat-workflow" {
 				// Multiple recipes available and no specific one requested
-				fmt.Println("Available recipes from command:")
-				for i, r := range dynamicRecipes {
-					fmt.Printf("  %d. %s - %s\n", i+1, r.Name, r.Description)
+				selected, err := pickRecipe(dynamicRecipes, cmd.Yes)
+				if err != nil {
+					return err
 				}
-				// For now, we'll use the first one, but this could be made interactive
-				recipeName = dynamicRecipes[0].Name
-				fmt.Printf("* Using first recipe: %s (specify with --recipe to choose a different one)\n", recipeName)
+				recipeName = selected.Name
 			}
 		}
 	}
@@ -487,6 +485,15 @@ func runPlanInitFromRecipe(cmd *PlanInitCmd, planPath string, planName string) e
 		}
 	}
 
+	// Check the vars against the recipe's declared schema before rendering
+	unknownVars, err := recipe.ValidateVars(recipeVars)
+	if err != nil {
+		return err
+	}
+	for _, name := range unknownVars {
+		fmt.Printf("Warning: recipe '%s' does not declare variable '%s'\n", recipeName, name)
+	}
+
 	// Override model from CLI if provided
 	if cmd.Model != "" {
 		recipeVars["model"] = cmd.Model
@@ -873,27 +880,18 @@ func executeOnStartHook(planPath, planName, noteRef string) error {
 		if hookCmdStr, ok := plan.Config.Hooks["on_start"]; ok && hookCmdStr != "" {
 			fmt.Println("▶️  Executing on_start hook...")
 
-			// Prepare template data
-			templateData := struct {
-				PlanName string
-				NoteRef  string
-			}{
-				PlanName: planName,
-				NoteRef:  noteRef,
+			hookData := orchestration.NewPlanHookData(plan)
+			hookData.PlanName = planName
+			if noteRef != "" {
+				hookData.NoteRef = noteRef
 			}
-
-			// Render the hook command
-			tmpl, err := template.New("hook").Parse(hookCmdStr)
+			renderedCmd, err := orchestration.RenderHookCommand(hookCmdStr, hookData)
 			if err != nil {
-				return fmt.Errorf("failed to parse on_start hook template: %w", err)
-			}
-			var renderedCmd bytes.Buffer
-			if err := tmpl.Execute(&renderedCmd, templateData); err != nil {
 				return fmt.Errorf("failed to render on_start hook command: %w", err)
 			}
 
 			// Execute the command
-			hookCmd := exec.Command("sh", "-c", renderedCmd.String())
+			hookCmd := exec.Command("sh", "-c", renderedCmd)
 			hookCmd.Stdout = os.Stdout
 			hookCmd.Stderr = os.Stderr
 			if err := hookCmd.Run(); err != nil {
@@ -1032,6 +1030,10 @@ func createDefaultPlanConfig(planPath, model, worktree, container, noteRef, reci
 		configContent.WriteString(`#     echo "Plan {{.PlanName}} is now in review."` + "\n")
 		configContent.WriteString("#   on_finish: |\n")
 		configContent.WriteString(`#     echo "Plan {{.PlanName}} is finished."` + "\n")
+		configContent.WriteString("#   on_fail: |\n")
+		configContent.WriteString(`#     echo "Plan {{.PlanName}} has failed jobs: {{range .FailedJobs}}{{.ID}} {{end}}"` + "\n")
+		configContent.WriteString("# Hooks can also use {{.PlanDir}}, {{.Worktree}}, {{.WorktreePath}}, {{.Branch}},\n")
+		configContent.WriteString("# and {{.Jobs}} (each with .ID, .Title, .Filename, .Status).\n")
 	}
 
 	configPath := filepath.Join(planPath, ".grove-plan.yml")
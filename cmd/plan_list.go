@@ -3,7 +3,10 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,6 +15,7 @@ import (
 
 	"github.com/grovetools/core/cli"
 	"github.com/grovetools/core/config"
+	"github.com/grovetools/core/git"
 	grovelogging "github.com/grovetools/core/logging"
 	"github.com/grovetools/core/pkg/workspace"
 	"github.com/grovetools/flow/pkg/orchestration"
@@ -26,8 +30,12 @@ var (
 	planListIncludeFinished bool
 	planListAllWorkspaces   bool
 	planListShowHold        bool
+	planListFormat          string
 )
 
+// planListFormats are the values accepted by `plan list --format`.
+var planListFormats = []string{"table", "json", "names"}
+
 // PlanSummary represents a plan in the JSON output
 type PlanSummary struct {
 	ID            string               `json:"id"`
@@ -41,6 +49,14 @@ type PlanSummary struct {
 	Repository    string               `json:"repository,omitempty"`
 	WorkspaceName string               `json:"workspace_name,omitempty"`
 	WorkspacePath string               `json:"workspace_path,omitempty"`
+	StatusCounts  map[string]int       `json:"status_counts,omitempty"`
+	Worktree      string               `json:"worktree,omitempty"`
+	WorktreeSize  int64                `json:"worktree_size_bytes,omitempty"`
+	Ahead         int                  `json:"ahead,omitempty"`
+	Behind        int                  `json:"behind,omitempty"`
+	MergeStatus   string               `json:"merge_status,omitempty"`
+	ReviewStatus  string               `json:"review_status,omitempty"`
+	Notes         string               `json:"notes,omitempty"`
 }
 
 // newPlanListCmd creates the `plan list` command.
@@ -49,7 +65,13 @@ func newPlanListCmd() *cobra.Command {
 		Use:   "list",
 		Short: "List all plans (use: flow list)",
 		Long: `Scans for and lists orchestration plans. By default, it scans the directory specified in
-the notebooks configuration. With --all-workspaces, it discovers all projects and scans for plans within them.`,
+the notebooks configuration. With --all-workspaces, it discovers all projects and scans for plans within them.
+
+Use --format for scripting: table and json include each plan's job status
+summary, worktree and its disk usage, git ahead/behind counts and review
+status, and names prints one plan name per line for shell completion or piping
+into other commands. --format json prints the same fields as --json, adding
+the worktree and git details.`,
 		RunE: runPlanList,
 	}
 
@@ -57,6 +79,7 @@ the notebooks configuration. With --all-workspaces, it discovers all projects an
 	cmd.Flags().BoolVar(&planListIncludeFinished, "include-finished", false, "Include finished plans in the output")
 	cmd.Flags().BoolVar(&planListAllWorkspaces, "all-workspaces", false, "List plans across all discovered workspaces")
 	cmd.Flags().BoolVar(&planListShowHold, "show-hold", false, "Include on-hold plans in the output")
+	cmd.Flags().StringVar(&planListFormat, "format", "", "Print plans with worktree and git details as table, json, or names (one per line)")
 
 	return cmd
 }
@@ -66,17 +89,35 @@ func NewListCmd() *cobra.Command {
 	listCmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all plans in the configured plans directory or across all workspaces",
-		Long: `Scans for and lists orchestration plans. By default, it scans the directory specified in the notebooks configuration. With --all-workspaces, it discovers all projects and scans for plans within them.`,
-		RunE:  runPlanList,
+		Long: `Scans for and lists orchestration plans. By default, it scans the directory specified in the notebooks configuration. With --all-workspaces, it discovers all projects and scans for plans within them.
+
+Use --format for scripting: table and json include each plan's job status
+summary, worktree and its disk usage, git ahead/behind counts and review
+status, and names prints one plan name per line for shell completion or piping
+into other commands. --format json prints the same fields as --json, adding
+the worktree and git details.`,
+		RunE: runPlanList,
 	}
 	listCmd.Flags().BoolVarP(&planListVerbose, "verbose", "v", false, "Show detailed information including jobs in each plan")
 	listCmd.Flags().BoolVar(&planListIncludeFinished, "include-finished", false, "Include finished plans in the output")
 	listCmd.Flags().BoolVar(&planListAllWorkspaces, "all-workspaces", false, "List plans across all discovered workspaces")
 	listCmd.Flags().BoolVar(&planListShowHold, "show-hold", false, "Include on-hold plans in the output")
+	listCmd.Flags().StringVar(&planListFormat, "format", "", "Print plans with worktree and git details as table, json, or names (one per line)")
 	return listCmd
 }
 
 func runPlanList(cmd *cobra.Command, args []string) error {
+	// --format json prints the same shape as the global --json, plus the
+	// worktree and git details, which are slow to gather for every plan
+	if cli.GetOptions(cmd).JSONOutput && planListFormat != "" && planListFormat != "json" {
+		return fmt.Errorf("--json cannot be combined with --format %s", planListFormat)
+	}
+	withDetails := planListFormat == "json"
+	jsonOutput := cli.GetOptions(cmd).JSONOutput || withDetails
+	if planListFormat != "" && !jsonOutput {
+		return runPlanListFormat(os.Stdout, planListFormat)
+	}
+
 	var summaries []PlanSummary
 	var err error
 
@@ -100,9 +141,10 @@ func runPlanList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Check if JSON output is requested
-	opts := cli.GetOptions(cmd)
-	if opts.JSONOutput {
+	if jsonOutput {
+		if withDetails {
+			addPlanListDetails(summaries)
+		}
 		return outputPlansJSON(summaries)
 	}
 
@@ -306,6 +348,9 @@ func createPlanSummary(plan *orchestration.Plan, expandedPath string) PlanSummar
 	if c := statusCounts[orchestration.JobStatusAbandoned]; c > 0 {
 		statusParts = append(statusParts, fmt.Sprintf("%d abandoned", c))
 	}
+	if c := statusCounts[orchestration.JobStatusSkipped]; c > 0 {
+		statusParts = append(statusParts, fmt.Sprintf("%d skipped", c))
+	}
 
 	status := "no jobs"
 	if len(statusParts) > 0 {
@@ -346,3 +391,115 @@ func outputPlansJSON(summaries []PlanSummary) error {
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(summaries)
 }
+
+// addPlanListDetails fills in the worktree, git and review details shown by
+// the plan list TUI, loading them once per plans directory.
+func addPlanListDetails(summaries []PlanSummary) {
+	items := make(map[string]PlanListItem)
+	loaded := make(map[string]bool)
+	for _, summary := range summaries {
+		plansDir := filepath.Dir(summary.Path)
+		if loaded[plansDir] {
+			continue
+		}
+		loaded[plansDir] = true
+		dirItems, _ := loadPlansList(plansDir, summary.WorkspacePath, true)
+		for _, item := range dirItems {
+			items[item.Plan.Directory] = item
+		}
+	}
+	for i := range summaries {
+		if item, ok := items[summaries[i].Path]; ok {
+			applyPlanListDetails(&summaries[i], item)
+		}
+	}
+}
+
+// applyPlanListDetails copies item's worktree, git and review details into summary.
+func applyPlanListDetails(summary *PlanSummary, item PlanListItem) {
+	summary.StatusCounts = item.StatusParts
+	summary.Worktree = item.Worktree
+	summary.Notes = item.Notes
+	if item.WorktreePath != "" {
+		summary.WorktreeSize, _ = worktreeDiskUsage(item.WorktreePath)
+	}
+	if item.GitStatus != nil {
+		summary.Ahead = item.GitStatus.AheadCount
+		summary.Behind = item.GitStatus.BehindCount
+	}
+	if item.MergeStatus != "-" {
+		summary.MergeStatus = item.MergeStatus
+	}
+	if item.ReviewStatus != "-" {
+		summary.ReviewStatus = item.ReviewStatus
+	}
+}
+
+// runPlanListFormat prints the current workspace's plans, as loaded for the
+// plan list TUI, in the given format.
+func runPlanListFormat(w io.Writer, format string) error {
+	valid := false
+	for _, f := range planListFormats {
+		valid = valid || f == format
+	}
+	if !valid {
+		return fmt.Errorf("invalid format '%s': must be one of %s", format, strings.Join(planListFormats, ", "))
+	}
+	if planListAllWorkspaces || planListIncludeFinished {
+		return fmt.Errorf("--format cannot be combined with --all-workspaces or --include-finished")
+	}
+
+	node, err := workspace.GetProjectByPath(".")
+	if err != nil {
+		return fmt.Errorf("could not determine current workspace: %w", err)
+	}
+	coreCfg, err := config.LoadDefault()
+	if err != nil {
+		coreCfg = &config.Config{}
+	}
+	plansDirectory, err := workspace.NewNotebookLocator(coreCfg).GetPlansDir(node)
+	if err != nil {
+		return fmt.Errorf("could not resolve plans directory: %w", err)
+	}
+	cwdGitRoot := node.Path
+	if cwdGitRoot == "" {
+		cwdGitRoot, _ = git.GetGitRoot(".")
+	}
+
+	items, err := loadPlansList(plansDirectory, cwdGitRoot, planListShowHold)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return writePlanList(w, format, items)
+}
+
+// writePlanList writes items to w as a table or one name per line.
+func writePlanList(w io.Writer, format string, items []PlanListItem) error {
+	switch format {
+	case "names":
+		for _, item := range items {
+			fmt.Fprintln(w, item.Name)
+		}
+		return nil
+
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(tw, "NAME\tJOBS\tSTATUS\tWORKTREE\tSIZE\tGIT\tREVIEW")
+		for _, item := range items {
+			worktree, size, gitStatus := "-", "-", "-"
+			if item.Worktree != "" {
+				worktree = item.Worktree
+			}
+			if item.WorktreePath != "" {
+				if bytes, err := worktreeDiskUsage(item.WorktreePath); err == nil {
+					size = formatDiskSize(bytes)
+				}
+			}
+			if item.GitStatus != nil {
+				gitStatus = fmt.Sprintf("+%d/-%d", item.GitStatus.AheadCount, item.GitStatus.BehindCount)
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\t%s\t%s\n", item.Name, item.JobCount, item.Status, worktree, size, gitStatus, item.ReviewStatus)
+		}
+		return tw.Flush()
+	}
+}
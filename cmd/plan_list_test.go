@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/grovetools/core/cli"
+	"github.com/grovetools/core/git"
+	"github.com/grovetools/flow/pkg/orchestration"
+)
+
+func TestWritePlanList(t *testing.T) {
+	worktreePath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(worktreePath, "main.go"), make([]byte, 2048), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	items := []PlanListItem{
+		{
+			Plan:         &orchestration.Plan{Name: "auth", Directory: "/plans/auth"},
+			Name:         "auth",
+			JobCount:     3,
+			Status:       "2 completed, 1 pending",
+			StatusParts:  map[string]int{"completed": 2, "pending": 1},
+			Worktree:     "auth",
+			WorktreePath: worktreePath,
+			GitStatus:    &git.StatusInfo{AheadCount: 2, BehindCount: 1},
+			MergeStatus:  "Ready",
+			ReviewStatus: "Review",
+		},
+		{
+			Plan:         &orchestration.Plan{Name: "notes", Directory: "/plans/notes"},
+			Name:         "notes",
+			Status:       "no jobs",
+			MergeStatus:  "-",
+			ReviewStatus: "-",
+		},
+	}
+
+	t.Run("names", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writePlanList(&buf, "names", items); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != "auth\nnotes\n" {
+			t.Errorf("names output = %q", got)
+		}
+	})
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writePlanList(&buf, "table", items); err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if len(lines) != 3 || !strings.HasPrefix(lines[0], "NAME") || !strings.Contains(lines[0], "SIZE") {
+			t.Fatalf("table output:\n%s", buf.String())
+		}
+		if !strings.Contains(lines[1], "+2/-1") || !strings.Contains(lines[1], "2.0 KiB") || !strings.Contains(lines[2], "no jobs") {
+			t.Errorf("table rows:\n%s", buf.String())
+		}
+	})
+}
+
+func TestApplyPlanListDetails(t *testing.T) {
+	worktreePath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(worktreePath, "main.go"), make([]byte, 2048), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	summary := PlanSummary{ID: "auth", Title: "auth", Path: "/plans/auth", JobCount: 3}
+	applyPlanListDetails(&summary, PlanListItem{
+		Name:         "auth",
+		StatusParts:  map[string]int{"completed": 2, "pending": 1},
+		Worktree:     "auth",
+		WorktreePath: worktreePath,
+		GitStatus:    &git.StatusInfo{AheadCount: 2, BehindCount: 1},
+		MergeStatus:  "Ready",
+		ReviewStatus: "Review",
+	})
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	// The --json fields are kept alongside the details
+	for key, want := range map[string]interface{}{
+		"id":                  "auth",
+		"path":                "/plans/auth",
+		"worktree":            "auth",
+		"worktree_size_bytes": float64(2048),
+		"ahead":               float64(2),
+		"behind":              float64(1),
+		"merge_status":        "Ready",
+		"review_status":       "Review",
+	} {
+		if got[key] != want {
+			t.Errorf("%s = %v, want %v", key, got[key], want)
+		}
+	}
+
+	placeholder := PlanSummary{ID: "notes"}
+	applyPlanListDetails(&placeholder, PlanListItem{Name: "notes", MergeStatus: "-", ReviewStatus: "-"})
+	if placeholder.MergeStatus != "" || placeholder.ReviewStatus != "" {
+		t.Errorf("placeholder statuses should be omitted: %+v", placeholder)
+	}
+}
+
+func TestRunPlanList_RejectsJSONWithOtherFormat(t *testing.T) {
+	defer func() { planListFormat = "" }()
+	planListFormat = "names"
+
+	cmd := cli.NewStandardCommand("list", "List plans")
+	if err := cmd.Flags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	err := runPlanList(cmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "--json cannot be combined with --format names") {
+		t.Errorf("runPlanList() error = %v, want --json/--format conflict", err)
+	}
+}
+
+func TestFormatDiskSize(t *testing.T) {
+	tests := map[int64]string{
+		0:               "0 B",
+		1023:            "1023 B",
+		1536:            "1.5 KiB",
+		5 * 1024 * 1024: "5.0 MiB",
+		3 << 30:         "3.0 GiB",
+	}
+	for bytes, want := range tests {
+		if got := formatDiskSize(bytes); got != want {
+			t.Errorf("formatDiskSize(%d) = %q, want %q", bytes, got, want)
+		}
+	}
+}
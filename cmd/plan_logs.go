@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/grovetools/core/cli"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var (
+	planLogsList   bool
+	planLogsFollow bool
+)
+
+// NewPlanLogsCmd creates the `plan logs` command.
+func NewPlanLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs <job> [directory]",
+		Short: "Show a job's log files",
+		Long: `Show the log files written for a job: its job.log and hook logs under
+.artifacts/<job-id>, and the LLM and cx output logs in the plan's log directory.
+
+By default the most recent log is printed. Use --list to list all of them, and
+--follow to keep printing the most recent log as it grows until the job stops
+running. The job can be given by ID or filename. If no directory is specified,
+uses the active job if set.
+
+Examples:
+  flow plan logs 02-review.md
+  flow plan logs review-1a2b3c4d my-plan --list
+  flow plan logs 03-implement.md --follow`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completeJobThenPlanArgs,
+		RunE:              runPlanLogs,
+	}
+	cmd.Flags().BoolVar(&planLogsList, "list", false, "List the job's log files instead of printing one")
+	cmd.Flags().BoolVarP(&planLogsFollow, "follow", "f", false, "Keep printing the most recent log as it grows while the job is running")
+	return cmd
+}
+
+// logFileJSON describes a log file in `plan logs --list --json` output.
+type logFileJSON struct {
+	Path     string    `json:"path"`
+	Size     int64     `json:"size"`
+	Modified time.Time `json:"modified"`
+}
+
+func runPlanLogs(cmd *cobra.Command, args []string) error {
+	if planLogsList && planLogsFollow {
+		return fmt.Errorf("--list and --follow cannot be used together")
+	}
+
+	var dir string
+	if len(args) > 1 {
+		dir = args[1]
+	}
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	job, found := plan.GetJobByID(args[0])
+	if !found {
+		job, found = plan.GetJobByFilename(args[0])
+	}
+	if !found {
+		return fmt.Errorf("job '%s' not found in plan '%s'", args[0], plan.Name)
+	}
+
+	logs, err := orchestration.FindJobLogs(plan, job)
+	if err != nil {
+		return fmt.Errorf("finding logs: %w", err)
+	}
+
+	if planLogsList {
+		return printLogList(cmd, logs)
+	}
+	if len(logs) == 0 {
+		return fmt.Errorf("no logs found for job '%s'", job.Filename)
+	}
+
+	latest := logs[len(logs)-1]
+	if !planLogsFollow {
+		f, err := os.Open(latest)
+		if err != nil {
+			return fmt.Errorf("opening log: %w", err)
+		}
+		defer f.Close()
+		_, err = io.Copy(os.Stdout, f)
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	fmt.Fprintf(os.Stderr, "%s\n", renderMuted("==> "+latest+" <=="))
+	return followLog(ctx, latest, os.Stdout, func() bool {
+		current, err := orchestration.LoadJob(job.FilePath)
+		return err == nil && current.Status == orchestration.JobStatusRunning
+	})
+}
+
+func printLogList(cmd *cobra.Command, logs []string) error {
+	var files []logFileJSON
+	for _, path := range logs {
+		if info, err := os.Stat(path); err == nil {
+			files = append(files, logFileJSON{Path: path, Size: info.Size(), Modified: info.ModTime()})
+		}
+	}
+
+	if cli.GetOptions(cmd).JSONOutput {
+		if files == nil {
+			files = []logFileJSON{}
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(files)
+	}
+
+	if len(files) == 0 {
+		fmt.Println("No logs found")
+		return nil
+	}
+	for _, file := range files {
+		fmt.Printf("%s  %s  %s\n", file.Modified.Format("2006-01-02 15:04:05"), renderMuted(fmt.Sprintf("%8d", file.Size)), file.Path)
+	}
+	return nil
+}
+
+// followLog copies path to w and then keeps copying whatever is appended to it,
+// polling until ctx is done or running reports that the job has stopped.
+func followLog(ctx context.Context, path string, w io.Writer, running func() bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening log: %w", err)
+	}
+	defer f.Close()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if _, err := io.Copy(w, f); err != nil {
+			return err
+		}
+		if !running() {
+			// Pick up anything written between the last copy and the job stopping
+			_, err := io.Copy(w, f)
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
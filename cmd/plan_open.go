@@ -2,22 +2,31 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/grovetools/flow/pkg/orchestration"
 	"github.com/spf13/cobra"
 )
 
+var planOpenPrint bool
+
 // NewOpenCmd creates the top-level `open` command.
 func NewOpenCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "open [directory]",
 		Short: "Open a plan's worktree in a dedicated tmux session",
 		Long: `Switches to or creates a tmux session for the plan's worktree and opens the interactive status TUI.
-This provides a one-command entry point into a plan's interactive environment.`,
+This provides a one-command entry point into a plan's interactive environment.
+
+With --print, only the absolute worktree path (or the plan directory if the plan
+has no worktree) is printed, for use in scripts: cd "$(flow open --print)"`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: runPlanOpen,
 	}
+	cmd.Flags().BoolVar(&planOpenPrint, "print", false, "Print the plan's worktree path instead of opening a tmux session")
+	return cmd
 }
 
 // runPlanOpen implements the open command.
@@ -39,34 +48,18 @@ func runPlanOpen(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("load plan: %w", err)
 	}
 
-	// --- Determine the authoritative worktree for the plan ---
-	var worktreeName string
-
-	// 1. Prioritize worktree from the plan's config file
-	if plan.Config != nil && plan.Config.Worktree != "" {
-		worktreeName = plan.Config.Worktree
-	} else {
-		// 2. If not in config, analyze jobs for a single, unique worktree
-		worktrees := make(map[string]bool)
-		hasMainRepoJobs := false
-		for _, job := range plan.Jobs {
-			if job.Worktree == "" {
-				hasMainRepoJobs = true
-			} else {
-				worktrees[job.Worktree] = true
-			}
-		}
+	worktreeName := planWorktreeName(plan)
 
-		// Only proceed if there's exactly one worktree and no main repo jobs
-		if len(worktrees) == 1 && !hasMainRepoJobs {
-			for wt := range worktrees {
-				worktreeName = wt
-				break
-			}
+	if planOpenPrint {
+		path, err := planWorktreePath(plan, worktreeName)
+		if err != nil {
+			return err
 		}
+		fmt.Println(path)
+		return nil
 	}
 
-	// 3. If no single worktree could be determined, return an error
+	// If no single worktree could be determined, return an error
 	if worktreeName == "" {
 		var errorMsg strings.Builder
 		errorMsg.WriteString(fmt.Sprintf("could not determine a single worktree for plan '%s'.\n", plan.Name))
@@ -98,4 +91,60 @@ func runPlanOpen(cmd *cobra.Command, args []string) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// planWorktreeName determines the authoritative worktree for a plan, or "" if
+// there is no single one.
+func planWorktreeName(plan *orchestration.Plan) string {
+	var worktreeName string
+
+	// 1. Prioritize worktree from the plan's config file
+	if plan.Config != nil && plan.Config.Worktree != "" {
+		worktreeName = plan.Config.Worktree
+	} else {
+		// 2. If not in config, analyze jobs for a single, unique worktree
+		worktrees := make(map[string]bool)
+		hasMainRepoJobs := false
+		for _, job := range plan.Jobs {
+			if job.Worktree == "" {
+				hasMainRepoJobs = true
+			} else {
+				worktrees[job.Worktree] = true
+			}
+		}
+
+		// Only proceed if there's exactly one worktree and no main repo jobs
+		if len(worktrees) == 1 && !hasMainRepoJobs {
+			for wt := range worktrees {
+				worktreeName = wt
+				break
+			}
+		}
+	}
+
+	return worktreeName
+}
+
+// planWorktreePath resolves the absolute path of a plan's worktree under the
+// main repository, even when run from inside another worktree. Plans without a
+// worktree resolve to the plan directory.
+func planWorktreePath(plan *orchestration.Plan, worktreeName string) (string, error) {
+	if worktreeName == "" {
+		return filepath.Abs(plan.Directory)
+	}
+
+	cwd, _ := os.Getwd()
+	gitRoot, err := mainRepoRoot(cwd)
+	if err != nil {
+		gitRoot, err = orchestration.GetProjectGitRoot(plan.Directory)
+		if err != nil {
+			return "", fmt.Errorf("could not find git root for worktree '%s': %w", worktreeName, err)
+		}
+	}
+
+	path := filepath.Join(gitRoot, ".grove-worktrees", worktreeName)
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("worktree '%s' not found at %s", worktreeName, path)
+	}
+	return path, nil
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+// archiveDirName is the subdirectory of a plan that pruned jobs move into.
+const archiveDirName = ".archive"
+
+var (
+	planPruneStatuses []string
+	planPruneDelete   bool
+	planPruneDryRun   bool
+)
+
+// NewPlanPruneCmd creates the `plan prune` command.
+func NewPlanPruneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune [directory]",
+		Short: "Remove finished jobs from a plan",
+		Long: `Move completed and abandoned job files into the plan's .archive/
+subdirectory, keeping their frontmatter and output for later reference. Use
+--delete to remove them instead, and --status to choose which statuses to prune.
+
+A job is kept if any job that remains in the plan still depends on it. Use
+--dry-run to see what would be pruned and what is kept. If no directory is
+specified, uses the active job if set.
+
+Examples:
+  flow plan prune --dry-run
+  flow plan prune my-plan --status completed
+  flow plan prune my-plan --delete`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanPrune,
+	}
+	cmd.Flags().StringSliceVar(&planPruneStatuses, "status", []string{string(orchestration.JobStatusCompleted), string(orchestration.JobStatusAbandoned)}, "Job statuses to prune")
+	cmd.Flags().BoolVar(&planPruneDelete, "delete", false, "Delete the job files instead of archiving them")
+	cmd.Flags().BoolVar(&planPruneDryRun, "dry-run", false, "Show which jobs would be pruned without changing anything")
+	return cmd
+}
+
+func runPlanPrune(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 0 {
+		dir = args[0]
+	}
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	statuses := make(map[orchestration.JobStatus]bool)
+	for _, status := range planPruneStatuses {
+		status = strings.TrimSpace(status)
+		valid := false
+		for _, known := range orchestration.JobStatuses() {
+			if orchestration.JobStatus(status) == known {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("unknown job status '%s'", status)
+		}
+		statuses[orchestration.JobStatus(status)] = true
+	}
+
+	prune, kept := selectPrunableJobs(plan, statuses)
+	for _, job := range plan.Jobs {
+		if reason, ok := kept[job.ID]; ok {
+			fmt.Printf("%s Keeping %s: %s\n", theme.IconWarning, job.Filename, reason)
+		}
+	}
+	if len(prune) == 0 {
+		fmt.Println("No jobs to prune")
+		return nil
+	}
+
+	verb := "Archived"
+	if planPruneDelete {
+		verb = "Deleted"
+	}
+	if planPruneDryRun {
+		verb = "Would archive"
+		if planPruneDelete {
+			verb = "Would delete"
+		}
+	}
+
+	archiveDir := filepath.Join(plan.Directory, archiveDirName)
+	for _, job := range prune {
+		if !planPruneDryRun {
+			if err := pruneJobFile(job, archiveDir, planPruneDelete); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("%s %s %s %s\n", theme.IconSuccess, verb, job.Filename, renderMuted("("+string(job.Status)+")"))
+	}
+	if !planPruneDryRun && !planPruneDelete {
+		fmt.Printf("\nArchived jobs are in %s\n", archiveDir)
+	}
+	return nil
+}
+
+// selectPrunableJobs returns the jobs with one of statuses that no remaining
+// job depends on, in filename order, and the reason each other matching job
+// is kept. Keeping a job can in turn keep the jobs it depends on.
+func selectPrunableJobs(plan *orchestration.Plan, statuses map[orchestration.JobStatus]bool) ([]*orchestration.Job, map[string]string) {
+	candidates := make(map[string]bool)
+	for _, job := range plan.Jobs {
+		if statuses[job.Status] {
+			candidates[job.ID] = true
+		}
+	}
+
+	kept := make(map[string]string)
+	for changed := true; changed; {
+		changed = false
+		for _, job := range plan.Jobs {
+			if candidates[job.ID] {
+				continue
+			}
+			// job stays in the plan, so everything it depends on must stay too
+			for _, dep := range job.Dependencies {
+				if dep != nil && candidates[dep.ID] {
+					delete(candidates, dep.ID)
+					kept[dep.ID] = fmt.Sprintf("%s depends on it", job.Filename)
+					changed = true
+				}
+			}
+		}
+	}
+
+	var prune []*orchestration.Job
+	for _, job := range plan.Jobs {
+		if candidates[job.ID] {
+			prune = append(prune, job)
+		}
+	}
+	sort.Slice(prune, func(i, j int) bool {
+		return prune[i].Filename < prune[j].Filename
+	})
+	return prune, kept
+}
+
+// pruneJobFile deletes a job file, or moves it unchanged into archiveDir.
+func pruneJobFile(job *orchestration.Job, archiveDir string, deleteFile bool) error {
+	if deleteFile {
+		if err := os.Remove(job.FilePath); err != nil {
+			return fmt.Errorf("deleting %s: %w", job.Filename, err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		return fmt.Errorf("creating archive directory: %w", err)
+	}
+	dest := filepath.Join(archiveDir, job.Filename)
+	if _, err := os.Stat(dest); err == nil {
+		return fmt.Errorf("cannot archive %s: %s already exists", job.Filename, dest)
+	}
+	if err := os.Rename(job.FilePath, dest); err != nil {
+		return fmt.Errorf("archiving %s: %w", job.Filename, err)
+	}
+	return nil
+}
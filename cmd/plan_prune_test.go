@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grovetools/flow/pkg/orchestration"
+)
+
+func TestSelectPrunableJobs(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"01-spec.md":      "---\nid: spec\ntitle: Spec\ntype: oneshot\nstatus: completed\n---\n",
+		"02-design.md":    "---\nid: design\ntitle: Design\ntype: oneshot\nstatus: completed\ndepends_on: [spec]\n---\n",
+		"03-implement.md": "---\nid: implement\ntitle: Implement\ntype: shell\nstatus: pending\ndepends_on: [design]\n---\n",
+		"04-spike.md":     "---\nid: spike\ntitle: Spike\ntype: shell\nstatus: abandoned\n---\n",
+		"05-notes.md":     "---\nid: notes\ntitle: Notes\ntype: oneshot\nstatus: completed\n---\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	plan, err := orchestration.LoadPlan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := map[orchestration.JobStatus]bool{
+		orchestration.JobStatusCompleted: true,
+		orchestration.JobStatusAbandoned: true,
+	}
+	prune, kept := selectPrunableJobs(plan, statuses)
+
+	var pruned []string
+	for _, job := range prune {
+		pruned = append(pruned, job.Filename)
+	}
+	if len(pruned) != 2 || pruned[0] != "04-spike.md" || pruned[1] != "05-notes.md" {
+		t.Errorf("pruned = %v, want [04-spike.md 05-notes.md]", pruned)
+	}
+	// design is kept because implement depends on it, and spec because design is kept
+	if _, ok := kept["design"]; !ok {
+		t.Error("design should be kept")
+	}
+	if _, ok := kept["spec"]; !ok {
+		t.Error("spec should be kept because design is kept")
+	}
+
+	archiveDir := filepath.Join(dir, archiveDirName)
+	for _, job := range prune {
+		if err := pruneJobFile(job, archiveDir, false); err != nil {
+			t.Fatalf("pruneJobFile() error = %v", err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(archiveDir, "05-notes.md")); err != nil {
+		t.Errorf("archived job missing: %v", err)
+	}
+	plan, err = orchestration.LoadPlan(dir)
+	if err != nil {
+		t.Fatalf("plan should still load after pruning: %v", err)
+	}
+	if len(plan.Jobs) != 3 {
+		t.Errorf("plan has %d jobs after pruning, want 3", len(plan.Jobs))
+	}
+}
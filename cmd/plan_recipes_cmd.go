@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/grovetools/core/cli"
@@ -19,55 +22,217 @@ var planRecipesCmd = &cobra.Command{
 var planRecipesListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List available plan recipes",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		domain, _ := cmd.Flags().GetString("domain")
-		// Load flow config to get dynamic recipe command
-		_, getRecipeCmd, err := loadFlowConfigWithDynamicRecipes()
-		if err != nil {
-			// Warning but don't fail - we can still list built-in and user recipes
-			fmt.Fprintf(os.Stderr, "Warning: could not load flow config: %v\n", err)
-		}
+	RunE:  runRecipesList,
+}
 
-		// List all recipes (user, dynamic, and built-in)
-		allRecipes, err := orchestration.ListAllRecipes(getRecipeCmd)
-		if err != nil {
-			return err
-		}
+var planRecipesShowCmd = &cobra.Command{
+	Use:   "show <recipe-name>",
+	Short: "Preview the job files a recipe would generate",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRecipesShow,
+}
+
+var recipeShowVars []string
+
+// recipeVarRefPattern finds {{ .Vars.name }} references in recipe job templates.
+var recipeVarRefPattern = regexp.MustCompile(`\.Vars\.([A-Za-z_][A-Za-z0-9_]*)`)
+
+// NewRecipeCmd creates the top-level `recipe` command.
+func NewRecipeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recipe",
+		Short: "Discover plan recipes",
+		Long: `List and preview the recipes available to 'flow plan init --recipe'.
 
-		var recipes []*orchestration.Recipe
-		if domain != "" {
-			for _, r := range allRecipes {
-				if r.Domain == domain {
-					recipes = append(recipes, r)
-				}
+Recipes come from the project, notebook, and user recipe directories, the
+get_recipe_cmd configured in grove.yml, and the built-in set.`,
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List available plan recipes",
+		RunE:  runRecipesList,
+	}
+	listCmd.Flags().String("domain", "", "Filter recipes by domain (e.g., generic, grove)")
+
+	showCmd := &cobra.Command{
+		Use:   "show <recipe-name>",
+		Short: "Preview the job files a recipe would generate",
+		Long: `Render each job file in a recipe and print it, without creating a plan.
+
+Variables referenced by the recipe are filled with <name> placeholders unless
+set in grove.yml or with --recipe-vars.
+
+Examples:
+  flow recipe show standard-feature
+  flow recipe show my-recipe --recipe-vars model=gemini-2.5-pro`,
+		Args: cobra.ExactArgs(1),
+		RunE: runRecipesShow,
+	}
+	showCmd.Flags().StringArrayVar(&recipeShowVars, "recipe-vars", nil, "Variables for the recipe templates (e.g., key=value)")
+
+	cmd.AddCommand(listCmd, showCmd)
+	return cmd
+}
+
+func runRecipesList(cmd *cobra.Command, args []string) error {
+	domain, _ := cmd.Flags().GetString("domain")
+	// Load flow config to get dynamic recipe command
+	_, getRecipeCmd, err := loadFlowConfigWithDynamicRecipes()
+	if err != nil {
+		// Warning but don't fail - we can still list built-in and user recipes
+		fmt.Fprintf(os.Stderr, "Warning: could not load flow config: %v\n", err)
+	}
+
+	// List all recipes (user, dynamic, and built-in)
+	allRecipes, err := orchestration.ListAllRecipes(getRecipeCmd)
+	if err != nil {
+		return err
+	}
+
+	var recipes []*orchestration.Recipe
+	if domain != "" {
+		for _, r := range allRecipes {
+			if r.Domain == domain {
+				recipes = append(recipes, r)
 			}
-		} else {
-			recipes = allRecipes
 		}
+	} else {
+		recipes = allRecipes
+	}
+
+	if len(recipes) == 0 {
+		fmt.Println("No plan recipes found.")
+		return nil
+	}
+
+	opts := cli.GetOptions(cmd)
+	if opts.JSONOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(recipes)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tDOMAIN\tSOURCE\tJOBS\tDESCRIPTION")
+	for _, r := range recipes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", r.Name, r.Domain, r.Source, len(r.Jobs), r.Description)
+	}
+	w.Flush()
+	return nil
+}
+
+func runRecipesShow(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	flowCfg, getRecipeCmd, err := loadFlowConfigWithDynamicRecipes()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not load flow config: %v\n", err)
+	}
 
-		if len(recipes) == 0 {
-			fmt.Println("No plan recipes found.")
-			return nil
+	recipe, err := orchestration.GetRecipe(name, getRecipeCmd)
+	if err != nil {
+		return err
+	}
+
+	var filenames []string
+	for filename := range recipe.Jobs {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	// Placeholders first, then grove.yml defaults, then flags, as plan init does
+	vars := make(map[string]string)
+	for _, filename := range filenames {
+		for _, m := range recipeVarRefPattern.FindAllStringSubmatch(string(recipe.Jobs[filename]), -1) {
+			vars[m[1]] = "<" + m[1] + ">"
+		}
+	}
+	for _, v := range recipe.Vars {
+		if v.Default != "" {
+			vars[v.Name] = v.Default
+		}
+	}
+	if flowCfg != nil && flowCfg.Recipes != nil {
+		if recipeCfg, ok := flowCfg.Recipes[name]; ok {
+			for k, v := range recipeCfg.Vars {
+				vars[k] = v
+			}
 		}
+	}
+	for _, v := range recipeShowVars {
+		for _, pair := range strings.Split(v, ",") {
+			parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+			if len(parts) == 2 {
+				vars[parts[0]] = parts[1]
+			}
+		}
+	}
+	templateData := struct {
+		PlanName string
+		Vars     map[string]string
+	}{
+		PlanName: "<plan-name>",
+		Vars:     vars,
+	}
 
-		opts := cli.GetOptions(cmd)
-		if opts.JSONOutput {
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			return encoder.Encode(recipes)
+	type renderedJob struct {
+		Filename string `json:"filename"`
+		Content  string `json:"content"`
+	}
+	var jobs []renderedJob
+	for _, filename := range filenames {
+		content, err := recipe.RenderJob(filename, templateData)
+		if err != nil {
+			return err
 		}
+		jobs = append(jobs, renderedJob{Filename: filename, Content: string(content)})
+	}
+
+	if cli.GetOptions(cmd).JSONOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(struct {
+			*orchestration.Recipe
+			Jobs []renderedJob `json:"jobs"`
+		}{recipe, jobs})
+	}
 
+	fmt.Printf("Recipe: %s", recipe.Name)
+	if recipe.Source != "" {
+		fmt.Printf(" %s", recipe.Source)
+	}
+	fmt.Println()
+	if recipe.Description != "" {
+		fmt.Println(recipe.Description)
+	}
+	if len(recipe.Vars) > 0 {
+		fmt.Println("\nVariables:")
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "NAME\tDOMAIN\tSOURCE\tDESCRIPTION")
-		for _, r := range recipes {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Name, r.Domain, r.Source, r.Description)
+		for _, v := range recipe.Vars {
+			detail := "optional"
+			if v.Required {
+				detail = "required"
+			}
+			if v.Default != "" {
+				detail += fmt.Sprintf(", default %q", v.Default)
+			}
+			fmt.Fprintf(w, "  %s\t(%s)\t%s\n", v.Name, detail, v.Description)
 		}
 		w.Flush()
-		return nil
-	},
+	}
+	for _, job := range jobs {
+		fmt.Printf("\n==> %s <==\n", job.Filename)
+		fmt.Print(job.Content)
+		if !strings.HasSuffix(job.Content, "\n") {
+			fmt.Println()
+		}
+	}
+	return nil
 }
 
 func init() {
 	planRecipesListCmd.Flags().String("domain", "", "Filter recipes by domain (e.g., generic, grove)")
 	planRecipesCmd.AddCommand(planRecipesListCmd)
-}
\ No newline at end of file
+	planRecipesShowCmd.Flags().StringArrayVar(&recipeShowVars, "recipe-vars", nil, "Variables for the recipe templates (e.g., key=value)")
+	planRecipesCmd.AddCommand(planRecipesShowCmd)
+}
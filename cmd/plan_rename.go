@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/grovetools/core/state"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var planRenameWorktree bool
+
+// NewPlanRenameCmd creates the `plan rename` command.
+func NewPlanRenameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename <old> <new>",
+		Short: "Rename a plan directory",
+		Long: `Rename a plan's directory under the plans root. If the active plan pointed
+at the old name, it is updated to the new one.
+
+With --rename-worktree, the plan's git worktree and branch are renamed to the
+new name as well, and the worktree references in .grove-plan.yml and the job
+files are updated.
+
+Examples:
+  flow plan rename auth-refactor auth-v2
+  flow plan rename auth-refactor auth-v2 --rename-worktree`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanRename,
+	}
+	cmd.Flags().BoolVar(&planRenameWorktree, "rename-worktree", false, "Also rename the plan's git worktree and branch")
+	return cmd
+}
+
+func runPlanRename(cmd *cobra.Command, args []string) error {
+	oldName, newName := args[0], args[1]
+	if err := validateDirectoryName(newName); err != nil {
+		return err
+	}
+	if strings.ContainsRune(newName, filepath.Separator) {
+		return fmt.Errorf("invalid plan name '%s': must not contain a path separator", newName)
+	}
+
+	oldPath, err := resolvePlanPath(oldName)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+	if info, err := os.Stat(oldPath); err != nil || !info.IsDir() {
+		return fmt.Errorf("plan directory does not exist: %s", oldPath)
+	}
+	newPath := filepath.Join(filepath.Dir(oldPath), newName)
+	if _, err := os.Stat(newPath); err == nil {
+		return fmt.Errorf("a plan already exists at %s", newPath)
+	}
+
+	plan, err := orchestration.LoadPlan(oldPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+	var oldWorktree string
+	if planRenameWorktree {
+		if plan.Config == nil || plan.Config.Worktree == "" {
+			return fmt.Errorf("plan '%s' has no associated worktree", plan.Name)
+		}
+		if len(plan.Config.Repos) > 0 {
+			return fmt.Errorf("--rename-worktree is not supported for ecosystem plans")
+		}
+		oldWorktree = plan.Config.Worktree
+	}
+
+	// Rename the worktree first so a failure leaves the plan untouched
+	var worktreePath string
+	if planRenameWorktree {
+		worktreePath, err = renameGitWorktree(oldWorktree, newName)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := movePlanDir(oldPath, newPath); err != nil {
+		if planRenameWorktree {
+			if _, undoErr := renameGitWorktree(newName, oldWorktree); undoErr != nil {
+				return fmt.Errorf("%w (restoring worktree '%s' also failed: %v)", err, oldWorktree, undoErr)
+			}
+		}
+		return err
+	}
+	fmt.Printf("%s Renamed plan '%s' to '%s'\n", theme.IconSuccess, filepath.Base(oldPath), newName)
+
+	activePlan, err := getActivePlanWithMigration()
+	if err == nil && activePlan != "" {
+		var newActive string
+		switch activePlan {
+		case oldName, filepath.Base(oldPath):
+			newActive = newName
+		case oldPath:
+			newActive = newPath
+		}
+		if newActive != "" {
+			if err := state.Set("flow.active_plan", newActive); err != nil {
+				return fmt.Errorf("update active job: %w", err)
+			}
+			fmt.Printf("Set active job to: %s\n", newActive)
+		}
+	}
+
+	if planRenameWorktree {
+		renamed, err := orchestration.LoadPlan(newPath)
+		if err != nil {
+			return fmt.Errorf("failed to load renamed plan: %w", err)
+		}
+		if err := setPlanWorktree(renamed, oldWorktree, newName); err != nil {
+			return fmt.Errorf("updating worktree references: %w", err)
+		}
+		if worktreePath != "" {
+			if err := setWorktreeActivePlan(worktreePath, newName); err != nil {
+				fmt.Printf("Warning: failed to update active plan in worktree: %v\n", err)
+			}
+		}
+		fmt.Printf("%s Renamed worktree and branch '%s' to '%s'\n", theme.IconSuccess, oldWorktree, newName)
+	}
+
+	return nil
+}
+
+// renameGitWorktree moves the worktree .grove-worktrees/<oldName> in the
+// current main repository to <newName> and renames its branch to match. It returns
+// the new worktree path, or "" if the worktree does not exist on disk.
+func renameGitWorktree(oldName, newName string) (string, error) {
+	gitRoot, err := mainRepoRoot(".")
+	if err != nil {
+		return "", fmt.Errorf("failed to find git root: %w", err)
+	}
+
+	var worktreePath string
+	oldPath := filepath.Join(gitRoot, ".grove-worktrees", oldName)
+	if _, err := os.Stat(oldPath); err == nil {
+		worktreePath = filepath.Join(gitRoot, ".grove-worktrees", newName)
+		if _, err := os.Stat(worktreePath); err == nil {
+			return "", fmt.Errorf("a worktree already exists at %s", worktreePath)
+		}
+		if out, err := exec.Command("git", "-C", gitRoot, "worktree", "move", oldPath, worktreePath).CombinedOutput(); err != nil {
+			return "", fmt.Errorf("git worktree move failed: %s", strings.TrimSpace(string(out)))
+		}
+	}
+
+	if err := exec.Command("git", "-C", gitRoot, "rev-parse", "--verify", "--quiet", "refs/heads/"+oldName).Run(); err == nil {
+		if out, err := exec.Command("git", "-C", gitRoot, "branch", "-m", oldName, newName).CombinedOutput(); err != nil {
+			return worktreePath, fmt.Errorf("git branch rename failed: %s", strings.TrimSpace(string(out)))
+		}
+	}
+
+	return worktreePath, nil
+}
+
+// setPlanWorktree points the plan's .grove-plan.yml and any job that named
+// oldName as its worktree at newName.
+func setPlanWorktree(plan *orchestration.Plan, oldName, newName string) error {
+	configPath := filepath.Join(plan.Directory, ".grove-plan.yml")
+	data, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	var config map[string]interface{}
+	if len(data) > 0 {
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			return err
+		}
+	}
+	if config["worktree"] == oldName {
+		config["worktree"] = newName
+		newData, err := yaml.Marshal(config)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(configPath, newData, 0644); err != nil {
+			return err
+		}
+	}
+
+	for _, job := range plan.Jobs {
+		if job.Worktree != oldName {
+			continue
+		}
+		content, err := os.ReadFile(job.FilePath)
+		if err != nil {
+			return fmt.Errorf("reading job file %s: %w", job.Filename, err)
+		}
+		content, err = orchestration.UpdateFrontmatter(content, map[string]interface{}{
+			"worktree": newName,
+		})
+		if err != nil {
+			return fmt.Errorf("updating frontmatter for %s: %w", job.Filename, err)
+		}
+		if err := os.WriteFile(job.FilePath, content, 0644); err != nil {
+			return fmt.Errorf("writing job file %s: %w", job.Filename, err)
+		}
+	}
+	return nil
+}
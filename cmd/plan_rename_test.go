@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/grovetools/flow/pkg/orchestration"
+)
+
+func TestSetPlanWorktree(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".grove-plan.yml": "model: gemini-2.5-pro\nworktree: old-tree\n",
+		"01-plan.md":      "---\nid: plan\ntitle: Plan\ntype: oneshot\nstatus: completed\nworktree: old-tree\n---\nPlan it\n",
+		"02-implement.md": "---\nid: implement\ntitle: Implement\ntype: shell\nstatus: pending\nworktree: other-tree\n---\necho hi\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	plan, err := orchestration.LoadPlan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := setPlanWorktree(plan, "old-tree", "new-tree"); err != nil {
+		t.Fatalf("setPlanWorktree() error = %v", err)
+	}
+
+	plan, err = orchestration.LoadPlan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.Config.Worktree != "new-tree" || plan.Config.Model != "gemini-2.5-pro" {
+		t.Errorf("plan config = %+v, want worktree new-tree and model kept", plan.Config)
+	}
+	if job, _ := plan.GetJobByID("plan"); job.Worktree != "new-tree" {
+		t.Errorf("job plan worktree = %q, want new-tree", job.Worktree)
+	}
+	if job, _ := plan.GetJobByID("implement"); job.Worktree != "other-tree" {
+		t.Errorf("job implement worktree = %q, want other-tree unchanged", job.Worktree)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "01-plan.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "Plan it") {
+		t.Errorf("job body was not preserved: %q", content)
+	}
+}
+
+func TestRenameGitWorktree_FromInsideWorktree(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := filepath.Join(root, ".grove-worktrees", "other")
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"commit", "-q", "--allow-empty", "-m", "initial"},
+		{"worktree", "add", "-q", "-b", "old-tree", filepath.Join(root, ".grove-worktrees", "old-tree")},
+		{"worktree", "add", "-q", "-b", "other", other},
+	} {
+		if _, err := runGitIn(root, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Run from another worktree: the rename must still target the main repository
+	t.Chdir(other)
+	got, err := renameGitWorktree("old-tree", "new-tree")
+	if err != nil {
+		t.Fatalf("renameGitWorktree() error = %v", err)
+	}
+	if want := filepath.Join(root, ".grove-worktrees", "new-tree"); got != want {
+		t.Errorf("renameGitWorktree() = %s, want %s", got, want)
+	}
+	if _, err := runGitIn(root, "rev-parse", "--verify", "--quiet", "refs/heads/new-tree"); err != nil {
+		t.Errorf("branch new-tree missing: %v", err)
+	}
+}
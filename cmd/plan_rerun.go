@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grovetools/core/pkg/process"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+var (
+	planRerunDir string
+	planRerunRun bool
+)
+
+// NewPlanRerunCmd creates the `plan rerun` command.
+func NewPlanRerunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rerun [job-id-or-file...]",
+		Short: "Reset failed jobs to pending so they can run again",
+		Long: `Reset jobs back to 'pending', clearing their start/end times, last error,
+and any output appended by previous runs.
+
+Without arguments, resets every failed job in the plan. With arguments, resets
+the named jobs (by ID or filename) regardless of status. Jobs that are currently
+running are never touched.
+
+Examples:
+  # Reset all failed jobs in the active plan
+  flow plan rerun
+
+  # Reset two specific jobs and run them immediately
+  flow plan rerun 02-api.md 03-tests.md --run`,
+		RunE: runPlanRerun,
+	}
+	cmd.Flags().StringVarP(&planRerunDir, "dir", "d", "", "Plan directory (defaults to the active plan)")
+	cmd.Flags().BoolVar(&planRerunRun, "run", false, "Run the reset jobs after resetting them")
+	return cmd
+}
+
+func runPlanRerun(cmd *cobra.Command, args []string) error {
+	planPath, err := resolvePlanPathWithActiveJob(planRerunDir)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	var candidates []*orchestration.Job
+	if len(args) > 0 {
+		for _, ref := range args {
+			job, found := plan.GetJobByID(ref)
+			if !found {
+				job, found = plan.GetJobByFilename(ref)
+			}
+			if !found {
+				return fmt.Errorf("job not found in plan: %s", ref)
+			}
+			candidates = append(candidates, job)
+		}
+	} else {
+		for _, job := range plan.GetJobsSortedByFilename() {
+			if job.Status == orchestration.JobStatusFailed {
+				candidates = append(candidates, job)
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		fmt.Println("No failed jobs to rerun.")
+		return nil
+	}
+
+	var reset []string
+	for _, job := range candidates {
+		if job.Status == orchestration.JobStatusRunning {
+			if pid, err := orchestration.ReadLockFile(job.FilePath); err == nil && process.IsProcessAlive(pid) {
+				fmt.Printf("%s Skipping %s: job is running (pid %d)\n", theme.IconWarning, job.Filename, pid)
+				continue
+			}
+		}
+		if err := orchestration.ResetJobForRerun(job); err != nil {
+			return fmt.Errorf("resetting %s: %w", job.Filename, err)
+		}
+		orchestration.RemoveLockFile(job.FilePath)
+		reset = append(reset, job.FilePath)
+		fmt.Printf("%s Reset %s to pending\n", theme.IconSuccess, job.Filename)
+	}
+
+	fmt.Printf("Reset %d job(s)\n", len(reset))
+
+	if planRerunRun && len(reset) > 0 {
+		return runPlanRun(cmd, reset)
+	}
+	return nil
+}
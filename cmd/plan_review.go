@@ -1,12 +1,10 @@
 package cmd
 
 import (
-	"bytes"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"text/template"
 
 	"github.com/grovetools/flow/pkg/orchestration"
 	"github.com/spf13/cobra"
@@ -57,41 +55,18 @@ func runPlanReview(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Find the first job with a note_ref
-	var noteRef string
-	for _, job := range plan.Jobs {
-		if job.NoteRef != "" {
-			noteRef = job.NoteRef
-			break
-		}
-	}
-
 	// Execute on_review hook if it exists
 	if plan.Config != nil && plan.Config.Hooks != nil {
 		if hookCmdStr, ok := plan.Config.Hooks["on_review"]; ok && hookCmdStr != "" {
 			fmt.Println("▶️  Executing on_review hook...")
 
-			// Prepare template data
-			templateData := struct {
-				PlanName string
-				NoteRef  string
-			}{
-				PlanName: plan.Name,
-				NoteRef:  noteRef,
-			}
-
-			// Render the hook command
-			tmpl, err := template.New("hook").Parse(hookCmdStr)
+			renderedCmd, err := orchestration.RenderHookCommand(hookCmdStr, orchestration.NewPlanHookData(plan))
 			if err != nil {
-				return fmt.Errorf("failed to parse on_review hook template: %w", err)
-			}
-			var renderedCmd bytes.Buffer
-			if err := tmpl.Execute(&renderedCmd, templateData); err != nil {
 				return fmt.Errorf("failed to render on_review hook command: %w", err)
 			}
 
 			// Execute the command
-			hookCmd := exec.Command("sh", "-c", renderedCmd.String())
+			hookCmd := exec.Command("sh", "-c", renderedCmd)
 			hookCmd.Stdout = os.Stdout
 			hookCmd.Stderr = os.Stderr
 			if err := hookCmd.Run(); err != nil {
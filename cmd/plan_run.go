@@ -5,10 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/fatih/color"
@@ -29,6 +32,11 @@ var ulog = grovelogging.NewUnifiedLogger("grove-flow")
 func runPlanRun(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	if err := validatePlanRunFlags(cmd); err != nil {
+		return err
+	}
+	defer orchestration.RemoveFetchedSources()
+
 	// Load flow config
 	flowCfg, err := loadFlowConfig()
 	if err != nil {
@@ -124,6 +132,18 @@ func runPlanRun(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("cannot run jobs: plan is on hold. Use 'flow plan unhold' to resume")
 	}
 
+	// A worktree matrix fans the whole plan out, one copy per worktree
+	matrix := planRunWorktreeMatrix
+	if len(matrix) == 0 && plan.Config != nil {
+		matrix = plan.Config.Worktrees
+	}
+	if len(matrix) > 0 && len(targetJobs) > 0 {
+		return fmt.Errorf("--worktree-matrix runs the whole plan and cannot be combined with specific jobs")
+	}
+	if planRunOnlyReady && (planRunAll || len(targetJobs) > 0 || len(matrix) > 0) {
+		return fmt.Errorf("--only-ready runs the plan's ready jobs and cannot be combined with --all, --worktree-matrix or specific jobs")
+	}
+
 	// Check for multiple worktrees
 	worktrees := make(map[string]bool)
 	hasMainRepo := false
@@ -185,7 +205,7 @@ func runPlanRun(cmd *cobra.Command, args []string) error {
 
 	// If plan uses a single worktree and we're not already in that session, create/switch to it
 	// Only do this for interactive_agent job type
-	if len(worktrees) == 1 && !hasMainRepo {
+	if len(worktrees) == 1 && !hasMainRepo && len(matrix) == 0 {
 		// Check if the jobs we're about to run include any interactive_agent jobs
 		hasInteractiveJobs := false
 		if len(jobsToRun) > 0 {
@@ -201,7 +221,7 @@ func runPlanRun(cmd *cobra.Command, args []string) error {
 		}
 
 		// Only prompt for tmux session if we have interactive_agent jobs to run
-		if !hasInteractiveJobs {
+		if !hasInteractiveJobs || planRunDryRun {
 			// Skip tmux session management for all other job types
 		} else {
 			worktreeName := ""
@@ -305,24 +325,25 @@ func runPlanRun(cmd *cobra.Command, args []string) error {
 	}
 
 	// Inject the loaded configuration into the plan object
-	plan.Orchestration = &orchestration.Config{
-		OneshotModel:         flowCfg.OneshotModel,
-		TargetAgentContainer: flowCfg.TargetAgentContainer,
-		PlansDirectory:       flowCfg.PlansDirectory,
-		MaxConsecutiveSteps:  flowCfg.MaxConsecutiveSteps,
-	}
-
-	// Check if any oneshot jobs need to be run
-	hasOneShot := false
+	plan.Orchestration = flowCfg.orchestrationConfig()
+	plan.Orchestration.Offline = planRunOffline
+	plan.Orchestration.PromptPrefix = planRunPromptPrefix
+	plan.Orchestration.PromptSuffix = planRunPromptSuffix
+
+	// Check if any pending oneshot job uses a model served by the llm command;
+	// claude, gemini and OpenAI-compatible models call their APIs directly.
+	needsLLMCommand := false
 	for _, job := range plan.Jobs {
 		if job.Type == orchestration.JobTypeOneshot && job.Status == orchestration.JobStatusPending {
-			hasOneShot = true
-			break
+			_, model := orchestration.ExplainModel(job, plan, planRunModel)
+			if orchestration.RequiresLLMCommand(model, plan) {
+				needsLLMCommand = true
+				break
+			}
 		}
 	}
 
-	// Check for llm command if oneshot jobs are present
-	if hasOneShot {
+	if needsLLMCommand && !planRunDryRun {
 		if _, err := exec.LookPath("llm"); err != nil {
 			return fmt.Errorf("dependency 'llm' not found. Please install with 'pip install llm'")
 		}
@@ -336,14 +357,32 @@ func runPlanRun(cmd *cobra.Command, args []string) error {
 	if flowCfg.MaxConsecutiveSteps > 0 {
 		maxSteps = flowCfg.MaxConsecutiveSteps
 	}
+	maxParallel, err := resolveMaxParallel(cmd, plan)
+	if err != nil {
+		return err
+	}
 	orchConfig := &orchestration.OrchestratorConfig{
-		MaxParallelJobs:     planRunParallel,
+		MaxParallelJobs:     maxParallel,
 		CheckInterval:       5 * time.Second,
 		ModelOverride:       modelOverride,
+		TimeoutOverride:     planRunTimeout,
 		MaxConsecutiveSteps: maxSteps,
 		SkipInteractive:     planRunSkipInteractive || planRunYes, // --yes implies skip interactive
+		DryRun:              planRunDryRun,
+		Restart:             planRunRestart,
+		ForceContext:        planRunForceContext,
+		MaxTurns:            planRunMaxTurns,
+		ContextFiles:        resolveRunContextFiles(planRunContextFiles),
+		NoContext:           planRunNoContext,
+		SaveRawOutput:       planRunSaveRaw,
+		ContinueOnFailure:   !planRunFailFast,
 	}
 	
+	// Only override retries if explicitly provided via CLI flag
+	if cmd.Flags().Changed("retry-count") {
+		orchConfig.RetryOverride = &planRunRetryCount
+	}
+
 	// Add summary configuration if enabled
 	if flowCfg.SummarizeOnComplete {
 		orchConfig.SummaryConfig = &orchestration.SummaryConfig{
@@ -354,10 +393,19 @@ func runPlanRun(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Create orchestrator
-	orch, err := orchestration.NewOrchestrator(plan, orchConfig)
-	if err != nil {
-		return fmt.Errorf("create orchestrator: %w", err)
+	if len(matrix) > 0 {
+		return runWorktreeMatrix(ctx, plan, matrix, orchConfig)
+	}
+
+	// --all and --only-ready run through orchestration.RunPlan, as embedders do
+	runOpts := orchestration.RunOptions{
+		Parallelism:       maxParallel,
+		SkipInteractive:   orchConfig.SkipInteractive,
+		ModelOverride:     modelOverride,
+		DryRun:            planRunDryRun,
+		ContinueOnFailure: !planRunFailFast,
+		OnlyReady:         planRunOnlyReady,
+		Config:            orchConfig,
 	}
 
 	// Handle different run modes
@@ -453,19 +501,60 @@ func runPlanRun(cmd *cobra.Command, args []string) error {
 			}
 		}
 		// Run all jobs
-		runErr = runAllJobs(ctx, orch, plan, cmd)
-	} else if planRunNext {
-		// Run next available jobs
-		runErr = runNextJobs(ctx, orch, plan, cmd)
+		runErr = runAllJobs(ctx, plan, runOpts)
+	} else if planRunOnlyReady {
+		// Run the ready frontier, then stop
+		runErr = runNextJobs(ctx, plan, func(ctx context.Context) error {
+			_, err := orchestration.RunPlan(ctx, plan, runOpts)
+			return err
+		})
 	} else {
-		// Default to running next if no flags specified
+		// Run next available jobs, the default if no flags are specified
 		planRunNext = true
-		runErr = runNextJobs(ctx, orch, plan, cmd)
+		orch, err := orchestration.NewOrchestrator(plan, orchConfig)
+		if err != nil {
+			return fmt.Errorf("create orchestrator: %w", err)
+		}
+		runErr = runNextJobs(ctx, plan, orch.RunNext)
+	}
+
+	if planRunReport != "" && !planRunDryRun {
+		if err := writePlanRunReport(plan.Directory, planRunReport); err != nil && runErr == nil {
+			runErr = err
+		}
+	}
+
+	// With --rerun-on-change a failed run is reported but doesn't stop the watcher
+	if planRunRerunOnChange && !planRunDryRun {
+		if runErr != nil {
+			fmt.Printf("%s %v\n", color.RedString(theme.IconError), runErr)
+		}
+		return watchPlanAndRerun(ctx, plan.Directory, flowCfg, orchConfig)
 	}
 
 	return runErr
 }
 
+// writePlanRunReport reloads the plan to pick up each job's final state, writes
+// the report to path, and returns an error if any job ended in failed.
+func writePlanRunReport(planDir, path string) error {
+	plan, err := orchestration.LoadPlan(planDir)
+	if err != nil {
+		return fmt.Errorf("failed to reload plan for report: %w", err)
+	}
+
+	report := orchestration.BuildRunReport(plan, planRunModel)
+	if err := orchestration.WriteRunReport(report, path, planRunReportFormat); err != nil {
+		return fmt.Errorf("failed to write run report: %w", err)
+	}
+	fmt.Printf("%s Run report written to %s\n", theme.IconSuccess, path)
+
+	if failed := report.Failed(); failed > 0 {
+		return fmt.Errorf("%d job(s) failed", failed)
+	}
+	return nil
+}
+
 // runSingleJob executes a specific job.
 func runSingleJob(ctx context.Context, orch *orchestration.Orchestrator, plan *orchestration.Plan, jobFile string, skipConfirm bool) error {
 	// Find the job
@@ -502,8 +591,8 @@ func runSingleJob(ctx context.Context, orch *orchestration.Orchestrator, plan *o
 		fmt.Printf("Status: %s → %s\n", job.Status, orchestration.JobStatusRunning)
 		fmt.Printf("Dependencies: %s All satisfied\n", color.GreenString(theme.IconSuccess))
 
-		// Confirm execution unless --yes
-		if !planRunYes {
+		// Confirm execution unless --yes or --dry-run
+		if !planRunYes && !planRunDryRun {
 			fmt.Print("\nExecute this job? [Y/n]: ")
 			reader := bufio.NewReader(os.Stdin)
 			response, _ := reader.ReadString('\n')
@@ -532,6 +621,10 @@ func runSingleJob(ctx context.Context, orch *orchestration.Orchestrator, plan *o
 		return err
 	}
 
+	if planRunDryRun {
+		return nil
+	}
+
 	ulog.Success("Job completed").
 		Field("job", job.Title).
 		Pretty(fmt.Sprintf("%s Job completed: %s", color.GreenString(theme.IconSuccess), job.Title)).
@@ -539,10 +632,14 @@ func runSingleJob(ctx context.Context, orch *orchestration.Orchestrator, plan *o
 	return nil
 }
 
-// runNextJobs executes all currently runnable jobs.
-func runNextJobs(ctx context.Context, orch *orchestration.Orchestrator, plan *orchestration.Plan, cmd *cobra.Command) error {
+// runNextJobs confirms and then calls run to execute the plan's currently
+// runnable jobs.
+func runNextJobs(ctx context.Context, plan *orchestration.Plan, run func(context.Context) error) error {
 	// Get current status
-	status := orch.GetStatus()
+	status, err := orchestration.GetPlanStatus(plan)
+	if err != nil {
+		return fmt.Errorf("build dependency graph: %w", err)
+	}
 
 	// Get runnable jobs first to determine if there's anything to do
 	graph, _ := orchestration.BuildDependencyGraph(plan)
@@ -564,14 +661,18 @@ func runNextJobs(ctx context.Context, orch *orchestration.Orchestrator, plan *or
 		return fmt.Errorf("no runnable jobs - check for failed dependencies")
 	}
 
-	// Show what will run
+	// Show what will run, and how, unless --yes
 	fmt.Println("Ready to run:")
-	for _, job := range runnable {
-		fmt.Printf("- %s (%s)\n", job.Filename, job.Title)
+	if planRunYes {
+		for _, job := range runnable {
+			fmt.Printf("- %s (%s)\n", job.Filename, job.Title)
+		}
+	} else {
+		printRunPreview(os.Stdout, orchestration.BuildRunPreview(plan, runnable, planRunModel, planRunNoContext))
 	}
 
-	// Confirm unless --yes
-	if !planRunYes {
+	// Confirm unless --yes or --dry-run
+	if !planRunYes && !planRunDryRun {
 		fmt.Printf("\nRun %d job(s)? [Y/n]: ", len(runnable))
 		reader := bufio.NewReader(os.Stdin)
 		response, _ := reader.ReadString('\n')
@@ -586,19 +687,81 @@ func runNextJobs(ctx context.Context, orch *orchestration.Orchestrator, plan *or
 	fmt.Printf("\n%s Running %d job(s)...\n",
 		color.YellowString(theme.IconRunning), len(runnable))
 
-	err := orch.RunNext(ctx)
-	if err != nil {
+	if err := run(ctx); err != nil {
 		return fmt.Errorf("execution failed: %w", err)
 	}
 
+	if planRunDryRun {
+		fmt.Printf("\n%s Dry run complete, no jobs were executed\n", color.GreenString(theme.IconSuccess))
+		return nil
+	}
+
 	fmt.Printf("%s All jobs completed\n", color.GreenString(theme.IconSuccess))
+	if planRunOnlyReady {
+		printNextReadyJobs(plan)
+	}
 	return nil
 }
 
-// runAllJobs executes all remaining jobs in the plan.
-func runAllJobs(ctx context.Context, orch *orchestration.Orchestrator, plan *orchestration.Plan, cmd *cobra.Command) error {
+// printNextReadyJobs lists the jobs unblocked by an --only-ready run, which
+// the next invocation will run.
+func printNextReadyJobs(plan *orchestration.Plan) {
+	graph, err := orchestration.BuildDependencyGraph(plan)
+	if err != nil {
+		return
+	}
+	next := graph.GetRunnableJobs()
+	if len(next) == 0 {
+		return
+	}
+	sort.Slice(next, func(i, j int) bool { return next[i].Filename < next[j].Filename })
+	fmt.Printf("\nNow ready (run again with --only-ready to continue):\n")
+	for _, job := range next {
+		fmt.Printf("- %s (%s)\n", job.Filename, job.Title)
+	}
+}
+
+// pendingJobs returns the plan's jobs that a full run has yet to run.
+func pendingJobs(plan *orchestration.Plan) []*orchestration.Job {
+	var jobs []*orchestration.Job
+	for _, job := range plan.Jobs {
+		switch job.Status {
+		case orchestration.JobStatusPending, orchestration.JobStatusPendingUser, orchestration.JobStatusPendingLLM:
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+// printRunPreview lists each job's type, effective model, worktree and context
+// before it runs, so a wrong worktree or an expensive model is caught before
+// the run starts.
+func printRunPreview(w io.Writer, preview []orchestration.RunPreviewJob) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  JOB\tTYPE\tMODEL\tWORKTREE\tCONTEXT\tTITLE")
+	for _, job := range preview {
+		model, worktree, contextDesc := "-", "(project root)", "none"
+		if job.Model != "" {
+			model = job.Model
+		}
+		if job.Worktree != "" {
+			worktree = job.Worktree
+		}
+		if job.Context != "" {
+			contextDesc = job.Context
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t%s\n", job.Filename, job.Type, model, worktree, contextDesc, job.Title)
+	}
+	tw.Flush()
+}
+
+// runAllJobs executes all remaining jobs in the plan with orchestration.RunPlan.
+func runAllJobs(ctx context.Context, plan *orchestration.Plan, opts orchestration.RunOptions) error {
 	// Get initial status
-	status := orch.GetStatus()
+	status, err := orchestration.GetPlanStatus(plan)
+	if err != nil {
+		return fmt.Errorf("build dependency graph: %w", err)
+	}
 
 	remaining := status.Pending + status.Running
 	if remaining == 0 {
@@ -614,8 +777,13 @@ func runAllJobs(ctx context.Context, orch *orchestration.Orchestrator, plan *orc
 	fmt.Printf("Total jobs: %d (%d completed, %d remaining)\n",
 		status.Total, status.Completed, remaining)
 
-	// Confirm unless --yes
 	if !planRunYes {
+		fmt.Println()
+		printRunPreview(os.Stdout, orchestration.BuildRunPreview(plan, pendingJobs(plan), planRunModel, planRunNoContext))
+	}
+
+	// Confirm unless --yes or --dry-run
+	if !planRunYes && !planRunDryRun {
 		fmt.Print("\nThis will run all remaining jobs. Continue? [Y/n]: ")
 		reader := bufio.NewReader(os.Stdin)
 		response, _ := reader.ReadString('\n')
@@ -631,19 +799,29 @@ func runAllJobs(ctx context.Context, orch *orchestration.Orchestrator, plan *orc
 
 	// Set up progress monitoring if --watch
 	if planRunWatch {
-		go monitorProgress(ctx, orch)
+		opts.Progress = progressPrinter()
 	}
 
-	err := orch.RunAll(ctx)
+	result, err := orchestration.RunPlan(ctx, plan, opts)
 	if err != nil {
 		return fmt.Errorf("orchestration failed: %w", err)
 	}
 
+	if planRunDryRun {
+		fmt.Printf("\n%s Dry run complete, no jobs were executed\n", color.GreenString(theme.IconSuccess))
+		return nil
+	}
+
 	// Final status
-	finalStatus := orch.GetStatus()
+	completed := 0
+	for _, job := range result.Jobs {
+		if job.Status == orchestration.JobStatusCompleted {
+			completed++
+		}
+	}
 	fmt.Printf("\n%s Orchestration complete!\n", color.GreenString(theme.IconSuccess))
 	fmt.Printf("Completed: %d, Failed: %d\n",
-		finalStatus.Completed, finalStatus.Failed)
+		completed, len(result.Failed()))
 
 	return nil
 }
@@ -670,6 +848,8 @@ func getUnmetDependencies(job *orchestration.Job, plan *orchestration.Plan) []st
 		} else if (job.Type == orchestration.JobTypeInteractiveAgent || job.Type == orchestration.JobTypeAgent) && dep.Type == orchestration.JobTypeChat && dep.Status == orchestration.JobStatusPendingUser {
 			// Special case: an interactive agent can run if its chat dependency is pending user input.
 			dependencyMet = true
+		} else if dep.Status == orchestration.JobStatusSkipped && job.AllowSkippedDeps {
+			dependencyMet = true
 		}
 
 		if !dependencyMet {
@@ -680,27 +860,19 @@ func getUnmetDependencies(job *orchestration.Job, plan *orchestration.Plan) []st
 	return unmet
 }
 
-// monitorProgress displays real-time progress updates.
-func monitorProgress(ctx context.Context, orch *orchestration.Orchestrator) {
-	ticker := time.NewTicker(2 * time.Second)
-	defer ticker.Stop()
-
+// progressPrinter returns a RunOptions.Progress callback that displays
+// real-time progress updates.
+func progressPrinter() func(*orchestration.PlanStatus) {
 	spinner := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 	i := 0
 
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			status := orch.GetStatus()
-			fmt.Printf("\r%s Progress: %d/%d completed, %d running",
-				spinner[i%len(spinner)],
-				status.Completed,
-				status.Total,
-				status.Running)
-			i++
-		}
+	return func(status *orchestration.PlanStatus) {
+		fmt.Printf("\r%s Progress: %d/%d completed, %d running",
+			spinner[i%len(spinner)],
+			status.Completed,
+			status.Total,
+			status.Running)
+		i++
 	}
 }
 
@@ -708,10 +880,126 @@ func monitorProgress(ctx context.Context, orch *orchestration.Orchestrator) {
 var (
 	planRunParallel        int
 	planRunWatch           bool
+	planRunRerunOnChange   bool
 	planRunYes             bool
 	planRunSkipInteractive bool
+	planRunDryRun          bool
+	planRunTimeout         time.Duration
+	planRunRetryCount      int
+	planRunRestart         bool
+	planRunReport          string
+	planRunReportFormat    string
+	planRunForceContext    bool
+	planRunMaxTurns        int
+	planRunContextFiles    []string
+	planRunNoContext       bool
+	planRunSaveRaw         bool
+	planRunFailFast        bool
+	planRunWorktreeMatrix  []string
+	planRunOffline         bool
+	planRunPromptPrefix    string
+	planRunPromptSuffix    string
+	planRunOnlyReady       bool
 )
 
+// validatePlanRunFlags rejects flag values that would otherwise only surface
+// once jobs have run.
+func validatePlanRunFlags(cmd *cobra.Command) error {
+	if cmd.Flags().Changed("retry-count") && planRunRetryCount < 0 {
+		return fmt.Errorf("--retry-count must be 0 or greater, got %d", planRunRetryCount)
+	}
+	switch planRunReportFormat {
+	case "", "markdown", "md", "json":
+	default:
+		return fmt.Errorf("unsupported --report-format '%s' (use markdown or json)", planRunReportFormat)
+	}
+	return nil
+}
+
+// resolveRunContextFiles makes --context-file paths absolute relative to the
+// working directory, skipping any that don't exist.
+func resolveRunContextFiles(paths []string) []string {
+	var resolved []string
+	for _, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err == nil {
+			_, err = os.Stat(absPath)
+		}
+		if err != nil {
+			fmt.Printf("%s Warning: skipping context file %s: %v\n", color.YellowString(theme.IconWarning), path, err)
+			continue
+		}
+		resolved = append(resolved, absPath)
+	}
+	return resolved
+}
+
+// resolveMaxParallel returns how many jobs plan run may execute at once: the
+// --parallel flag if given, else the plan's max_parallel, else the flag default.
+func resolveMaxParallel(cmd *cobra.Command, plan *orchestration.Plan) (int, error) {
+	if cmd.Flags().Changed("parallel") {
+		if planRunParallel < 1 {
+			return 0, fmt.Errorf("--parallel must be at least 1, got %d", planRunParallel)
+		}
+		return planRunParallel, nil
+	}
+	if plan.Config != nil && plan.Config.MaxParallel != 0 {
+		if plan.Config.MaxParallel < 1 {
+			return 0, fmt.Errorf("max_parallel in .grove-plan.yml must be at least 1, got %d", plan.Config.MaxParallel)
+		}
+		return plan.Config.MaxParallel, nil
+	}
+	return planRunParallel, nil
+}
+
+// runWorktreeMatrix runs every job in the plan once per worktree. Each worktree
+// gets its own copy of the plan (see orchestration.PrepareMatrixPlan), so job
+// output never collides; a failure in one worktree does not stop the others.
+// With --report, each worktree's report is written next to the requested path
+// (see matrixReportPath).
+func runWorktreeMatrix(ctx context.Context, plan *orchestration.Plan, matrix []string, orchConfig *orchestration.OrchestratorConfig) error {
+	var failed []string
+	for _, worktree := range matrix {
+		worktree = strings.TrimSpace(worktree)
+		if worktree == "" {
+			continue
+		}
+		fmt.Printf("\n%s Running plan in worktree '%s'\n", color.CyanString(theme.IconRunning), worktree)
+
+		matrixPlan, err := orchestration.PrepareMatrixPlan(plan, worktree)
+		if err != nil {
+			fmt.Printf("%s %s: %v\n", color.RedString(theme.IconError), worktree, err)
+			failed = append(failed, worktree)
+			continue
+		}
+		_, runErr := orchestration.RunPlan(ctx, matrixPlan, orchestration.RunOptions{Config: orchConfig})
+		if planRunReport != "" && !planRunDryRun {
+			if err := writePlanRunReport(matrixPlan.Directory, matrixReportPath(planRunReport, worktree)); err != nil && runErr == nil {
+				runErr = err
+			}
+		}
+		if runErr != nil {
+			fmt.Printf("%s %s: %v\n", color.RedString(theme.IconError), worktree, runErr)
+			failed = append(failed, worktree)
+			continue
+		}
+		fmt.Printf("%s Worktree '%s' completed. Output: %s\n", color.GreenString(theme.IconSuccess), worktree, matrixPlan.Directory)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("plan failed in %d of %d worktree(s): %s", len(failed), len(matrix), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// matrixReportPath returns where a matrix run writes worktree's --report: the
+// report path with the worktree name added before its extension, e.g.
+// report-feature-a.md.
+func matrixReportPath(path, worktree string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "-" + worktree + ext
+}
+
 // buildRunCommandForTmux reconstructs the flow plan run command with its flags for execution inside tmux.
 func buildRunCommandForTmux(cmd *cobra.Command, args []string) []string {
 	flowCmd := []string{"flow", "plan", "run"}
@@ -723,21 +1011,74 @@ func buildRunCommandForTmux(cmd *cobra.Command, args []string) []string {
 	if cmd.Flags().Changed("next") && planRunNext {
 		flowCmd = append(flowCmd, "--next")
 	}
+	if cmd.Flags().Changed("only-ready") && planRunOnlyReady {
+		flowCmd = append(flowCmd, "--only-ready")
+	}
 	if cmd.Flags().Changed("yes") && planRunYes {
 		flowCmd = append(flowCmd, "--yes")
 	}
 	if cmd.Flags().Changed("watch") && planRunWatch {
 		flowCmd = append(flowCmd, "--watch")
 	}
+	if cmd.Flags().Changed("rerun-on-change") && planRunRerunOnChange {
+		flowCmd = append(flowCmd, "--rerun-on-change")
+	}
 	if cmd.Flags().Changed("skip-interactive") && planRunSkipInteractive {
 		flowCmd = append(flowCmd, "--skip-interactive")
 	}
+	if cmd.Flags().Changed("restart") && planRunRestart {
+		flowCmd = append(flowCmd, "--restart")
+	}
+	if cmd.Flags().Changed("dry-run") && planRunDryRun {
+		flowCmd = append(flowCmd, "--dry-run")
+	}
 	if cmd.Flags().Changed("parallel") {
 		flowCmd = append(flowCmd, "--parallel", fmt.Sprintf("%d", planRunParallel))
 	}
 	if cmd.Flags().Changed("model") && planRunModel != "" {
 		flowCmd = append(flowCmd, "--model", planRunModel)
 	}
+	if cmd.Flags().Changed("timeout") {
+		flowCmd = append(flowCmd, "--timeout", planRunTimeout.String())
+	}
+	if cmd.Flags().Changed("retry-count") {
+		flowCmd = append(flowCmd, "--retry-count", fmt.Sprintf("%d", planRunRetryCount))
+	}
+	if cmd.Flags().Changed("max-turns") {
+		flowCmd = append(flowCmd, "--max-turns", fmt.Sprintf("%d", planRunMaxTurns))
+	}
+	if cmd.Flags().Changed("fail-fast") {
+		flowCmd = append(flowCmd, fmt.Sprintf("--fail-fast=%t", planRunFailFast))
+	}
+	if cmd.Flags().Changed("force-context") && planRunForceContext {
+		flowCmd = append(flowCmd, "--force-context")
+	}
+	if cmd.Flags().Changed("no-context") && planRunNoContext {
+		flowCmd = append(flowCmd, "--no-context")
+	}
+	if cmd.Flags().Changed("context-file") {
+		for _, file := range planRunContextFiles {
+			flowCmd = append(flowCmd, "--context-file", file)
+		}
+	}
+	if cmd.Flags().Changed("offline") && planRunOffline {
+		flowCmd = append(flowCmd, "--offline")
+	}
+	if cmd.Flags().Changed("save-raw") && planRunSaveRaw {
+		flowCmd = append(flowCmd, "--save-raw")
+	}
+	if cmd.Flags().Changed("prompt-prefix") {
+		flowCmd = append(flowCmd, "--prompt-prefix", planRunPromptPrefix)
+	}
+	if cmd.Flags().Changed("prompt-suffix") {
+		flowCmd = append(flowCmd, "--prompt-suffix", planRunPromptSuffix)
+	}
+	if cmd.Flags().Changed("report") {
+		flowCmd = append(flowCmd, "--report", planRunReport)
+	}
+	if cmd.Flags().Changed("report-format") {
+		flowCmd = append(flowCmd, "--report-format", planRunReportFormat)
+	}
 
 	// Add the original arguments
 	flowCmd = append(flowCmd, args...)
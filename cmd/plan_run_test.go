@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,3 +37,135 @@ func TestLooksLikeFilePath(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveMaxParallel(t *testing.T) {
+	defer func(v int) { planRunParallel = v }(planRunParallel)
+
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().IntVarP(&planRunParallel, "parallel", "p", 3, "")
+		return cmd
+	}
+	planWith := func(maxParallel int) *orchestration.Plan {
+		return &orchestration.Plan{Config: &orchestration.PlanConfig{MaxParallel: maxParallel}}
+	}
+
+	if got, err := resolveMaxParallel(newCmd(), &orchestration.Plan{}); err != nil || got != 3 {
+		t.Errorf("default = %d, %v; want 3", got, err)
+	}
+	if got, err := resolveMaxParallel(newCmd(), planWith(5)); err != nil || got != 5 {
+		t.Errorf("plan max_parallel = %d, %v; want 5", got, err)
+	}
+	if _, err := resolveMaxParallel(newCmd(), planWith(-1)); err == nil {
+		t.Error("expected an error for max_parallel below 1")
+	}
+
+	cmd := newCmd()
+	cmd.Flags().Set("parallel", "2")
+	if got, err := resolveMaxParallel(cmd, planWith(5)); err != nil || got != 2 {
+		t.Errorf("--parallel over plan = %d, %v; want 2", got, err)
+	}
+	cmd = newCmd()
+	cmd.Flags().Set("parallel", "0")
+	if _, err := resolveMaxParallel(cmd, planWith(5)); err == nil {
+		t.Error("expected an error for --parallel 0")
+	}
+}
+
+func TestValidatePlanRunFlags(t *testing.T) {
+	defer func(v int, format string) { planRunRetryCount, planRunReportFormat = v, format }(planRunRetryCount, planRunReportFormat)
+
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().IntVar(&planRunRetryCount, "retry-count", 0, "")
+		cmd.Flags().StringVar(&planRunReportFormat, "report-format", "markdown", "")
+		return cmd
+	}
+
+	if err := validatePlanRunFlags(newCmd()); err != nil {
+		t.Errorf("defaults: unexpected error %v", err)
+	}
+	cmd := newCmd()
+	cmd.Flags().Set("retry-count", "0")
+	if err := validatePlanRunFlags(cmd); err != nil {
+		t.Errorf("--retry-count 0: unexpected error %v", err)
+	}
+	cmd = newCmd()
+	cmd.Flags().Set("retry-count", "-1")
+	if err := validatePlanRunFlags(cmd); err == nil {
+		t.Error("expected an error for --retry-count -1")
+	}
+	cmd = newCmd()
+	cmd.Flags().Set("report-format", "json")
+	if err := validatePlanRunFlags(cmd); err != nil {
+		t.Errorf("--report-format json: unexpected error %v", err)
+	}
+	cmd = newCmd()
+	cmd.Flags().Set("report-format", "yaml")
+	if err := validatePlanRunFlags(cmd); err == nil {
+		t.Error("expected an error for --report-format yaml")
+	}
+}
+
+func TestBuildRunCommandForTmux_ForwardsRunFlags(t *testing.T) {
+	defer func(files []string, turns int, failFast, noContext, offline, saveRaw bool, prefix, suffix, report, format string) {
+		planRunContextFiles, planRunMaxTurns = files, turns
+		planRunFailFast, planRunNoContext, planRunOffline, planRunSaveRaw = failFast, noContext, offline, saveRaw
+		planRunPromptPrefix, planRunPromptSuffix = prefix, suffix
+		planRunReport, planRunReportFormat = report, format
+	}(planRunContextFiles, planRunMaxTurns, planRunFailFast, planRunNoContext, planRunOffline, planRunSaveRaw,
+		planRunPromptPrefix, planRunPromptSuffix, planRunReport, planRunReportFormat)
+
+	cmd := &cobra.Command{}
+	cmd.Flags().StringArrayVar(&planRunContextFiles, "context-file", nil, "")
+	cmd.Flags().BoolVar(&planRunNoContext, "no-context", false, "")
+	cmd.Flags().IntVar(&planRunMaxTurns, "max-turns", 0, "")
+	cmd.Flags().BoolVar(&planRunOffline, "offline", false, "")
+	cmd.Flags().BoolVar(&planRunSaveRaw, "save-raw", false, "")
+	cmd.Flags().BoolVar(&planRunFailFast, "fail-fast", true, "")
+	cmd.Flags().StringVar(&planRunPromptPrefix, "prompt-prefix", "", "")
+	cmd.Flags().StringVar(&planRunPromptSuffix, "prompt-suffix", "", "")
+	cmd.Flags().StringVar(&planRunReport, "report", "", "")
+	cmd.Flags().StringVar(&planRunReportFormat, "report-format", "markdown", "")
+
+	assert.Equal(t, []string{"flow", "plan", "run", "01-job.md"}, buildRunCommandForTmux(cmd, []string{"01-job.md"}))
+
+	err := cmd.ParseFlags([]string{
+		"--context-file", "a.md", "--context-file", "b.md", "--no-context", "--max-turns", "4",
+		"--offline", "--save-raw", "--fail-fast=false", "--prompt-prefix", "be brief",
+		"--prompt-suffix", "in French", "--report", "out.json", "--report-format", "json",
+	})
+	assert.NoError(t, err)
+
+	got := strings.Join(buildRunCommandForTmux(cmd, nil), " ")
+	for _, want := range []string{
+		"--context-file a.md --context-file b.md", "--no-context", "--max-turns 4",
+		"--offline", "--save-raw", "--fail-fast=false", "--prompt-prefix be brief",
+		"--prompt-suffix in French", "--report out.json", "--report-format json",
+	} {
+		assert.Contains(t, got, want)
+	}
+}
+
+func TestMatrixReportPath(t *testing.T) {
+	assert.Equal(t, "out/report-feature-a.md", matrixReportPath("out/report.md", "feature-a"))
+	assert.Equal(t, "report-main.json", matrixReportPath("report.json", "main"))
+	assert.Equal(t, "report-main", matrixReportPath("report", "main"))
+}
+
+func TestPrintRunPreview(t *testing.T) {
+	var buf bytes.Buffer
+	printRunPreview(&buf, []orchestration.RunPreviewJob{
+		{Filename: "01-spec.md", Title: "Spec", Type: orchestration.JobTypeOneshot, Model: "claude-opus-4", Context: ".grove/rules"},
+		{Filename: "02-build.md", Title: "Build", Type: orchestration.JobTypeShell, Worktree: "feature"},
+	})
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if assert.Len(t, lines, 3) {
+		assert.Contains(t, lines[0], "MODEL")
+		assert.Contains(t, lines[1], "claude-opus-4")
+		assert.Contains(t, lines[1], "(project root)")
+		assert.Contains(t, lines[2], "feature")
+		assert.Contains(t, lines[2], "none")
+	}
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/fsnotify/fsnotify"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+)
+
+// watchDebounce is how long a job file must stay quiet before --rerun-on-change
+// re-runs it, so an editor's burst of writes on save triggers a single run.
+const watchDebounce = 500 * time.Millisecond
+
+// watchPlanAndRerun watches planDir and re-runs a pending or failed job whenever
+// its markdown file is edited. It returns nil when interrupted with Ctrl+C.
+func watchPlanAndRerun(ctx context.Context, planDir string, flowCfg *FlowConfig, orchConfig *orchestration.OrchestratorConfig) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(planDir); err != nil {
+		return fmt.Errorf("watching %s: %w", planDir, err)
+	}
+
+	fmt.Printf("\n%s Watching %s for job changes (Ctrl+C to stop)...\n", theme.IconInfo, planDir)
+
+	err = watchJobFiles(ctx, watcher, planDir, watchDebounce, func(filenames []string) {
+		for _, filename := range filenames {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := rerunWatchedJob(ctx, planDir, filename, flowCfg, orchConfig); err != nil {
+				fmt.Printf("%s %s: %v\n", color.RedString(theme.IconError), filename, err)
+			}
+		}
+		fmt.Printf("\n%s Watching for job changes...\n", theme.IconInfo)
+	})
+	if ctx.Err() != nil {
+		fmt.Println("\nStopped watching.")
+	}
+	return err
+}
+
+// watchJobFiles calls run with the sorted names of the job files whose contents
+// changed, once the watcher's events have been quiet for debounce, so an
+// editor's burst of writes on save triggers a single call. Writes made during
+// run (status updates, appended output) are ignored by comparing file contents
+// against a snapshot taken after each call. It returns nil once ctx is done or
+// the watcher is closed.
+func watchJobFiles(ctx context.Context, watcher *fsnotify.Watcher, planDir string, debounce time.Duration, run func(filenames []string)) error {
+	snapshot := snapshotJobFiles(planDir)
+	changed := make(map[string]bool)
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			ulog.Warn("File watcher error").Err(err).Log(ctx)
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if !strings.HasSuffix(event.Name, ".md") || !event.Has(fsnotify.Write|fsnotify.Create|fsnotify.Rename) {
+				continue
+			}
+			changed[filepath.Base(event.Name)] = true
+			timer.Reset(debounce)
+
+		case <-timer.C:
+			filenames := changedJobFiles(planDir, changed, snapshot)
+			changed = make(map[string]bool)
+			if len(filenames) == 0 {
+				continue
+			}
+			run(filenames)
+			// Re-snapshot so the writes made during run don't trigger another round
+			snapshot = snapshotJobFiles(planDir)
+		}
+	}
+}
+
+// changedJobFiles returns, sorted, the candidates whose contents differ from snapshot.
+func changedJobFiles(planDir string, candidates map[string]bool, snapshot map[string][32]byte) []string {
+	var filenames []string
+	for filename := range candidates {
+		if snapshot[filename] != hashJobFile(filepath.Join(planDir, filename)) {
+			filenames = append(filenames, filename)
+		}
+	}
+	sort.Strings(filenames)
+	return filenames
+}
+
+// rerunWatchedJob reloads the plan and runs filename if it is pending or failed.
+func rerunWatchedJob(ctx context.Context, planDir, filename string, flowCfg *FlowConfig, orchConfig *orchestration.OrchestratorConfig) error {
+	plan, err := orchestration.LoadPlan(planDir)
+	if err != nil {
+		return fmt.Errorf("load plan: %w", err)
+	}
+	plan.Orchestration = flowCfg.orchestrationConfig()
+
+	job, found := plan.GetJobByFilename(filename)
+	if !found {
+		return nil
+	}
+	if job.Status != orchestration.JobStatusPending && job.Status != orchestration.JobStatusFailed {
+		return nil
+	}
+
+	orch, err := orchestration.NewOrchestrator(plan, orchConfig)
+	if err != nil {
+		return fmt.Errorf("create orchestrator: %w", err)
+	}
+
+	fmt.Printf("\n%s %s changed\n", theme.IconInfo, filename)
+	return runSingleJob(ctx, orch, plan, filename, true)
+}
+
+// snapshotJobFiles hashes every markdown file in planDir, keyed by filename.
+func snapshotJobFiles(planDir string) map[string][32]byte {
+	snapshot := make(map[string][32]byte)
+	entries, err := os.ReadDir(planDir)
+	if err != nil {
+		return snapshot
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+		snapshot[entry.Name()] = hashJobFile(filepath.Join(planDir, entry.Name()))
+	}
+	return snapshot
+}
+
+// hashJobFile returns the content hash of path, or the zero hash if it can't be read.
+func hashJobFile(path string) [32]byte {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return [32]byte{}
+	}
+	return sha256.Sum256(content)
+}
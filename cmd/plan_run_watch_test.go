@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestChangedJobFiles(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"01-a.md": "a",
+		"02-b.md": "b",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "sub.md"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	snapshot := snapshotJobFiles(dir)
+	if len(snapshot) != 2 {
+		t.Fatalf("snapshot has %d files, want 2 (directories skipped)", len(snapshot))
+	}
+
+	// Rewriting identical content is not a change
+	if err := os.WriteFile(filepath.Join(dir, "01-a.md"), []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "02-b.md"), []byte("edited"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "03-new.md"), []byte("new"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	candidates := map[string]bool{"03-new.md": true, "01-a.md": true, "02-b.md": true}
+	got := changedJobFiles(dir, candidates, snapshot)
+	want := []string{"02-b.md", "03-new.md"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("changedJobFiles() = %v, want %v", got, want)
+	}
+}
+
+func TestWatchJobFiles_DebouncesAndIgnoresOwnWrites(t *testing.T) {
+	dir := t.TempDir()
+	jobPath := filepath.Join(dir, "01-job.md")
+	if err := os.WriteFile(jobPath, []byte("v0"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+	if err := watcher.Add(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var calls [][]string
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watchJobFiles(ctx, watcher, dir, 100*time.Millisecond, func(filenames []string) {
+			mu.Lock()
+			calls = append(calls, filenames)
+			mu.Unlock()
+			// Simulate the run updating the job's status
+			os.WriteFile(jobPath, []byte("status: completed"), 0o644)
+		})
+	}()
+
+	// A burst of saves produces a single run
+	for i := 1; i <= 3; i++ {
+		if err := os.WriteFile(jobPath, []byte{byte('0' + i)}, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(500 * time.Millisecond)
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("watchJobFiles() error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := [][]string{{"01-job.md"}}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("run calls = %v, want %v", calls, want)
+	}
+}
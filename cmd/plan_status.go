@@ -22,17 +22,28 @@ import (
 
 // Command flags
 var (
-	statusTUI bool // Kept for backwards compatibility; TUI is now always used unless --json is specified
+	statusTUI   bool          // Kept for backwards compatibility; TUI is now always used unless --json is specified
+	statusSince time.Duration // Only show jobs active within this window
+
+	statusWatch         bool          // Print plain-text status updates instead of launching the TUI
+	statusWatchInterval time.Duration // How often --watch reloads the plan
 )
 
 // InitPlanStatusFlags initializes the flags for the status command
 func InitPlanStatusFlags() {
 	// Keep --tui flag for backwards compatibility, but it's now a no-op (TUI is the default)
 	planStatusCmd.Flags().BoolVarP(&statusTUI, "tui", "t", false, "Launch interactive TUI (default behavior, kept for backwards compatibility)")
+	planStatusCmd.Flags().DurationVar(&statusSince, "since", 0, "Only show jobs started, finished, or modified within this window (e.g., 1h, 30m)")
+	planStatusCmd.Flags().BoolVar(&statusWatch, "watch", false, "Print plain-text status updates without the TUI until all jobs finish")
+	planStatusCmd.Flags().DurationVar(&statusWatchInterval, "interval", 5*time.Second, "How often --watch checks the plan for changes")
 }
 
 // RunPlanStatus implements the status command.
 func RunPlanStatus(cmd *cobra.Command, args []string) error {
+	if statusWatchInterval <= 0 {
+		return fmt.Errorf("--interval must be greater than 0, got %s", statusWatchInterval)
+	}
+
 	var dir string
 	if len(args) > 0 {
 		dir = args[0]
@@ -74,6 +85,9 @@ func RunPlanStatus(cmd *cobra.Command, args []string) error {
 	// Check if JSON output is requested via --json flag
 	opts := cli.GetOptions(cmd)
 	if opts.JSONOutput {
+		if statusSince > 0 {
+			plan.Jobs = filterJobsActiveSince(plan.Jobs, time.Now().Add(-statusSince))
+		}
 		// Output JSON and exit (no TUI)
 		output, err := formatStatusJSON(plan)
 		if err != nil {
@@ -83,6 +97,11 @@ func RunPlanStatus(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Plain-text live status for CI and other non-interactive terminals
+	if statusWatch {
+		return watchPlanStatus(cmd.Context(), planPath, statusWatchInterval, os.Stdout)
+	}
+
 	// Always launch TUI for interactive use
 	if !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd()) {
 		return fmt.Errorf("flow status requires an interactive terminal to launch the TUI")
@@ -90,6 +109,17 @@ func RunPlanStatus(cmd *cobra.Command, args []string) error {
 	return runStatusTUI(plan, graph)
 }
 
+// filterJobsActiveSince returns the jobs that were active at or after since.
+func filterJobsActiveSince(jobs []*orchestration.Job, since time.Time) []*orchestration.Job {
+	var recent []*orchestration.Job
+	for _, job := range jobs {
+		if job.ActiveSince(since) {
+			recent = append(recent, job)
+		}
+	}
+	return recent
+}
+
 // VerifyRunningJobStatus checks the PID liveness for jobs marked as running.
 // If a job's process is dead, its status is updated in-memory to "interrupted".
 func VerifyRunningJobStatus(plan *orchestration.Plan) {
@@ -297,6 +327,8 @@ func colorizeStatus(status orchestration.JobStatus) string {
 		return theme.DefaultTheme.Warning.Render(theme.IconStatusHold)
 	case orchestration.JobStatusAbandoned:
 		return theme.DefaultTheme.Muted.Render(theme.IconStatusAbandoned)
+	case orchestration.JobStatusSkipped:
+		return theme.DefaultTheme.Muted.Render(theme.IconArrow)
 	default: // Pending
 		return theme.IconPending
 	}
@@ -409,6 +441,9 @@ func formatStatusJSON(plan *orchestration.Plan) (string, error) {
 		Jobs         []*orchestration.Job `json:"jobs"`
 		Stats        map[string]int       `json:"statistics"`
 		Worktree     *WorktreeStatus      `json:"worktree,omitempty"`
+		StaleJobs    []string             `json:"stale_jobs,omitempty"` // Completed jobs whose prompt changed since they ran
+		Elapsed      map[string]string    `json:"elapsed,omitempty"`    // Job ID -> duration, or time so far for running jobs
+		Waiting      map[string]string    `json:"waiting,omitempty"`    // Job ID -> why a blocked or pending job can't run yet
 	}{
 		Plan:  plan.Name,
 		Jobs:  plan.Jobs,
@@ -422,6 +457,29 @@ func formatStatusJSON(plan *orchestration.Plan) (string, error) {
 	output.Stats["total"] = len(plan.Jobs)
 	output.Stats["completed"] = output.Stats["completed"]
 
+	now := time.Now()
+	for _, job := range plan.Jobs {
+		if reason := job.WaitReason(); reason != "" {
+			if output.Waiting == nil {
+				output.Waiting = make(map[string]string)
+			}
+			output.Waiting[job.ID] = reason
+		}
+		if elapsed := job.Elapsed(now); elapsed > 0 {
+			if output.Elapsed == nil {
+				output.Elapsed = make(map[string]string)
+			}
+			output.Elapsed[job.ID] = elapsed.Round(time.Second).String()
+		}
+	}
+
+	stale := orchestration.StalePromptJobs(plan)
+	for _, job := range plan.Jobs {
+		if stale[job.ID] {
+			output.StaleJobs = append(output.StaleJobs, job.ID)
+		}
+	}
+
 	// Add worktree status if available
 	if plan.Config != nil && plan.Config.Worktree != "" {
 		worktreeStatus, err := getWorktreeStatus(plan)
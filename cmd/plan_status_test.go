@@ -7,9 +7,11 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/grovetools/core/cli"
 	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -205,6 +207,18 @@ func TestRunPlanStatus(t *testing.T) {
 	}
 }
 
+func TestRunPlanStatus_RejectsNonPositiveInterval(t *testing.T) {
+	defer func(v time.Duration) { statusWatchInterval = v }(statusWatchInterval)
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		statusWatchInterval = interval
+		err := RunPlanStatus(&cobra.Command{}, []string{t.TempDir()})
+		if err == nil || !strings.Contains(err.Error(), "--interval") {
+			t.Errorf("interval %s: got error %v, want an --interval error", interval, err)
+		}
+	}
+}
+
 func TestFormatStatusJSON(t *testing.T) {
 	plan := &orchestration.Plan{
 		Name: "test-plan",
@@ -461,3 +475,24 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+func TestFormatPlainStatus(t *testing.T) {
+	plan := &orchestration.Plan{
+		Name: "release",
+		Jobs: []*orchestration.Job{
+			{Filename: "01-spec.md", Title: "Spec", Status: orchestration.JobStatusCompleted},
+			{Filename: "02-impl.md", Title: "Implement", Status: orchestration.JobStatusRunning},
+			{Filename: "03-review.md", Title: "Review", Status: orchestration.JobStatusPending},
+		},
+	}
+
+	out := formatPlainStatus(plan)
+	assert.True(t, strings.HasPrefix(out, "release: 3 jobs (1 completed, 1 running, 1 pending)\n"), out)
+	assert.Contains(t, out, "  running    02-impl.md    Implement\n")
+	assert.NotContains(t, out, "\x1b[", "plain status should not contain ANSI escapes")
+	assert.False(t, allJobsFinished(plan.Jobs))
+
+	plan.Jobs[1].Status = orchestration.JobStatusFailed
+	plan.Jobs[2].Status = orchestration.JobStatusBlocked
+	assert.True(t, allJobsFinished(plan.Jobs))
+}
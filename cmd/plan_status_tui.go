@@ -13,6 +13,9 @@ import (
 
 // runStatusTUI runs the interactive TUI for plan status
 func runStatusTUI(plan *orchestration.Plan, graph *orchestration.DependencyGraph) error {
+	// Jobs run from the TUI may download URL prompt sources
+	defer orchestration.RemoveFetchedSources()
+
 	// Inject the helper functions into the status_tui package
 	status_tui.FindRootJobsFunc = ExportedFindRootJobs
 	status_tui.FindAllDependentsFunc = ExportedFindAllDependents
@@ -24,6 +27,9 @@ func runStatusTUI(plan *orchestration.Plan, graph *orchestration.DependencyGraph
 	var streamWriter *logviewer.StreamWriter
 
 	model := status_tui.New(plan, graph)
+	if statusSince > 0 {
+		model.SetSinceFilter(statusSince)
+	}
 
 	// Use alt screen only when not in Neovim (to fix screen duplication)
 	// But disable it in Neovim to allow editor functionality
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/grovetools/flow/pkg/orchestration"
+)
+
+// statusWatchOrder is the order in which status counts are listed in the
+// summary line of `plan status --watch`.
+var statusWatchOrder = []orchestration.JobStatus{
+	orchestration.JobStatusCompleted,
+	orchestration.JobStatusRunning,
+	orchestration.JobStatusPendingLLM,
+	orchestration.JobStatusPending,
+	orchestration.JobStatusPendingUser,
+	orchestration.JobStatusIdle,
+	orchestration.JobStatusNeedsReview,
+	orchestration.JobStatusTodo,
+	orchestration.JobStatusHold,
+	orchestration.JobStatusFailed,
+	orchestration.JobStatusBlocked,
+	orchestration.JobStatusSkipped,
+	orchestration.JobStatusAbandoned,
+}
+
+// isFinishedStatus reports whether a job in this status will not change
+// again without someone acting on it.
+func isFinishedStatus(status orchestration.JobStatus) bool {
+	switch status {
+	case orchestration.JobStatusCompleted, orchestration.JobStatusFailed, orchestration.JobStatusBlocked,
+		orchestration.JobStatusSkipped, orchestration.JobStatusAbandoned:
+		return true
+	}
+	return false
+}
+
+// allJobsFinished reports whether every job has reached a finished status.
+func allJobsFinished(jobs []*orchestration.Job) bool {
+	for _, job := range jobs {
+		if !isFinishedStatus(job.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatPlainStatus renders a plan's job statuses as plain text with no
+// colors, for terminals and logs where the TUI cannot be used.
+func formatPlainStatus(plan *orchestration.Plan) string {
+	counts := make(map[orchestration.JobStatus]int)
+	for _, job := range plan.Jobs {
+		counts[job.Status]++
+	}
+
+	var parts []string
+	for _, status := range statusWatchOrder {
+		if counts[status] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[status], status))
+			delete(counts, status)
+		}
+	}
+	for status, n := range counts { // statuses outside the list, such as "interrupted"
+		parts = append(parts, fmt.Sprintf("%d %s", n, status))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d jobs", plan.Name, len(plan.Jobs))
+	if len(parts) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(parts, ", "))
+	}
+	b.WriteString("\n")
+
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	for _, job := range plan.Jobs {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", job.Status, job.Filename, job.Title)
+	}
+	tw.Flush()
+	return b.String()
+}
+
+// watchPlanStatus prints a plain-text status summary for the plan at planPath,
+// then reprints it whenever it changes, checking every interval and whenever a
+// file in the plan directory is written. It returns once every job has
+// finished, or nil when interrupted with Ctrl+C.
+func watchPlanStatus(ctx context.Context, planPath string, interval time.Duration, w io.Writer) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// File events only trigger an early refresh, so watching is best-effort
+	var events <-chan fsnotify.Event
+	var watchErrors <-chan error
+	if watcher, err := fsnotify.NewWatcher(); err == nil {
+		defer watcher.Close()
+		if err := watcher.Add(planPath); err == nil {
+			events, watchErrors = watcher.Events, watcher.Errors
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last string
+	for {
+		plan, err := orchestration.LoadPlan(planPath)
+		if err != nil {
+			return fmt.Errorf("load plan: %w", err)
+		}
+		if os.Getenv("GROVE_SKIP_PID_CHECK") != "true" {
+			VerifyRunningJobStatus(plan)
+		}
+		if statusSince > 0 {
+			plan.Jobs = filterJobsActiveSince(plan.Jobs, time.Now().Add(-statusSince))
+		}
+
+		if status := formatPlainStatus(plan); status != last {
+			fmt.Fprintf(w, "[%s] %s", time.Now().Format("15:04:05"), status)
+			last = status
+		}
+		if allJobsFinished(plan.Jobs) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-watchErrors:
+		case <-events:
+			// Let a burst of writes settle before reloading
+			time.Sleep(watchDebounce)
+			drainEvents(events)
+		}
+	}
+}
+
+// drainEvents discards any file events already waiting on events.
+func drainEvents(events <-chan fsnotify.Event) {
+	for {
+		select {
+		case <-events:
+		default:
+			return
+		}
+	}
+}
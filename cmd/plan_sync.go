@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/grovetools/core/pkg/workspace"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var planSyncFetch bool
+
+// NewPlanSyncCmd creates the `plan sync` command.
+func NewPlanSyncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync [name]",
+		Short: "Rebase a plan's worktree branch onto main",
+		Long: `Rebase the plan's worktree branch onto the latest main branch so long-running
+plans stay current. With --fetch, origin is fetched first and the branch is
+rebased onto origin's main instead of the local one.
+
+If the rebase hits conflicts, the conflicting files are listed and the rebase
+is aborted so the worktree is left clean. The worktree must have no
+uncommitted changes.
+
+For ecosystem plans, each repository's worktree branch is rebased in turn.
+
+If no plan name is provided, uses the active plan.
+
+Examples:
+  flow plan sync
+  flow plan sync auth-refactor --fetch`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanSync,
+	}
+	cmd.Flags().BoolVar(&planSyncFetch, "fetch", false, "Fetch from origin and rebase onto origin's main branch")
+	return cmd
+}
+
+func runPlanSync(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return err
+	}
+	plan, err := orchestration.LoadPlan(planPath)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+	if plan.Config == nil || plan.Config.Worktree == "" {
+		return fmt.Errorf("plan '%s' has no associated worktree", plan.Name)
+	}
+	worktreeName := plan.Config.Worktree
+
+	if len(plan.Config.Repos) > 0 {
+		return syncWorktreeEcosystem(plan, worktreeName)
+	}
+
+	// From inside a worktree, sync it against the main repository
+	gitRoot, err := mainRepoRoot(".")
+	if err != nil {
+		return fmt.Errorf("not in a git repository: %w", err)
+	}
+	base, err := syncWorktreeRepo(gitRoot, worktreeName, planSyncFetch)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Rebased worktree '%s' onto %s\n", theme.IconSuccess, worktreeName, base)
+	return nil
+}
+
+func syncWorktreeEcosystem(plan *orchestration.Plan, worktreeName string) error {
+	logger := logrus.New()
+	logger.SetLevel(logrus.WarnLevel)
+	discoveryService := workspace.NewDiscoveryService(logger)
+	discoveryResult, err := discoveryService.DiscoverAll()
+	if err != nil {
+		return fmt.Errorf("failed to discover workspaces: %w", err)
+	}
+	localWorkspaces := workspace.NewProvider(discoveryResult).LocalWorkspaces()
+
+	var synced []string
+	var errors []string
+	for _, repoName := range plan.Config.Repos {
+		repoPath, exists := localWorkspaces[repoName]
+		if !exists {
+			errors = append(errors, fmt.Sprintf("%s: repo not found locally", repoName))
+			continue
+		}
+
+		fmt.Printf("Syncing %s...\n", repoName)
+		base, err := syncWorktreeRepo(repoPath, worktreeName, planSyncFetch)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %v", repoName, err))
+			continue
+		}
+		synced = append(synced, fmt.Sprintf("%s (onto %s)", repoName, base))
+	}
+
+	if len(synced) > 0 {
+		fmt.Printf("\n%s Rebased %d repos: %s\n", theme.IconSuccess, len(synced), strings.Join(synced, ", "))
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("failed to sync %d repos:\n  %s", len(errors), strings.Join(errors, "\n  "))
+	}
+	return nil
+}
+
+// syncWorktreeRepo rebases the branch checked out in
+// <repoPath>/.grove-worktrees/<worktreeName> onto the repository's default
+// branch, fetching origin first if fetch is set. It returns the ref that was
+// rebased onto. On conflicts the rebase is aborted and the conflicting files
+// are reported in the error.
+func syncWorktreeRepo(repoPath, worktreeName string, fetch bool) (string, error) {
+	worktreePath := filepath.Join(repoPath, ".grove-worktrees", worktreeName)
+	if _, err := os.Stat(worktreePath); err != nil {
+		return "", fmt.Errorf("worktree not found at %s", worktreePath)
+	}
+
+	if rebaseInProgress(worktreePath) {
+		return "", fmt.Errorf("worktree %s is already in the middle of a rebase; finish or abort it first", worktreePath)
+	}
+	status, err := runGitIn(worktreePath, "status", "--porcelain")
+	if err != nil {
+		return "", err
+	}
+	if status != "" {
+		return "", fmt.Errorf("worktree %s has uncommitted changes; commit or stash them first", worktreePath)
+	}
+
+	defaultBranch := "main"
+	if _, err := runGitIn(repoPath, "rev-parse", "--verify", "--quiet", "refs/heads/main"); err != nil {
+		if _, err := runGitIn(repoPath, "rev-parse", "--verify", "--quiet", "refs/heads/master"); err != nil {
+			return "", fmt.Errorf("neither 'main' nor 'master' branch found")
+		}
+		defaultBranch = "master"
+	}
+
+	base := defaultBranch
+	if fetch {
+		if _, err := runGitIn(repoPath, "fetch", "origin", defaultBranch); err != nil {
+			return "", err
+		}
+		base = "origin/" + defaultBranch
+	}
+
+	if _, err := runGitIn(worktreePath, "rebase", base); err != nil {
+		if !rebaseInProgress(worktreePath) {
+			return "", fmt.Errorf("rebase onto %s failed: %w", base, err)
+		}
+		conflicts, _ := runGitIn(worktreePath, "diff", "--name-only", "--diff-filter=U")
+		if _, abortErr := runGitIn(worktreePath, "rebase", "--abort"); abortErr != nil {
+			return "", fmt.Errorf("rebase onto %s failed and could not be aborted (%v): %w", base, abortErr, err)
+		}
+		if conflicts != "" {
+			return "", fmt.Errorf("rebase onto %s hit conflicts and was aborted; conflicting files:\n    %s\n%w",
+				base, strings.ReplaceAll(conflicts, "\n", "\n    "), err)
+		}
+		return "", fmt.Errorf("rebase onto %s failed and was aborted: %w", base, err)
+	}
+	return base, nil
+}
+
+// rebaseInProgress reports whether the worktree at dir is stopped in the middle
+// of a rebase, as opposed to a rebase that failed before it started.
+func rebaseInProgress(dir string) bool {
+	for _, state := range []string{"rebase-merge", "rebase-apply"} {
+		path, err := runGitIn(dir, "rev-parse", "--git-path", state)
+		if err != nil {
+			continue
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		if _, err := os.Stat(path); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// runGitIn runs git in dir and returns its trimmed output.
+func runGitIn(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	out := strings.TrimSpace(string(output))
+	if err != nil {
+		return out, fmt.Errorf("git %s failed: %s", strings.Join(args, " "), out)
+	}
+	return out, nil
+}
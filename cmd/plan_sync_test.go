@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSyncWorktreeRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+	root := t.TempDir()
+	worktree := filepath.Join(root, ".grove-worktrees", "feature")
+	git := func(dir string, args ...string) {
+		t.Helper()
+		if _, err := runGitIn(dir, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	commitFile := func(dir, name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		git(dir, "add", name)
+		git(dir, "commit", "-q", "-m", "update "+name)
+	}
+
+	git(root, "init", "-q", "-b", "main")
+	commitFile(root, "shared.txt", "base\n")
+	git(root, "worktree", "add", "-q", "-b", "feature", worktree)
+
+	// Non-conflicting changes on both sides rebase cleanly
+	commitFile(worktree, "feature.txt", "feature\n")
+	commitFile(root, "main.txt", "main\n")
+	base, err := syncWorktreeRepo(root, "feature", false)
+	if err != nil || base != "main" {
+		t.Fatalf("clean rebase: got %q, %v", base, err)
+	}
+	if _, err := os.Stat(filepath.Join(worktree, "main.txt")); err != nil {
+		t.Fatalf("worktree was not rebased onto main: %v", err)
+	}
+
+	// Conflicting changes are reported and the rebase is aborted
+	commitFile(worktree, "shared.txt", "feature side\n")
+	commitFile(root, "shared.txt", "main side\n")
+	_, err = syncWorktreeRepo(root, "feature", false)
+	if err == nil || !strings.Contains(err.Error(), "shared.txt") {
+		t.Fatalf("conflicting rebase: expected error naming shared.txt, got %v", err)
+	}
+	if status, _ := runGitIn(worktree, "status", "--porcelain"); status != "" {
+		t.Errorf("worktree left dirty after abort:\n%s", status)
+	}
+	if out, _ := runGitIn(worktree, "rev-parse", "--abbrev-ref", "HEAD"); out != "feature" {
+		t.Errorf("worktree HEAD = %q, want feature", out)
+	}
+
+	// A rebase left in progress is reported and not aborted on the user's behalf
+	if _, err := runGitIn(worktree, "rebase", "main"); err == nil {
+		t.Fatal("expected the manual rebase to stop on conflicts")
+	}
+	if !rebaseInProgress(worktree) {
+		t.Fatal("rebaseInProgress() = false during a conflicted rebase")
+	}
+	if _, err := syncWorktreeRepo(root, "feature", false); err == nil || !strings.Contains(err.Error(), "middle of a rebase") {
+		t.Errorf("in-progress rebase: expected an error, got %v", err)
+	}
+	git(worktree, "rebase", "--abort")
+	if rebaseInProgress(worktree) {
+		t.Error("rebaseInProgress() = true after the rebase was aborted")
+	}
+
+	// Uncommitted changes block the sync
+	if err := os.WriteFile(filepath.Join(worktree, "dirty.txt"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := syncWorktreeRepo(root, "feature", false); err == nil || !strings.Contains(err.Error(), "uncommitted") {
+		t.Errorf("dirty worktree: expected uncommitted changes error, got %v", err)
+	}
+}
+
+func TestMainRepoRoot(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	worktree := filepath.Join(root, ".grove-worktrees", "feature")
+	if err := os.Mkdir(filepath.Join(root, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"commit", "-q", "--allow-empty", "-m", "initial"},
+		{"worktree", "add", "-q", "-b", "feature", worktree},
+	} {
+		if _, err := runGitIn(root, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, dir := range []string{root, filepath.Join(root, "sub"), worktree} {
+		got, err := mainRepoRoot(dir)
+		if err != nil {
+			t.Fatalf("mainRepoRoot(%s) error = %v", dir, err)
+		}
+		if got != root {
+			t.Errorf("mainRepoRoot(%s) = %s, want %s", dir, got, root)
+		}
+	}
+}
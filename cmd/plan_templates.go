@@ -4,9 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/grovetools/core/cli"
+	"github.com/grovetools/core/tui/theme"
 	"github.com/grovetools/flow/pkg/orchestration"
 	"github.com/spf13/cobra"
 )
@@ -16,51 +21,211 @@ var planTemplatesCmd = &cobra.Command{
 	Short: "Manage job templates",
 }
 
-var planTemplatesListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List available job templates",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		domain, _ := cmd.Flags().GetString("domain")
-		manager := orchestration.NewTemplateManager()
-		allTemplates, err := manager.ListTemplates()
-		if err != nil {
-			return err
-		}
+// newTemplatesListCmd creates the `list` subcommand shared by `flow template`
+// and `flow plan templates`.
+func newTemplatesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List available job templates",
+		RunE:  runTemplatesList,
+	}
+	cmd.Flags().String("domain", "", "Filter templates by domain (e.g., generic, grove)")
+	return cmd
+}
 
-		var templates []*orchestration.JobTemplate
-		if domain != "" {
-			for _, t := range allTemplates {
-				if t.Domain == domain {
-					templates = append(templates, t)
-				}
-			}
-		} else {
-			templates = allTemplates
-		}
+// newTemplatesShowCmd creates the `show` subcommand shared by `flow template`
+// and `flow plan templates`.
+func newTemplatesShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <template-name>",
+		Short: "Show a template's metadata and body",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runTemplatesShow,
+	}
+}
 
-		if len(templates) == 0 {
-			fmt.Println("No job templates found.")
-			return nil
-		}
+// NewTemplateCmd creates the top-level `template` command.
+func NewTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Discover and scaffold job templates",
+		Long: `List and inspect the job templates available to --template, and scaffold new
+project templates with 'flow template new'.
 
-		// Check if JSON output is requested
-		opts := cli.GetOptions(cmd)
-		if opts.JSONOutput {
-			// Output templates as JSON
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			return encoder.Encode(templates)
-		}
+Templates are resolved from the nearest .grove/job-templates directory, the
+notebook's templates, ~/.config/grove/job-templates, and the built-in set, in
+that order; the first template with a given name wins.
+
+A template can build on others: 'extends: <name>' in its frontmatter prepends the
+parent's body and inherits any frontmatter fields it does not set, and
+{{ include "<name>" }} in the body inserts another template's body in place. A
+template that extends its own name builds on the template it overrides.`,
+	}
+
+	newCmd := &cobra.Command{
+		Use:   "new <name>",
+		Short: "Scaffold a new project job template",
+		Long: `Create .grove/job-templates/<name>.md in the current project with a starter
+frontmatter header and body, then open it in $EDITOR.
+
+Examples:
+  flow template new security-review
+  flow template new release-notes --type agent --description "Draft release notes" --no-edit`,
+		Args: cobra.ExactArgs(1),
+		RunE: runTemplateNew,
+	}
+	newCmd.Flags().StringVar(&templateNewDescription, "description", "", "Template description")
+	newCmd.Flags().StringVar(&templateNewType, "type", "oneshot", "Job type the template is intended for")
+	newCmd.Flags().BoolVar(&templateNewNoEdit, "no-edit", false, "Do not open the new template in $EDITOR")
+	newCmd.Flags().BoolVarP(&templateNewForce, "force", "f", false, "Overwrite an existing template")
+
+	cmd.AddCommand(newTemplatesListCmd(), newTemplatesShowCmd(), newCmd)
+	return cmd
+}
+
+var (
+	templateNewDescription string
+	templateNewType        string
+	templateNewNoEdit      bool
+	templateNewForce       bool
+)
+
+func runTemplateNew(cmd *cobra.Command, args []string) error {
+	name := strings.TrimSuffix(args[0], ".md")
+	if err := validateDirectoryName(name); err != nil {
+		return fmt.Errorf("invalid template name: %w", err)
+	}
+	if strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return fmt.Errorf("invalid template name: must not contain path separators")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current directory: %w", err)
+	}
+	templatesDir := filepath.Join(orchestration.GetProjectRootSafe(cwd), ".grove", "job-templates")
+	templatePath := filepath.Join(templatesDir, name+".md")
 
-		// Default tabular output
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "NAME\tDOMAIN\tTYPE\tSOURCE\tDESCRIPTION")
-		for _, t := range templates {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Name, t.Domain, t.Type, t.Source, t.Description)
+	if _, err := os.Stat(templatePath); err == nil && !templateNewForce {
+		return fmt.Errorf("template already exists at %s (use --force to overwrite)", templatePath)
+	}
+
+	content := fmt.Sprintf(`---
+description: %q
+type: %q
+---
+
+## Base Prompt (%s)
+
+Describe the task for the model here. The job's own prompt is appended below this
+template when it runs.
+`, templateNewDescription, templateNewType, name)
+
+	if err := os.MkdirAll(templatesDir, 0o755); err != nil {
+		return fmt.Errorf("creating templates directory: %w", err)
+	}
+	if err := os.WriteFile(templatePath, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("writing template: %w", err)
+	}
+	fmt.Printf("%s Created template '%s' at %s\n", theme.IconSuccess, name, templatePath)
+
+	if templateNewNoEdit {
+		return nil
+	}
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		fmt.Println("$EDITOR is not set; edit the file directly to finish the template.")
+		return nil
+	}
+	editCmd := exec.Command("sh", "-c", editor+` "$1"`, "sh", templatePath)
+	editCmd.Stdin = os.Stdin
+	editCmd.Stdout = os.Stdout
+	editCmd.Stderr = os.Stderr
+	if err := editCmd.Run(); err != nil {
+		return fmt.Errorf("running editor: %w", err)
+	}
+	return nil
+}
+
+func runTemplatesList(cmd *cobra.Command, args []string) error {
+	domain, _ := cmd.Flags().GetString("domain")
+	manager := orchestration.NewTemplateManager()
+	allTemplates, err := manager.ListTemplates()
+	if err != nil {
+		return err
+	}
+
+	var templates []*orchestration.JobTemplate
+	if domain != "" {
+		for _, t := range allTemplates {
+			if t.Domain == domain {
+				templates = append(templates, t)
+			}
 		}
-		w.Flush()
+	} else {
+		templates = allTemplates
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	if len(templates) == 0 {
+		fmt.Println("No job templates found.")
 		return nil
-	},
+	}
+
+	// Check if JSON output is requested
+	opts := cli.GetOptions(cmd)
+	if opts.JSONOutput {
+		// Output templates as JSON
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(templates)
+	}
+
+	// Default tabular output
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tDOMAIN\tTYPE\tSOURCE\tDESCRIPTION")
+	for _, t := range templates {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Name, t.Domain, t.Type, t.Source, t.Description)
+	}
+	w.Flush()
+	return nil
+}
+
+func runTemplatesShow(cmd *cobra.Command, args []string) error {
+	manager := orchestration.NewTemplateManager()
+	template, err := manager.FindTemplate(args[0])
+	if err != nil {
+		return fmt.Errorf("template '%s' not found; run 'flow template list' to see available templates", args[0])
+	}
+
+	if cli.GetOptions(cmd).JSONOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(template)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", template.Name)
+	fmt.Fprintf(w, "Source:\t%s\n", template.Source)
+	if template.Path != "" {
+		fmt.Fprintf(w, "Path:\t%s\n", template.Path)
+	}
+	if template.Type != "" {
+		fmt.Fprintf(w, "Type:\t%s\n", template.Type)
+	}
+	if template.Domain != "" {
+		fmt.Fprintf(w, "Domain:\t%s\n", template.Domain)
+	}
+	if template.Description != "" {
+		fmt.Fprintf(w, "Description:\t%s\n", template.Description)
+	}
+	w.Flush()
+
+	fmt.Println()
+	fmt.Print(template.Prompt)
+	return nil
 }
 
 var planTemplatesPrintWithFrontmatter bool
@@ -62,6 +62,7 @@ type PlanListItem struct {
 	StatusParts           map[string]int        // For detailed status breakdown
 	LastUpdated           time.Time             // When the plan was last modified
 	Worktree              string                // Worktree associated with the plan
+	WorktreePath          string                // Worktree directory, if it exists
 	GitStatus             *git.StatusInfo       // Git status information for the worktree
 	ReviewStatus          string                // Review status like "In Progress"
 	MergeStatus           string                // Merge status: "Ready", "Needs Rebase", "Merged"
@@ -94,6 +95,9 @@ type planListTUIModel struct {
 	repoCursor           int    // Cursor position in ecosystem repo list
 	repoGitLogContent    string // Git log for selected repo
 	repoGitLogError      error  // Error from repo git log
+	creatingWorktree     bool   // Prompting for a worktree name for the selected plan
+	worktreeInput        textinput.Model
+	worktreePlanIndex    int
 }
 
 // TUI key mappings for plan list
@@ -113,6 +117,8 @@ type planListKeyMap struct {
 	ToggleGitLog      key.Binding
 	ToggleHold        key.Binding
 	SetHoldStatus     key.Binding
+	CreateWorktree    key.Binding
+	Refresh           key.Binding
 }
 
 func (k planListKeyMap) ShortHelp() []key.Binding {
@@ -137,10 +143,12 @@ func (k planListKeyMap) FullHelp() [][]key.Binding {
 			k.ReviewPlan,
 			k.FinishPlan,
 			k.SetHoldStatus,
+			k.CreateWorktree,
 			k.FastForwardUpdate,
 			k.FastForwardMain,
 			k.ToggleGitLog,
 			k.ToggleHold,
+			k.Refresh,
 			k.Help,
 			k.Quit,
 		},
@@ -205,6 +213,14 @@ var planListKeys = planListKeyMap{
 		key.WithKeys("h"),
 		key.WithHelp("h", "hold/unhold plan"),
 	),
+	CreateWorktree: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "create worktree for plan"),
+	),
+	Refresh: key.NewBinding(
+		key.WithKeys("R"),
+		key.WithHelp("R", "refresh git status"),
+	),
 }
 
 
@@ -232,6 +248,13 @@ type fastForwardMsg struct {
 	message string
 }
 
+// worktreeCreatedMsg is sent when a worktree has been created for a plan
+type worktreeCreatedMsg struct {
+	planName string
+	worktree string
+	err      error
+}
+
 func fetchGitLogCmd(plansDir string) tea.Cmd {
 	return func() tea.Msg {
 		gitRoot, err := git.GetGitRoot(plansDir)
@@ -393,6 +416,14 @@ func (m planListTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case worktreeCreatedMsg:
+		if msg.err != nil {
+			m.statusMessage = theme.DefaultTheme.Error.Render(fmt.Sprintf("Error: %s", msg.err.Error()))
+		} else {
+			m.statusMessage = theme.DefaultTheme.Success.Render(fmt.Sprintf("%s Created worktree '%s' for plan '%s'", theme.IconSuccess, msg.worktree, msg.planName))
+		}
+		return m, loadPlansListCmd(m.plansDirectory, m.cwdGitRoot, m.showOnHold)
+
 	case gitLogMsg:
 		m.gitLogContent = msg.content
 		m.gitLogError = msg.err
@@ -453,6 +484,33 @@ func (m planListTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// If naming a new worktree, handle input
+		if m.creatingWorktree {
+			switch msg.String() {
+			case "enter":
+				m.creatingWorktree = false
+				name := strings.TrimSpace(m.worktreeInput.Value())
+				if m.worktreePlanIndex < 0 || m.worktreePlanIndex >= len(m.plans) || name == "" {
+					return m, nil
+				}
+				if err := validateDirectoryName(name); err != nil {
+					m.statusMessage = theme.DefaultTheme.Error.Render(fmt.Sprintf("Error: %s", err.Error()))
+					return m, nil
+				}
+				m.statusMessage = fmt.Sprintf("Creating worktree '%s'...", name)
+				return m, createPlanWorktreeCmd(m.plans[m.worktreePlanIndex].Plan, name, m.cwdGitRoot)
+
+			case "esc":
+				m.creatingWorktree = false
+				return m, nil
+
+			default:
+				var cmd tea.Cmd
+				m.worktreeInput, cmd = m.worktreeInput.Update(msg)
+				return m, cmd
+			}
+		}
+
 		// If editing notes, handle input
 		if m.editingNotes {
 			switch msg.String() {
@@ -618,6 +676,34 @@ func (m planListTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, textinput.Blink
 			}
 
+		case key.Matches(msg, m.keys.Refresh):
+			// Drop cached git status so every worktree is recomputed
+			clearWorktreeGitCache()
+			m.statusMessage = "Refreshing git status..."
+			return m, tea.Batch(
+				loadPlansListCmd(m.plansDirectory, m.cwdGitRoot, m.showOnHold),
+				fetchGitLogCmd(m.cwdGitRoot),
+			)
+
+		case key.Matches(msg, m.keys.CreateWorktree):
+			if m.cursor >= 0 && m.cursor < len(m.plans) {
+				selected := m.plans[m.cursor]
+				if selected.Worktree != "" {
+					m.statusMessage = theme.DefaultTheme.Warning.Render(fmt.Sprintf("Plan '%s' already uses worktree '%s'", selected.Name, selected.Worktree))
+					return m, nil
+				}
+				m.creatingWorktree = true
+				m.worktreePlanIndex = m.cursor
+				ti := textinput.New()
+				ti.Placeholder = "worktree name"
+				ti.SetValue(selected.Name)
+				ti.Focus()
+				ti.CharLimit = 100
+				ti.Width = 50
+				m.worktreeInput = ti
+				return m, textinput.Blink
+			}
+
 		case key.Matches(msg, m.keys.FinishPlan):
 			// Ctrl+X key - execute plan finish command
 			if m.cursor >= 0 && m.cursor < len(m.plans) {
@@ -761,6 +847,21 @@ func (m planListTUIModel) View() string {
 		return padStyle.Render(m.help.View())
 	}
 
+	// If naming a new worktree, show the input field
+	if m.creatingWorktree {
+		s.WriteString(components.RenderHeader("Create Worktree"))
+		s.WriteString("\n\n")
+		if m.worktreePlanIndex >= 0 && m.worktreePlanIndex < len(m.plans) {
+			s.WriteString(theme.DefaultTheme.Muted.Render("Plan: "))
+			s.WriteString(m.plans[m.worktreePlanIndex].Name)
+			s.WriteString("\n\n")
+		}
+		s.WriteString(m.worktreeInput.View())
+		s.WriteString("\n\n")
+		s.WriteString(theme.DefaultTheme.Muted.Render("Press Enter to create, Esc to cancel"))
+		return padStyle.Render(s.String())
+	}
+
 	// If editing notes, show the input field
 	if m.editingNotes {
 		s.WriteString(components.RenderHeader("Edit Plan Notes"))
@@ -1129,6 +1230,7 @@ func (m planListTUIModel) formatStatusWithEmoji(plan PlanListItem) string {
 	blocked := plan.StatusParts["blocked"]
 	hold := plan.StatusParts["hold"]
 	abandoned := plan.StatusParts["abandoned"]
+	skipped := plan.StatusParts["skipped"]
 
 	// Build status string with emojis
 	var parts []string
@@ -1168,6 +1270,9 @@ func (m planListTUIModel) formatStatusWithEmoji(plan PlanListItem) string {
 	if hold > 0 {
 		parts = append(parts, fmt.Sprintf("%d on hold", hold))
 	}
+	if skipped > 0 {
+		parts = append(parts, fmt.Sprintf("%d skipped", skipped))
+	}
 
 	statusText := emoji + " " + strings.Join(parts, ", ")
 	
@@ -1322,6 +1427,18 @@ func loadPlansList(plansDirectory string, cwdGitRoot string, showOnHold bool) ([
 						if gitRoot != "" {
 							worktreePath := filepath.Join(gitRoot, ".grove-worktrees", worktree)
 							if _, statErr := os.Stat(worktreePath); statErr == nil {
+								item.WorktreePath = worktreePath
+							}
+							var repos []string
+							if plan.Config != nil {
+								repos = plan.Config.Repos
+							}
+							fingerprint := worktreeGitFingerprint(worktreePath, gitRoot, repos)
+							if cached, ok := cachedWorktreeGitDetails(worktreePath, fingerprint); ok {
+								item.GitStatus = cached.GitStatus
+								item.MergeStatus = cached.MergeStatus
+								item.EcosystemRepoStatuses = cached.EcosystemRepoStatuses
+							} else if _, statErr := os.Stat(worktreePath); statErr == nil {
 								gitStatus, statusErr := git.GetStatus(worktreePath)
 								if statusErr == nil {
 									// Override ahead/behind counts to compare against local main, not upstream
@@ -1345,6 +1462,11 @@ func loadPlansList(plansDirectory string, cwdGitRoot string, showOnHold bool) ([
 									} else {
 										item.MergeStatus = getMergeStatus(gitRoot, worktree)
 									}
+									storeWorktreeGitDetails(worktreePath, fingerprint, worktreeGitDetails{
+										GitStatus:             item.GitStatus,
+										MergeStatus:           item.MergeStatus,
+										EcosystemRepoStatuses: item.EcosystemRepoStatuses,
+									})
 								}
 							}
 						}
@@ -1388,6 +1510,10 @@ func loadPlansList(plansDirectory string, cwdGitRoot string, showOnHold bool) ([
 						statusStrParts = append(statusStrParts, fmt.Sprintf("%d abandoned", c))
 						statusParts["abandoned"] = c
 					}
+					if c := statusCounts[orchestration.JobStatusSkipped]; c > 0 {
+						statusStrParts = append(statusStrParts, fmt.Sprintf("%d skipped", c))
+						statusParts["skipped"] = c
+					}
 
 					item.StatusParts = statusParts
 					if len(statusStrParts) > 0 {
@@ -1724,6 +1850,34 @@ func openPlanStatusTUI(plan *orchestration.Plan) tea.Cmd {
 	)
 }
 
+// createPlanWorktreeCmd creates a worktree for a plan that doesn't have one, makes
+// the plan active inside it, and records it in the plan's .grove-plan.yml.
+func createPlanWorktreeCmd(plan *orchestration.Plan, worktreeName, gitRoot string) tea.Cmd {
+	return func() tea.Msg {
+		if plan.Config == nil {
+			plan.Config = &orchestration.PlanConfig{}
+		}
+
+		worktreePath, err := createWorktreeIfRequested(worktreeName, plan.Config.Repos, gitRoot)
+		if err != nil {
+			return worktreeCreatedMsg{planName: plan.Name, worktree: worktreeName, err: err}
+		}
+		if err := setWorktreeActivePlan(worktreePath, plan.Name); err != nil {
+			return worktreeCreatedMsg{planName: plan.Name, worktree: worktreeName, err: err}
+		}
+
+		plan.Config.Worktree = worktreeName
+		data, err := yaml.Marshal(plan.Config)
+		if err != nil {
+			return worktreeCreatedMsg{planName: plan.Name, worktree: worktreeName, err: fmt.Errorf("failed to marshal plan config: %w", err)}
+		}
+		if err := os.WriteFile(filepath.Join(plan.Directory, ".grove-plan.yml"), data, 0644); err != nil {
+			return worktreeCreatedMsg{planName: plan.Name, worktree: worktreeName, err: fmt.Errorf("failed to write plan config: %w", err)}
+		}
+		return worktreeCreatedMsg{planName: plan.Name, worktree: worktreeName}
+	}
+}
+
 func executePlanFinish(plan *orchestration.Plan) tea.Cmd {
 	return tea.Sequence(
 		// First set the active job programmatically
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/grovetools/core/git"
+)
+
+// worktreeGitDetails is the git-derived part of a PlanListItem.
+type worktreeGitDetails struct {
+	GitStatus             *git.StatusInfo
+	MergeStatus           string
+	EcosystemRepoStatuses []EcosystemRepoStatus
+}
+
+type worktreeGitCacheEntry struct {
+	fingerprint string
+	details     worktreeGitDetails
+}
+
+// worktreeGitCache keeps the git status of each plan worktree between plan list
+// refreshes, so the refresh tick only recomputes status for worktrees whose
+// HEAD, index, working tree, or main branch changed.
+var worktreeGitCache = struct {
+	sync.Mutex
+	entries map[string]worktreeGitCacheEntry
+}{entries: make(map[string]worktreeGitCacheEntry)}
+
+// cachedWorktreeGitDetails returns the cached details for worktreePath if its
+// fingerprint is unchanged.
+func cachedWorktreeGitDetails(worktreePath, fingerprint string) (worktreeGitDetails, bool) {
+	worktreeGitCache.Lock()
+	defer worktreeGitCache.Unlock()
+	entry, ok := worktreeGitCache.entries[worktreePath]
+	if !ok || fingerprint == "" || entry.fingerprint != fingerprint {
+		return worktreeGitDetails{}, false
+	}
+	return entry.details, true
+}
+
+func storeWorktreeGitDetails(worktreePath, fingerprint string, details worktreeGitDetails) {
+	if fingerprint == "" {
+		return
+	}
+	worktreeGitCache.Lock()
+	defer worktreeGitCache.Unlock()
+	worktreeGitCache.entries[worktreePath] = worktreeGitCacheEntry{fingerprint: fingerprint, details: details}
+}
+
+// clearWorktreeGitCache forces the next plan list load to recompute git status.
+func clearWorktreeGitCache() {
+	worktreeGitCache.Lock()
+	defer worktreeGitCache.Unlock()
+	worktreeGitCache.entries = make(map[string]worktreeGitCacheEntry)
+}
+
+// worktreeGitFingerprint summarizes the state that git status, ahead/behind
+// counts, and merge status depend on: each repository's HEAD, index mtime, and
+// uncommitted changes, plus the main branch of gitRoot. Refs are read directly;
+// only the dirty state needs a `git status` per repository. It returns "" when
+// the state can't be determined.
+func worktreeGitFingerprint(worktreePath, gitRoot string, repos []string) string {
+	paths := []string{worktreePath}
+	for _, repo := range repos {
+		paths = append(paths, filepath.Join(worktreePath, repo))
+	}
+
+	var parts []string
+	for _, path := range paths {
+		if _, ok := resolveGitDir(path); !ok {
+			if path == worktreePath {
+				return ""
+			}
+			continue // Repo not present in this ecosystem worktree
+		}
+		// Run status first: it may refresh the index, which changes its mtime
+		dirty, err := runGitIn(path, "status", "--porcelain")
+		if err != nil {
+			return ""
+		}
+		state, ok := repoHeadState(path)
+		if !ok {
+			return ""
+		}
+		parts = append(parts, fmt.Sprintf("%s#%x", state, sha256.Sum256([]byte(dirty))))
+	}
+	if mainState, ok := repoRefState(gitRoot, "refs/heads/main"); ok {
+		parts = append(parts, mainState)
+	}
+	return strings.Join(parts, "|")
+}
+
+// repoHeadState returns the HEAD commit and index mtime of the repository or
+// worktree checked out at path.
+func repoHeadState(path string) (string, bool) {
+	gitDir, ok := resolveGitDir(path)
+	if !ok {
+		return "", false
+	}
+	head, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return "", false
+	}
+	state := strings.TrimSpace(string(head))
+	if ref, isRef := strings.CutPrefix(state, "ref: "); isRef {
+		refState, ok := repoRefState(path, ref)
+		if !ok {
+			return "", false
+		}
+		state = refState
+	}
+	if info, err := os.Stat(filepath.Join(gitDir, "index")); err == nil {
+		state += fmt.Sprintf("@%d", info.ModTime().UnixNano())
+	}
+	return state, true
+}
+
+// repoRefState returns the value of ref in the repository at path, looking in
+// the git dir, the common dir shared by worktrees, and finally packed-refs.
+func repoRefState(path, ref string) (string, bool) {
+	gitDir, ok := resolveGitDir(path)
+	if !ok {
+		return "", false
+	}
+	dirs := []string{gitDir}
+	if common, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir := strings.TrimSpace(string(common))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+		dirs = append(dirs, commonDir)
+	}
+
+	for _, dir := range dirs {
+		if data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(ref))); err == nil {
+			return ref + "=" + strings.TrimSpace(string(data)), true
+		}
+	}
+	for _, dir := range dirs {
+		if info, err := os.Stat(filepath.Join(dir, "packed-refs")); err == nil {
+			return fmt.Sprintf("%s@packed-%d", ref, info.ModTime().UnixNano()), true
+		}
+	}
+	return "", false
+}
+
+// resolveGitDir returns the git directory for path, following the "gitdir:"
+// file that worktrees and submodules use in place of a .git directory.
+func resolveGitDir(path string) (string, bool) {
+	dotGit := filepath.Join(path, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		return "", false
+	}
+	if info.IsDir() {
+		return dotGit, true
+	}
+	data, err := os.ReadFile(dotGit)
+	if err != nil {
+		return "", false
+	}
+	gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir: ")
+	if !ok {
+		return "", false
+	}
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(path, gitDir)
+	}
+	return gitDir, true
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestWorktreeGitFingerprint(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+	root := t.TempDir()
+	worktree := filepath.Join(root, ".grove-worktrees", "feature")
+	git := func(dir string, args ...string) {
+		t.Helper()
+		if _, err := runGitIn(dir, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	git(root, "init", "-q", "-b", "main")
+	write(filepath.Join(root, "file.txt"), "base\n")
+	git(root, "add", "file.txt")
+	git(root, "commit", "-q", "-m", "base")
+	git(root, "worktree", "add", "-q", "-b", "feature", worktree)
+
+	first := worktreeGitFingerprint(worktree, root, nil)
+	if first == "" {
+		t.Fatal("expected a fingerprint for a valid worktree")
+	}
+	if again := worktreeGitFingerprint(worktree, root, nil); again != first {
+		t.Errorf("fingerprint changed without any git change: %q != %q", again, first)
+	}
+
+	write(filepath.Join(worktree, "file.txt"), "edited\n")
+	unstaged := worktreeGitFingerprint(worktree, root, nil)
+	if unstaged == first {
+		t.Error("an unstaged edit should change the fingerprint")
+	}
+
+	git(worktree, "commit", "-q", "-am", "edit")
+	if worktreeGitFingerprint(worktree, root, nil) == unstaged {
+		t.Error("a new commit on the worktree branch should change the fingerprint")
+	}
+
+	if worktreeGitFingerprint(filepath.Join(root, "missing"), root, nil) != "" {
+		t.Error("a missing worktree should have no fingerprint")
+	}
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/grovetools/core/cli"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/spf13/cobra"
+)
+
+// NewPlanValidateCmd creates the `plan validate` command.
+func NewPlanValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate [directory]",
+		Short: "Check a plan's job files for mistakes before running it",
+		Long: `Check every job in a plan without running anything:
+
+  - frontmatter fields have the right types and known values (type, status, output, prompt_overflow)
+  - job IDs are present and unique
+  - depends_on entries resolve to jobs in the plan, with no cycles
+  - template names exist
+  - include paths resolve
+
+Problems are listed per job file. Exits non-zero if any are found.
+If no directory is specified, uses the active job if set.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanValidate,
+	}
+}
+
+func runPlanValidate(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	planPath, err := resolvePlanPathWithActiveJob(dir)
+	if err != nil {
+		return fmt.Errorf("could not resolve plan path: %w", err)
+	}
+
+	problems, err := orchestration.ValidatePlanDir(planPath)
+	if err != nil {
+		return err
+	}
+
+	if cli.GetOptions(cmd).JSONOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if problems == nil {
+			problems = []orchestration.ValidationProblem{}
+		}
+		if err := encoder.Encode(problems); err != nil {
+			return err
+		}
+	} else if len(problems) == 0 {
+		fmt.Printf("%s No problems found in %s\n", theme.IconSuccess, planPath)
+	} else {
+		current := "\x00"
+		for _, p := range problems {
+			if p.File != current {
+				current = p.File
+				if current == "" {
+					fmt.Println("Plan")
+				} else {
+					fmt.Println(current)
+				}
+			}
+			fmt.Printf("  %s %s\n", theme.IconError, p.Message)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problem(s) in plan", len(problems))
+	}
+	return nil
+}
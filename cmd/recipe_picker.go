@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/grovetools/core/tui/keymap"
+	"github.com/grovetools/core/tui/theme"
+	"github.com/grovetools/flow/pkg/orchestration"
+	"github.com/mattn/go-isatty"
+)
+
+// recipeItem is a recipe shown in the recipe picker.
+type recipeItem struct {
+	recipe *orchestration.Recipe
+}
+
+func (r recipeItem) FilterValue() string { return r.recipe.Name + " " + r.recipe.Description }
+func (r recipeItem) Title() string       { return r.recipe.Name }
+func (r recipeItem) Description() string { return r.recipe.Description }
+
+type recipePickerKeyMap struct {
+	keymap.Base
+	Select key.Binding
+}
+
+// recipePickerModel lets the user choose one recipe from a list.
+type recipePickerModel struct {
+	list     list.Model
+	keys     recipePickerKeyMap
+	selected *orchestration.Recipe
+	quitting bool
+}
+
+func newRecipePickerModel(recipes []*orchestration.Recipe) recipePickerModel {
+	items := make([]list.Item, len(recipes))
+	for i, r := range recipes {
+		items[i] = recipeItem{recipe: r}
+	}
+
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.Foreground(theme.DefaultColors.Orange).BorderForeground(theme.DefaultColors.Orange)
+	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.BorderForeground(theme.DefaultColors.Orange)
+
+	l := list.New(items, delegate, 80, min(len(recipes)*3+4, 20))
+	l.Title = "Select a recipe"
+	l.Styles.Title = theme.DefaultTheme.Header.Bold(true)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+
+	return recipePickerModel{
+		list: l,
+		keys: recipePickerKeyMap{
+			Base: keymap.NewBase(),
+			Select: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "select recipe"),
+			),
+		},
+	}
+}
+
+func (m recipePickerModel) Init() tea.Cmd { return nil }
+
+func (m recipePickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.list.SetWidth(msg.Width)
+		return m, nil
+	case tea.KeyMsg:
+		// Let the filter input consume keys while the user is typing
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+		switch {
+		case key.Matches(msg, m.keys.Select):
+			if it, ok := m.list.SelectedItem().(recipeItem); ok {
+				m.selected = it.recipe
+			}
+			return m, tea.Quit
+		case key.Matches(msg, m.keys.Quit), msg.String() == "esc":
+			m.quitting = true
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m recipePickerModel) View() string {
+	if m.selected != nil || m.quitting {
+		return ""
+	}
+	return m.list.View()
+}
+
+// pickRecipe asks the user to choose one of several recipes. It falls back to the
+// first recipe, with a note, when stdin is not a terminal or skipPrompt is set.
+func pickRecipe(recipes []*orchestration.Recipe, skipPrompt bool) (*orchestration.Recipe, error) {
+	if skipPrompt || !isatty.IsTerminal(os.Stdin.Fd()) {
+		var names []string
+		for _, r := range recipes {
+			names = append(names, r.Name)
+		}
+		fmt.Printf("* Using first recipe: %s (available: %s; specify with --recipe to choose a different one)\n",
+			recipes[0].Name, strings.Join(names, ", "))
+		return recipes[0], nil
+	}
+
+	finalModel, err := tea.NewProgram(newRecipePickerModel(recipes)).Run()
+	if err != nil {
+		return nil, fmt.Errorf("error running recipe picker: %w", err)
+	}
+	m := finalModel.(recipePickerModel)
+	if m.selected == nil {
+		return nil, fmt.Errorf("no recipe selected")
+	}
+	fmt.Printf("* Selected recipe: %s\n", m.selected.Name)
+	return m.selected, nil
+}
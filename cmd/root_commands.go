@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 )
 
@@ -19,11 +21,15 @@ Examples:
   # Add a job with inline prompt
   flow add myplan -t agent --title "Implementation" -d 01-plan.md -p "Implement the user authentication feature"
 
+  # Pipe a long prompt in from stdin
+  cat spec.md | flow add myplan -t oneshot --title "Spec Review" -f -
+
   # Use active job
   flow set myplan
   flow add -t agent --title "Implementation" -d 01-plan.md -p "Implement feature"`,
-		Args: cobra.MaximumNArgs(1),
-		RunE: runPlanAdd,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanAdd,
 	}
 	// Add flags from plan_add_step.go
 	addCmd.Flags().StringVar(&planAddTemplate, "template", "", "Name of the job template to use")
@@ -32,11 +38,12 @@ Examples:
    • chat             - Interactive conversation requiring user input
    • shell            - Execute shell commands directly
    • headless_agent   - Autonomous agent without user interaction
-   • interactive_agent - Agent with user interaction (default)
+   • interactive_agent - Agent with user interaction (default unless the plan sets default_job_type)
    • file             - Static file content, no execution`)
 	addCmd.Flags().StringVar(&planAddTitle, "title", "", "Job title")
-	addCmd.Flags().StringSliceVarP(&planAddDependsOn, "depends-on", "d", nil, "Dependencies (job filenames)")
-	addCmd.Flags().StringVarP(&planAddPromptFile, "prompt-file", "f", "", "File containing the prompt")
+	addCmd.Flags().StringSliceVarP(&planAddDependsOn, "depends-on", "d", nil, "Dependencies by job ID or filename (repeatable)")
+	addCmd.Flags().StringVarP(&planAddPromptFile, "prompt-file", "f", "", "File containing the prompt (use - to read from stdin)")
+	addCmd.Flags().BoolVar(&planAddPromptStdin, "prompt-stdin", false, "Read the prompt from stdin")
 	addCmd.Flags().StringVarP(&planAddPrompt, "prompt", "p", "", "Inline prompt text (alternative to --prompt-file)")
 	addCmd.Flags().BoolVarP(&planAddInteractive, "interactive", "i", false, "Interactive mode")
 	addCmd.Flags().StringSliceVar(&planAddIncludeFiles, "include", nil, "Comma-separated list of files to include as context")
@@ -52,6 +59,9 @@ Examples:
 	addCmd.Flags().StringVar(&planAddRecipe, "recipe", "", "Name of a recipe to add to the plan")
 	addCmd.Flags().StringArrayVar(&planAddRecipeVars, "recipe-vars", nil, "Variables for the recipe templates (e.g., key=value)")
 	addCmd.Flags().StringVar(&planAddSourceFile, "source-file", "", "Origin file path for tracking job provenance (e.g., Claude plan file)")
+	addCmd.Flags().StringVar(&planAddAfter, "after", "", "Insert the job after this job (ID or filename), depending on it and renumbering later jobs")
+	addCmd.Flags().BoolVar(&planAddInsert, "insert", false, "With --after, also make jobs that depended on the anchor depend on the new job")
+	registerAddCompletions(addCmd)
 	return addCmd
 }
 
@@ -62,17 +72,51 @@ func NewRunCmd() *cobra.Command {
 		Long: `Run jobs in an orchestration plan.
 Without arguments, runs the next available jobs.
 With a single job file argument, runs that specific job.
-With multiple job file arguments, runs those jobs in parallel.`,
-		RunE: runPlanRun,
+With multiple job file arguments, runs those jobs in parallel.
+Up to --parallel jobs run at once (default 3, or max_parallel from the plan's
+.grove-plan.yml); interactive agent and chat jobs always run one at a time.
+With --rerun-on-change, keeps running afterwards and re-runs a pending or
+failed job each time its markdown file is saved, until interrupted with Ctrl+C.
+After a job fails, no new jobs are started and the run stops once running jobs
+finish. With --fail-fast=false, only the failed job's dependents are blocked;
+independent jobs keep running and all failures are reported at the end.
+--prompt-prefix and --prompt-suffix add a one-off instruction before or after
+every job's request for this run; the briefing file marks them as run
+instructions.
+With --only-ready, runs every job whose dependencies are already satisfied and
+stops, leaving the jobs they unblock for the next invocation, so the plan can
+be stepped through one layer at a time.
+Before running, each pending job is listed with its type, effective model,
+worktree and context source for confirmation; --yes skips this.`,
+		ValidArgsFunction: completeRunArgs,
+		RunE:              runPlanRun,
 	}
 	runCmd.Flags().StringVarP(&planRunDir, "dir", "d", ".", "Plan directory")
 	runCmd.Flags().BoolVarP(&planRunAll, "all", "a", false, "Run all pending jobs")
 	runCmd.Flags().BoolVarP(&planRunNext, "next", "n", false, "Run next available jobs")
-	runCmd.Flags().IntVarP(&planRunParallel, "parallel", "p", 3, "Max parallel jobs")
+	runCmd.Flags().BoolVar(&planRunOnlyReady, "only-ready", false, "Run every job that is ready now, then stop without running the jobs they unblock")
+	runCmd.Flags().IntVarP(&planRunParallel, "parallel", "p", 3, "Max parallel jobs (overrides max_parallel in .grove-plan.yml); interactive and chat jobs always run one at a time")
 	runCmd.Flags().BoolVarP(&planRunWatch, "watch", "w", false, "Watch progress in real-time")
+	runCmd.Flags().BoolVar(&planRunRerunOnChange, "rerun-on-change", false, "After the run, keep watching the plan and re-run pending/failed jobs whenever their files change")
 	runCmd.Flags().BoolVarP(&planRunYes, "yes", "y", false, "Skip confirmation prompts")
 	runCmd.Flags().StringVar(&planRunModel, "model", "", "Override model for jobs (e.g., claude-3-5-sonnet-20240620, gpt-4)")
 	runCmd.Flags().BoolVar(&planRunSkipInteractive, "skip-interactive", false, "Skip interactive agent jobs (useful for CI/automation)")
+	runCmd.Flags().BoolVar(&planRunDryRun, "dry-run", false, "Print the assembled prompt for each job without calling the LLM or changing job status")
+	runCmd.Flags().DurationVar(&planRunTimeout, "timeout", 0, "Override the LLM timeout for oneshot jobs (e.g., 10m)")
+	runCmd.Flags().IntVar(&planRunRetryCount, "retry-count", 0, "Override the number of LLM retries for oneshot jobs")
+	runCmd.Flags().BoolVar(&planRunRestart, "restart", false, "Ignore the checkpoint from an interrupted --all run and start over")
+	runCmd.Flags().StringVar(&planRunReport, "report", "", "Write a report of each job's final status, duration, model, output path and error to this file")
+	runCmd.Flags().StringVar(&planRunReportFormat, "report-format", "markdown", "Report format: markdown or json")
+	runCmd.Flags().BoolVar(&planRunForceContext, "force-context", false, "Regenerate context for every job instead of reusing it when the worktree and rules are unchanged")
+	runCmd.Flags().IntVar(&planRunMaxTurns, "max-turns", 0, "Let chat jobs run up to this many turns unattended (overrides max_turns in frontmatter)")
+	runCmd.Flags().StringArrayVar(&planRunContextFiles, "context-file", nil, "Attach an extra file to every job's prompt for this run (repeatable)")
+	runCmd.Flags().BoolVar(&planRunNoContext, "no-context", false, "Skip context generation and omit .grove/context and CLAUDE.md from prompts")
+	runCmd.Flags().BoolVar(&planRunSaveRaw, "save-raw", false, "Save each oneshot job's verbatim LLM response to <job-id>.raw.md in the log directory")
+	runCmd.Flags().BoolVar(&planRunFailFast, "fail-fast", true, "Stop starting new jobs after the first failure; with --fail-fast=false, block only the failed job's dependents and run the rest")
+	runCmd.Flags().StringSliceVar(&planRunWorktreeMatrix, "worktree-matrix", nil, "Run the plan's jobs once per worktree (comma-separated), overriding worktrees in .grove-plan.yml")
+	runCmd.Flags().BoolVar(&planRunOffline, "offline", false, "Fail jobs that include URL prompt sources instead of fetching them")
+	runCmd.Flags().StringVar(&planRunPromptPrefix, "prompt-prefix", "", "Instruction to place before every job's request for this run (e.g., \"respond in French\")")
+	runCmd.Flags().StringVar(&planRunPromptSuffix, "prompt-suffix", "", "Instruction to place after every job's request for this run")
 	return runCmd
 }
 
@@ -82,11 +126,20 @@ func NewStatusCmd() *cobra.Command {
 		Short: "Show plan status in an interactive TUI",
 		Long: `Show the status of all jobs in an orchestration plan within an interactive TUI.
 If no directory is specified, uses the active job if set.
-If no active job is set, it will launch the plan browser.`,
-		Args: cobra.MaximumNArgs(1),
-		RunE: runPlanStatus,
+If no active job is set, it will launch the plan browser.
+
+With --watch, prints a plain-text summary instead of the TUI and reprints it
+whenever a job's status changes, until every job has completed, failed, been
+blocked, skipped or abandoned, or until Ctrl+C. Use this on CI machines and
+other terminals where the TUI cannot run.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completePlanArg,
+		RunE:              runPlanStatus,
 	}
 	statusCmd.Flags().BoolVarP(&statusTUI, "tui", "t", false, "Launch interactive TUI (default behavior, kept for backwards compatibility)")
+	statusCmd.Flags().DurationVar(&statusSince, "since", 0, "Only show jobs started, finished, or modified within this window (e.g., 1h, 30m)")
+	statusCmd.Flags().BoolVar(&statusWatch, "watch", false, "Print plain-text status updates without the TUI until all jobs finish")
+	statusCmd.Flags().DurationVar(&statusWatchInterval, "interval", 5*time.Second, "How often --watch checks the plan for changes")
 	return statusCmd
 }
 
@@ -0,0 +1,54 @@
+package status_tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// clipboardCommands lists the programs that can write stdin to the system
+// clipboard on this platform, in order of preference.
+func clipboardCommands() [][]string {
+	switch runtime.GOOS {
+	case "darwin":
+		return [][]string{{"pbcopy"}}
+	case "windows":
+		return [][]string{{"clip"}}
+	default:
+		var cmds [][]string
+		if os.Getenv("WAYLAND_DISPLAY") != "" {
+			cmds = append(cmds, []string{"wl-copy"})
+		}
+		return append(cmds,
+			[]string{"xclip", "-selection", "clipboard"},
+			[]string{"xsel", "--clipboard", "--input"},
+		)
+	}
+}
+
+// copyToClipboard copies text to the system clipboard. If no clipboard program
+// is available it writes text to a temporary file instead and returns its path.
+func copyToClipboard(text string) (fallbackPath string, err error) {
+	for _, args := range clipboardCommands() {
+		if _, err := exec.LookPath(args[0]); err != nil {
+			continue
+		}
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err == nil {
+			return "", nil
+		}
+	}
+
+	f, err := os.CreateTemp("", "flow-prompt-*.xml")
+	if err != nil {
+		return "", fmt.Errorf("no clipboard available and could not write temp file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(text); err != nil {
+		return "", fmt.Errorf("writing prompt to %s: %w", f.Name(), err)
+	}
+	return f.Name(), nil
+}
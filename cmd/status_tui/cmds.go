@@ -50,6 +50,7 @@ type FrontmatterContentLoadedMsg struct {
 type BriefingContentLoadedMsg struct {
 	Content string
 	Err     error
+	Diff    bool // Content is a diff of the last two briefings
 }
 
 type EditContentLoadedMsg struct {
@@ -122,9 +123,7 @@ func loadFrontmatterCmd(job *orchestration.Job) tea.Cmd {
 // loadBriefingCmd finds and loads the most recent briefing file for a job.
 func loadBriefingCmd(plan *orchestration.Plan, job *orchestration.Job) tea.Cmd {
 	return func() tea.Msg {
-		jobArtifactDir := filepath.Join(plan.Directory, ".artifacts", job.ID)
-		pattern := "briefing-*.xml"
-		files, err := filepath.Glob(filepath.Join(jobArtifactDir, pattern))
+		files, err := orchestration.BriefingFiles(plan, job)
 		if err != nil {
 			return BriefingContentLoadedMsg{Err: err}
 		}
@@ -133,29 +132,34 @@ func loadBriefingCmd(plan *orchestration.Plan, job *orchestration.Job) tea.Cmd {
 			return BriefingContentLoadedMsg{Content: "No briefing file found for this job."}
 		}
 
-		// Find the most recent file
-		var mostRecentFile string
-		var latestModTime time.Time
-		for _, file := range files {
-			info, err := os.Stat(file)
-			if err == nil {
-				if info.ModTime().After(latestModTime) {
-					latestModTime = info.ModTime()
-					mostRecentFile = file
-				}
-			}
+		content, err := os.ReadFile(files[len(files)-1])
+		if err != nil {
+			return BriefingContentLoadedMsg{Err: err}
 		}
 
-		if mostRecentFile == "" {
-			return BriefingContentLoadedMsg{Content: "Could not determine the most recent briefing file."}
-		}
+		return BriefingContentLoadedMsg{Content: string(content)}
+	}
+}
 
-		content, err := os.ReadFile(mostRecentFile)
+// loadBriefingDiffCmd loads a unified diff of a job's last two briefing files.
+func loadBriefingDiffCmd(plan *orchestration.Plan, job *orchestration.Job) tea.Cmd {
+	return func() tea.Msg {
+		files, err := orchestration.BriefingFiles(plan, job)
 		if err != nil {
-			return BriefingContentLoadedMsg{Err: err}
+			return BriefingContentLoadedMsg{Err: err, Diff: true}
+		}
+		if len(files) < 2 {
+			return BriefingContentLoadedMsg{Content: "Need at least two briefing files to diff; run this job again.", Diff: true}
 		}
 
-		return BriefingContentLoadedMsg{Content: string(content)}
+		diff, err := orchestration.DiffLastBriefings(plan, job)
+		if err != nil {
+			return BriefingContentLoadedMsg{Err: err, Diff: true}
+		}
+		if diff == "" {
+			return BriefingContentLoadedMsg{Content: "The last two briefings are identical.", Diff: true}
+		}
+		return BriefingContentLoadedMsg{Content: diff, Diff: true}
 	}
 }
 
@@ -635,14 +639,17 @@ func runJobsWithOrchestrator(orchestrator *orchestration.Orchestrator, jobs []*o
 				tuiWriter.NoWorkspacePrefix = true
 			}
 
-			// Run jobs concurrently
+			// Run jobs concurrently, up to the plan's parallel limit
 			var wg sync.WaitGroup
 			errChan := make(chan error, len(jobs))
+			slots := make(chan struct{}, orchestrator.MaxParallelJobs())
 
 			for _, job := range jobs {
 				wg.Add(1)
 				go func(j *orchestration.Job) {
 					defer wg.Done()
+					slots <- struct{}{}
+					defer func() { <-slots }()
 					// Recover from panics in individual job goroutines
 					defer func() {
 						if r := recover(); r != nil {
@@ -985,15 +992,44 @@ func setJobStatus(job *orchestration.Job, plan *orchestration.Plan, status orche
 	}
 }
 
+// setMultipleJobStatus applies status to every job, continuing past failures so
+// one bad file doesn't leave the rest of the selection unchanged.
 func setMultipleJobStatus(jobs []*orchestration.Job, plan *orchestration.Plan, status orchestration.JobStatus) tea.Cmd {
 	return func() tea.Msg {
 		sp := orchestration.NewStatePersister()
+		var failed []string
 		for _, job := range jobs {
 			if err := sp.UpdateJobStatus(job, status); err != nil {
-				return err
+				failed = append(failed, job.Filename)
 			}
 		}
-		return RefreshMsg{} // Refresh to show the status change
+		summary := fmt.Sprintf("Set %d job(s) to %s", len(jobs)-len(failed), status)
+		if len(failed) > 0 {
+			summary += fmt.Sprintf(" (failed: %s)", strings.Join(failed, ", "))
+		}
+		return StatusUpdateMsg(summary) // Refreshes to show the status change
+	}
+}
+
+// PromptCopiedMsg reports the result of copying a job's assembled prompt.
+// FallbackPath is set when no clipboard was available and the prompt was
+// written to that file instead.
+type PromptCopiedMsg struct {
+	JobID        string
+	FallbackPath string
+	Err          error
+}
+
+// copyJobPrompt assembles the prompt job would be sent and copies it to the
+// clipboard.
+func copyJobPrompt(job *orchestration.Job, plan *orchestration.Plan) tea.Cmd {
+	return func() tea.Msg {
+		prompt, err := orchestration.AssemblePrompt(job, plan)
+		if err != nil {
+			return PromptCopiedMsg{JobID: job.ID, Err: err}
+		}
+		path, err := copyToClipboard(prompt)
+		return PromptCopiedMsg{JobID: job.ID, FallbackPath: path, Err: err}
 	}
 }
 
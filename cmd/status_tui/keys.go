@@ -23,9 +23,12 @@ type KeyMap struct {
 	Implement       key.Binding
 	AgentFromChat   key.Binding
 	Rename          key.Binding
+	CopyPrompt      key.Binding
 	Resume          key.Binding
 	EditDeps        key.Binding
 	ToggleSummaries key.Binding
+	ToggleRecent    key.Binding
+	CycleSort       key.Binding
 	ToggleView      key.Binding
 	ToggleColumns   key.Binding
 	GoToTop         key.Binding
@@ -35,6 +38,7 @@ type KeyMap struct {
 	ViewLogs          key.Binding
 	ViewFrontmatter   key.Binding
 	ViewBriefing      key.Binding
+	DiffBriefing      key.Binding
 	ViewEdit          key.Binding
 	CycleDetailPane   key.Binding
 	CloseDetailPane   key.Binding
@@ -110,6 +114,10 @@ func NewKeyMap() KeyMap {
 			key.WithKeys("ctrl+r"),
 			key.WithHelp("ctrl+r", "rename job"),
 		),
+		CopyPrompt: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "copy prompt to clipboard"),
+		),
 		Resume: key.NewBinding(
 			key.WithKeys("R"),
 			key.WithHelp("R", "resume job"),
@@ -122,6 +130,14 @@ func NewKeyMap() KeyMap {
 			key.WithKeys("s"),
 			key.WithHelp("s", "toggle summaries"),
 		),
+		ToggleRecent: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "filter recent jobs"),
+		),
+		CycleSort: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "cycle sort (tree/status/title/updated)"),
+		),
 		ToggleView: key.NewBinding(
 			key.WithKeys("t"),
 			key.WithHelp("t", "toggle view"),
@@ -158,6 +174,10 @@ func NewKeyMap() KeyMap {
 			key.WithKeys("b"),
 			key.WithHelp("b", "view briefing"),
 		),
+		DiffBriefing: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "diff last two briefings"),
+		),
 		ViewEdit: key.NewBinding(
 			key.WithKeys("m", "p"),
 			key.WithHelp("m/p", "preview markdown"),
@@ -200,6 +220,7 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 			k.GoToBottom,
 			k.PageUp,
 			k.PageDown,
+			k.Search,
 		},
 		{
 			key.NewBinding(key.WithKeys(""), key.WithHelp("", "Selection")),
@@ -212,9 +233,12 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 			k.ToggleView,
 			k.ToggleColumns,
 			k.ToggleSummaries,
+			k.ToggleRecent,
+			k.CycleSort,
 			k.ViewLogs,
 			k.ViewFrontmatter,
 			k.ViewBriefing,
+			k.DiffBriefing,
 			k.ViewEdit,
 			k.CycleDetailPane,
 			k.CloseDetailPane,
@@ -235,6 +259,7 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 			k.AddXmlPlan,
 			k.Implement,
 			k.Rename,
+			k.CopyPrompt,
 			k.Resume,
 			k.EditDeps,
 			k.Archive,
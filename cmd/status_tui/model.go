@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -112,6 +113,7 @@ type Model struct {
 	editViewport        viewport.Model
 	frontmatterRawContent string
 	briefingRawContent    string
+	briefingShowDiff      bool // Briefing pane shows a diff of the last two briefings
 	editRawContent        string
 	Focus               ViewFocus // Track which pane is active
 	LogSplitVertical    bool      // Track log viewer layout
@@ -124,6 +126,117 @@ type Model struct {
 	LogViewerWidth     int       // Cached log viewer width
 	LogViewerHeight    int       // Cached log viewer height
 	JobsPaneWidth      int       // Cached jobs pane width for vertical split
+	SinceFilterActive  bool          // Only list jobs active within SinceWindow
+	SinceWindow        time.Duration // Window for the recent-jobs filter
+	SortOrder          string        // "" for dependency tree order, or one of sortOrders
+	Searching          bool            // Search input is focused
+	SearchInput        textinput.Model // Search query input
+	SearchQuery        string          // Active fuzzy filter on title/id/status
+	StaleJobs          map[string]bool // Completed jobs whose prompt changed since they ran, as of opening the TUI
+}
+
+// sortOrders are the job list orderings cycled by the sort key, after the
+// default dependency tree order.
+var sortOrders = []string{"status", "title", "updated"}
+
+// statusSortRank orders active and failed jobs before finished ones.
+var statusSortRank = map[orchestration.JobStatus]int{
+	orchestration.JobStatusRunning:     0,
+	orchestration.JobStatusFailed:      1,
+	orchestration.JobStatusPendingUser: 2,
+	orchestration.JobStatusPendingLLM:  3,
+	orchestration.JobStatusNeedsReview: 4,
+	orchestration.JobStatusPending:     5,
+	orchestration.JobStatusIdle:        6,
+	orchestration.JobStatusBlocked:     7,
+	orchestration.JobStatusHold:        8,
+	orchestration.JobStatusTodo:        9,
+	orchestration.JobStatusCompleted:   10,
+	orchestration.JobStatusSkipped:     11,
+	orchestration.JobStatusAbandoned:   12,
+}
+
+// jobMatchesSearch reports whether query fuzzy-matches the job's title, ID,
+// filename, or status.
+func jobMatchesSearch(job *orchestration.Job, query string) bool {
+	for _, field := range []string{job.Title, job.ID, job.Filename, string(job.Status)} {
+		if fuzzyMatch(query, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// fuzzyMatch reports whether the characters of query appear in text in order,
+// ignoring case.
+func fuzzyMatch(query, text string) bool {
+	text = strings.ToLower(text)
+	for _, r := range strings.ToLower(query) {
+		i := strings.IndexRune(text, r)
+		if i < 0 {
+			return false
+		}
+		text = text[i+len(string(r)):]
+	}
+	return true
+}
+
+// nextSortOrder returns the sort order after current, wrapping back to tree order.
+func nextSortOrder(current string) string {
+	for i, order := range sortOrders {
+		if order == current {
+			if i+1 < len(sortOrders) {
+				return sortOrders[i+1]
+			}
+			return ""
+		}
+	}
+	return sortOrders[0]
+}
+
+// sortJobs orders jobs in place by the given sort order.
+func sortJobs(jobs []*orchestration.Job, order string) {
+	switch order {
+	case "status":
+		sort.SliceStable(jobs, func(i, j int) bool {
+			ri, ok := statusSortRank[jobs[i].Status]
+			if !ok {
+				ri = len(statusSortRank)
+			}
+			rj, ok := statusSortRank[jobs[j].Status]
+			if !ok {
+				rj = len(statusSortRank)
+			}
+			return ri < rj
+		})
+	case "title":
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return strings.ToLower(jobs[i].Title) < strings.ToLower(jobs[j].Title)
+		})
+	case "updated":
+		sort.SliceStable(jobs, func(i, j int) bool {
+			return jobs[i].UpdatedAt.After(jobs[j].UpdatedAt)
+		})
+	}
+}
+
+// defaultSinceWindow is used by the recent-jobs filter key when --since wasn't given.
+const defaultSinceWindow = time.Hour
+
+// tuiOrchestratorConfig returns the orchestrator settings for running jobs from
+// the TUI. Like `flow plan run`, it runs up to the plan's max_parallel jobs at
+// once, or 3.
+func tuiOrchestratorConfig(plan *orchestration.Plan) *orchestration.OrchestratorConfig {
+	maxParallel := 3
+	if plan.Config != nil && plan.Config.MaxParallel > 0 {
+		maxParallel = plan.Config.MaxParallel
+	}
+	return &orchestration.OrchestratorConfig{
+		MaxParallelJobs:     maxParallel,
+		CheckInterval:       5 * time.Second,
+		MaxConsecutiveSteps: 20,
+		SkipInteractive:     true, // Don't prompt for user input in TUI mode
+	}
 }
 
 // New creates a new Model
@@ -148,15 +261,8 @@ func New(plan *orchestration.Plan, graph *orchestration.DependencyGraph) Model {
 	editVp := viewport.New(80, 20)
 
 	// Create orchestrator for direct job execution
-	orchConfig := &orchestration.OrchestratorConfig{
-		MaxParallelJobs:     1,    // TUI runs one job or selection at a time
-		CheckInterval:       5 * time.Second,
-		MaxConsecutiveSteps: 20,
-		SkipInteractive:     true, // Don't prompt for user input in TUI mode
-	}
-
 	// Create the orchestrator instance
-	orch, err := orchestration.NewOrchestrator(plan, orchConfig)
+	orch, err := orchestration.NewOrchestrator(plan, tuiOrchestratorConfig(plan))
 	if err != nil {
 		// Log error but continue - the old path can still work
 		fmt.Fprintf(os.Stderr, "Warning: Failed to create orchestrator for TUI: %v\n", err)
@@ -196,7 +302,7 @@ func New(plan *orchestration.Plan, graph *orchestration.DependencyGraph) Model {
 		initialCursor = len(jobs) - 1
 	}
 
-	return Model{
+	m := Model{
 		Plan:             plan,
 		Graph:            graph,
 		Orchestrator:     orch,
@@ -228,9 +334,83 @@ func New(plan *orchestration.Plan, graph *orchestration.DependencyGraph) Model {
 		frontmatterViewport: frontmatterVp,
 		briefingViewport:    briefingVp,
 		editViewport:        editVp,
+		SortOrder:           state.SortOrder,
+		StaleJobs:           orchestration.StalePromptJobs(plan),
+	}
+	if m.SortOrder != "" {
+		m.rebuildJobList()
+	}
+	return m
+}
+
+// SetSinceFilter limits the job list to jobs active within window.
+func (m *Model) SetSinceFilter(window time.Duration) {
+	m.SinceWindow = window
+	m.SinceFilterActive = true
+	m.rebuildJobList()
+}
+
+// rebuildJobList flattens the plan's job tree and applies the recent-jobs filter.
+func (m *Model) rebuildJobList() {
+	jobs, parents, indents := flattenJobTreeWithParents(m.Plan)
+	if m.SinceFilterActive {
+		since := time.Now().Add(-m.SinceWindow)
+		var recent []*orchestration.Job
+		for _, job := range jobs {
+			if job.ActiveSince(since) {
+				recent = append(recent, job)
+			}
+		}
+		jobs = recent
+	}
+	if m.SearchQuery != "" {
+		var matches []*orchestration.Job
+		for _, job := range jobs {
+			if jobMatchesSearch(job, m.SearchQuery) {
+				matches = append(matches, job)
+			}
+		}
+		jobs = matches
+	}
+	if m.SortOrder != "" {
+		// A sorted list isn't a tree, so drop the indentation
+		sortJobs(jobs, m.SortOrder)
+		indents = make(map[string]int)
+	}
+	m.Jobs = jobs
+	m.JobParents = parents
+	m.JobIndents = indents
+
+	// Selection only covers jobs that are still listed
+	visible := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		visible[job.ID] = true
+	}
+	for id := range m.Selected {
+		if !visible[id] {
+			delete(m.Selected, id)
+		}
+	}
+
+	if m.Cursor >= len(m.Jobs) {
+		m.Cursor = len(m.Jobs) - 1
+	}
+	if m.Cursor < 0 && len(m.Jobs) > 0 {
+		m.Cursor = 0
 	}
 }
 
+// selectedPlanJobs returns the selected jobs in filename order.
+func (m Model) selectedPlanJobs() []*orchestration.Job {
+	var jobs []*orchestration.Job
+	for _, job := range m.Plan.GetJobsSortedByFilename() {
+		if m.Selected[job.ID] {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
 // SetProgramRef sets the package-level program reference
 // This is called by runStatusTUI before starting the program
 func SetProgramRef(program *tea.Program) {
@@ -268,6 +448,17 @@ func (m Model) renderJobsPane(contentWidth int) string {
 		planName = theme.DefaultTheme.Bold.Render(m.Plan.Name)
 	}
 	headerText := headerLabel + planName
+	if m.SinceFilterActive {
+		headerText += "  " + theme.DefaultTheme.Muted.Render(fmt.Sprintf("(active in last %s)", m.SinceWindow))
+	}
+	if m.SortOrder != "" {
+		headerText += "  " + theme.DefaultTheme.Muted.Render(fmt.Sprintf("(sorted by %s)", m.SortOrder))
+	}
+	if m.Searching {
+		headerText += "  / " + m.SearchInput.View()
+	} else if m.SearchQuery != "" {
+		headerText += "  " + theme.DefaultTheme.Muted.Render(fmt.Sprintf("(filter: %s, esc to clear)", m.SearchQuery))
+	}
 	styledHeader := lipgloss.NewStyle().
 		Background(theme.DefaultTheme.Header.GetBackground()).
 		Align(lipgloss.Left).
@@ -315,6 +506,9 @@ func (m Model) renderLogsPane(contentWidth int, paneContent string) (string, str
 			paneTitle = "Job Properties"
 		case BriefingPane:
 			paneTitle = "Briefing"
+			if m.briefingShowDiff {
+				paneTitle = "Briefing Diff"
+			}
 		case EditPane:
 			paneTitle = "Edit"
 		}
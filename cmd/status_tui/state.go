@@ -12,6 +12,7 @@ import (
 type tuiState struct {
 	ColumnVisibility map[string]bool `json:"column_visibility"`
 	LogSplitVertical bool            `json:"log_split_vertical,omitempty"`
+	SortOrder        string          `json:"sort_order,omitempty"`
 }
 
 // getStateFilePath returns the path to the TUI state file.
@@ -36,7 +37,7 @@ func defaultColumnVisibility() map[string]bool {
 		"PREPEND":    false,
 		"UPDATED":    false,
 		"COMPLETED":  false,
-		"DURATION":   false,
+		"DURATION":   true,  // Elapsed time helps spot slow or stuck jobs
 	}
 }
 
@@ -77,7 +78,7 @@ func loadState() (*tuiState, error) {
 }
 
 // saveState saves the TUI state to disk.
-func saveState(visibility map[string]bool, logSplitVertical bool) error {
+func saveState(visibility map[string]bool, logSplitVertical bool, sortOrder string) error {
 	path, err := getStateFilePath()
 	if err != nil {
 		return err
@@ -86,6 +87,7 @@ func saveState(visibility map[string]bool, logSplitVertical bool) error {
 	state := tuiState{
 		ColumnVisibility: visibility,
 		LogSplitVertical: logSplitVertical,
+		SortOrder:        sortOrder,
 	}
 
 	data, err := json.MarshalIndent(state, "", "  ")
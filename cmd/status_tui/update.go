@@ -118,7 +118,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case BriefingContentLoadedMsg:
-		if m.ActiveDetailPane == BriefingPane {
+		// Drop results for the other briefing mode if the user switched modes
+		if m.ActiveDetailPane == BriefingPane && msg.Diff == m.briefingShowDiff {
 			if msg.Err != nil {
 				m.briefingRawContent = theme.DefaultTheme.Error.Render(fmt.Sprintf("Error: %v", msg.Err))
 				m.briefingViewport.SetContent(m.briefingRawContent)
@@ -129,8 +130,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.updateLayoutDimensions()
 				m.briefingViewport.Width = m.LogViewerWidth
 				m.briefingViewport.Height = m.LogViewerHeight - logHeaderHeight
-				// Render styled briefing XML and wrap to viewport width - 1 for scrollbar
-				styledContent := renderStyledBriefing(m.briefingRawContent)
+				// Render styled briefing XML or diff and wrap to viewport width - 1 for scrollbar
+				styledContent := m.renderBriefingContent()
 				wrappedContent := wrapContentForViewport(styledContent, m.briefingViewport.Width-1)
 				m.briefingViewport.SetContent(wrappedContent)
 			}
@@ -200,6 +201,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case PromptCopiedMsg:
+		switch {
+		case msg.Err != nil:
+			m.StatusSummary = theme.DefaultTheme.Error.Render(fmt.Sprintf("Error copying prompt: %v", msg.Err))
+		case msg.FallbackPath != "":
+			m.StatusSummary = theme.DefaultTheme.Warning.Render(fmt.Sprintf("No clipboard available; prompt written to %s", msg.FallbackPath))
+		default:
+			m.StatusSummary = theme.DefaultTheme.Success.Render(theme.IconSuccess + " Prompt copied to clipboard.")
+		}
+		return m, nil
+
 	case RenameCompleteMsg:
 		if msg.Err != nil {
 			m.StatusSummary = theme.DefaultTheme.Error.Render(fmt.Sprintf("Error renaming job: %v", msg.Err))
@@ -328,6 +340,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Self-heal jobs whose run was killed and left a lock behind
+		for _, job := range plan.Jobs {
+			if job.Status != orchestration.JobStatusRunning {
+				continue
+			}
+			if recovered, err := orchestration.RecoverStaleLock(job); err != nil {
+				logger.WithFields(map[string]interface{}{
+					"job":   job.ID,
+					"error": err,
+				}).Warn("Failed to recover stale lock")
+			} else if recovered {
+				logger.WithFields(map[string]interface{}{
+					"job": job.ID,
+				}).Info("Reset job with stale lock to pending")
+			}
+		}
+
 		// Verify running jobs (check PIDs, clear stale "running" statuses)
 		// This needs to be imported from cmd package
 		verifyRunningJobStatusHelper(plan)
@@ -355,13 +384,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Recreate orchestrator with the refreshed plan
-		orchConfig := &orchestration.OrchestratorConfig{
-			MaxParallelJobs:     1,
-			CheckInterval:       5 * time.Second,
-			MaxConsecutiveSteps: 20,
-			SkipInteractive:     true,
-		}
-		orch, err := orchestration.NewOrchestrator(plan, orchConfig)
+		orch, err := orchestration.NewOrchestrator(plan, tuiOrchestratorConfig(plan))
 		if err != nil {
 			logger.WithFields(map[string]interface{}{
 				"error": err,
@@ -377,10 +400,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update model with refreshed data
 		m.Plan = plan
 		m.Graph = graph
-		jobs, parents, indents := flattenJobTreeWithParents(plan)
-		m.Jobs = jobs
-		m.JobParents = parents
-		m.JobIndents = indents
+		m.rebuildJobList()
 
 		// Only update status summary if not running a job
 		// (preserve the "Running..." message)
@@ -388,26 +408,6 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.StatusSummary = formatStatusSummaryHelper(plan)
 		}
 
-		// Adjust cursor if needed
-		if m.Cursor >= len(m.Jobs) {
-			m.Cursor = len(m.Jobs) - 1
-		}
-		if m.Cursor < 0 && len(m.Jobs) > 0 {
-			m.Cursor = 0
-		}
-
-		// Clear selections that no longer exist
-		newSelected := make(map[string]bool)
-		for id := range m.Selected {
-			for _, job := range m.Jobs {
-				if job.ID == id {
-					newSelected[id] = true
-					break
-				}
-			}
-		}
-		m.Selected = newSelected
-
 		// Autorun logic: if autorunning, check for next runnable jobs from the original selection
 		// Only trigger autorun if no jobs are currently running
 		if m.isAutorunning && !m.IsRunningJob && m.originalSelection != nil {
@@ -478,7 +478,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.frontmatterViewport.SetContent(wrappedContent)
 		}
 		if m.briefingRawContent != "" {
-			styledContent := renderStyledBriefing(m.briefingRawContent)
+			styledContent := m.renderBriefingContent()
 			wrappedContent := wrapContentForViewport(styledContent, m.briefingViewport.Width-1)
 			m.briefingViewport.SetContent(wrappedContent)
 		}
@@ -526,6 +526,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		// Handle search mode: the list narrows as the query is typed
+		if m.Searching {
+			switch msg.String() {
+			case "enter":
+				m.Searching = false
+				m.SearchInput.Blur()
+				return m, nil
+			case "esc":
+				m.Searching = false
+				m.SearchQuery = ""
+				m.rebuildJobList()
+				return m, nil
+			}
+			m.SearchInput, cmd = m.SearchInput.Update(msg)
+			if query := m.SearchInput.Value(); query != m.SearchQuery {
+				m.SearchQuery = query
+				m.rebuildJobList()
+			}
+			return m, cmd
+		}
+
 		// Handle renaming mode
 		if m.Renaming {
 			switch msg.String() {
@@ -669,18 +690,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 				// Set status for selected jobs or current job if none selected
 				if len(m.Selected) > 0 {
-					// Set status for all selected jobs
-					var jobsToUpdate []*orchestration.Job
-					for id := range m.Selected {
-						for _, job := range m.Jobs {
-							if job.ID == id {
-								jobsToUpdate = append(jobsToUpdate, job)
-								break
-							}
-						}
-					}
+					// Set status for all selected jobs, including any hidden by a filter
 					return m, tea.Sequence(
-						setMultipleJobStatus(jobsToUpdate, m.Plan, selectedStatus),
+						setMultipleJobStatus(m.selectedPlanJobs(), m.Plan, selectedStatus),
 						refreshPlan(m.PlanDir),
 					)
 				} else if m.Cursor < len(m.Jobs) {
@@ -868,7 +880,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if i, ok := m.columnList.SelectedItem().(columnSelectItem); ok {
 					m.columnVisibility[i.name] = !m.columnVisibility[i.name]
 					// Save state to disk
-					_ = saveState(m.columnVisibility, m.LogSplitVertical)
+					_ = saveState(m.columnVisibility, m.LogSplitVertical, m.SortOrder)
 				}
 				return m, nil
 			default:
@@ -920,7 +932,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Let 'q' and 'ctrl+c' be handled by the main logic to quit.
 			case "?":
 				// Let '?' be handled by the main logic to show help.
-			case "l", "f", "b", "m", "p", "v":
+			case "l", "f", "b", "B", "m", "p", "v":
 				// Let pane switching keys be handled by the main logic.
 			case "tab", "shift+tab":
 				// Let 'tab' and 'shift+tab' be handled by the main logic to switch focus.
@@ -1004,7 +1016,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.ShowLogs {
 				m.LogSplitVertical = !m.LogSplitVertical
 				// Save the new state
-				_ = saveState(m.columnVisibility, m.LogSplitVertical)
+				_ = saveState(m.columnVisibility, m.LogSplitVertical, m.SortOrder)
 
 				// Centralized layout calculation
 				m.updateLayoutDimensions()
@@ -1024,7 +1036,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.frontmatterViewport.SetContent(wrappedContent)
 				}
 				if m.briefingRawContent != "" {
-					styledContent := renderStyledBriefing(m.briefingRawContent)
+					styledContent := m.renderBriefingContent()
 					wrappedContent := wrapContentForViewport(styledContent, m.briefingViewport.Width-1)
 					m.briefingViewport.SetContent(wrappedContent)
 				}
@@ -1067,7 +1079,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.frontmatterViewport.SetContent(wrappedContent)
 				}
 				if m.briefingRawContent != "" {
-					styledContent := renderStyledBriefing(m.briefingRawContent)
+					styledContent := m.renderBriefingContent()
 					wrappedContent := wrapContentForViewport(styledContent, m.briefingViewport.Width-1)
 					m.briefingViewport.SetContent(wrappedContent)
 				}
@@ -1169,6 +1181,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.openDetailPane(FrontmatterPane)
 
 		case key.Matches(msg, m.KeyMap.ViewBriefing):
+			m.briefingShowDiff = false
+			return m.openDetailPane(BriefingPane)
+
+		case key.Matches(msg, m.KeyMap.DiffBriefing):
+			m.briefingShowDiff = true
 			return m.openDetailPane(BriefingPane)
 
 		case key.Matches(msg, m.KeyMap.ViewEdit):
@@ -1190,7 +1207,24 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 
+		case key.Matches(msg, m.KeyMap.Search):
+			ti := textinput.New()
+			ti.Placeholder = "filter by title, id, or status"
+			ti.SetValue(m.SearchQuery)
+			ti.Focus()
+			ti.CharLimit = 100
+			ti.Width = 40
+			m.SearchInput = ti
+			m.Searching = true
+			return m, textinput.Blink
+
 		case key.Matches(msg, m.KeyMap.CloseDetailPane):
+			// With no detail pane open, esc clears an active search filter
+			if m.ActiveDetailPane == NoPane && !m.ShowLogs && m.SearchQuery != "" {
+				m.SearchQuery = ""
+				m.rebuildJobList()
+				return m, nil
+			}
 			m.LogViewer.Stop()
 			m.ShowLogs = false
 			m.LogPaneFullscreen = false
@@ -1251,6 +1285,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						reason = "is already running."
 					case orchestration.JobStatusAbandoned, orchestration.JobStatusHold:
 						reason = "is on hold/abandoned."
+					case orchestration.JobStatusSkipped:
+						reason = "was skipped."
 					case orchestration.JobStatusPending, orchestration.JobStatusBlocked, orchestration.JobStatusFailed:
 						// Since IsRunnable() returned false, it must be because dependencies are not met.
 						reason = "is blocked by unmet dependencies."
@@ -1401,7 +1437,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case key.Matches(msg, m.KeyMap.SetStatus):
-			if m.Cursor < len(m.Jobs) {
+			if m.Cursor < len(m.Jobs) || len(m.Selected) > 0 {
 				m.ShowStatusPicker = true
 				m.StatusPickerCursor = 0
 			}
@@ -1586,6 +1622,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, textinput.Blink
 			}
 
+		case key.Matches(msg, m.KeyMap.CopyPrompt):
+			if m.Cursor >= 0 && m.Cursor < len(m.Jobs) {
+				m.StatusSummary = theme.DefaultTheme.Muted.Render("Assembling prompt...")
+				return m, copyJobPrompt(m.Jobs[m.Cursor], m.Plan)
+			}
+
 		case key.Matches(msg, m.KeyMap.Rename):
 			if m.Cursor >= 0 && m.Cursor < len(m.Jobs) {
 				m.Renaming = true
@@ -1633,6 +1675,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.KeyMap.ToggleSummaries):
 			m.ShowSummaries = !m.ShowSummaries
 
+		case key.Matches(msg, m.KeyMap.CycleSort):
+			m.SortOrder = nextSortOrder(m.SortOrder)
+			m.rebuildJobList()
+			_ = saveState(m.columnVisibility, m.LogSplitVertical, m.SortOrder)
+
+		case key.Matches(msg, m.KeyMap.ToggleRecent):
+			m.SinceFilterActive = !m.SinceFilterActive
+			if m.SinceWindow == 0 {
+				m.SinceWindow = defaultSinceWindow
+			}
+			m.rebuildJobList()
+
 		case key.Matches(msg, m.KeyMap.ToggleColumns):
 			m.columnSelectMode = true
 
@@ -1694,6 +1748,9 @@ func (m Model) reloadActiveDetailPane() (Model, tea.Cmd) {
 	case FrontmatterPane:
 		return m, loadFrontmatterCmd(job)
 	case BriefingPane:
+		if m.briefingShowDiff {
+			return m, loadBriefingDiffCmd(m.Plan, job)
+		}
 		return m, loadBriefingCmd(m.Plan, job)
 	case EditPane:
 		return m, loadJobFileContentCmd(job)
@@ -1741,6 +1798,10 @@ func (m Model) openDetailPane(pane DetailPane) (tea.Model, tea.Cmd) {
 		m.StatusSummary = theme.DefaultTheme.Info.Render(fmt.Sprintf("Loading frontmatter for %s...", job.Title))
 		return m, loadFrontmatterCmd(job)
 	case BriefingPane:
+		if m.briefingShowDiff {
+			m.StatusSummary = theme.DefaultTheme.Info.Render(fmt.Sprintf("Diffing briefings for %s...", job.Title))
+			return m, loadBriefingDiffCmd(m.Plan, job)
+		}
 		m.StatusSummary = theme.DefaultTheme.Info.Render(fmt.Sprintf("Loading briefing for %s...", job.Title))
 		return m, loadBriefingCmd(m.Plan, job)
 	case EditPane:
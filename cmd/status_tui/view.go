@@ -37,6 +37,7 @@ func getStatusStyles() map[orchestration.JobStatus]lipgloss.Style {
 		orchestration.JobStatusHold:      theme.DefaultTheme.Warning,
 		orchestration.JobStatusAbandoned: theme.DefaultTheme.Muted,       // Very subtle for abandoned jobs
 		orchestration.JobStatusIdle:      theme.DefaultTheme.Highlight,   // Agent waiting for next input
+		orchestration.JobStatusSkipped:   theme.DefaultTheme.Muted,       // run_if was false or a dependency was skipped
 		"interrupted":                    theme.DefaultTheme.Magenta,     // Magenta for interrupted jobs
 	}
 }
@@ -217,6 +218,12 @@ func (m Model) renderTableView() string {
 				} else {
 					cell = statusText
 				}
+				if m.StaleJobs[job.ID] {
+					cell += " " + t.Warning.Render("(stale)")
+				}
+				if job.Status == orchestration.JobStatusBlocked && job.BlockedReason != "" {
+					cell += " " + t.Muted.Render("("+job.BlockedReason+")")
+				}
 			case "TEMPLATE":
 				templateText := job.Template
 				if templateText == "" {
@@ -252,10 +259,13 @@ func (m Model) renderTableView() string {
 					cell = t.Muted.Render("-")
 				}
 			case "DURATION":
-				if job.Duration > 0 {
-					cell = t.Muted.Render(formatDuration(job.Duration))
-				} else {
+				elapsed := job.Elapsed(time.Now()).Round(time.Second)
+				if elapsed <= 0 {
 					cell = t.Muted.Render("-")
+				} else if job.Status == orchestration.JobStatusRunning {
+					cell = formatDuration(elapsed)
+				} else {
+					cell = t.Muted.Render(formatDuration(elapsed))
 				}
 			default:
 				cell = t.Muted.Render("?")
@@ -315,6 +325,8 @@ func (m Model) getStatusIcon(status orchestration.JobStatus) string {
 		icon = theme.IconStatusHold
 	case orchestration.JobStatusAbandoned:
 		icon = theme.IconStatusAbandoned
+	case orchestration.JobStatusSkipped:
+		icon = theme.IconArrow
 	case orchestration.JobStatusNeedsReview:
 		icon = theme.IconStatusNeedsReview
 	case orchestration.JobStatusIdle:
@@ -356,7 +368,13 @@ func (m Model) renderStatusPicker() string {
 	var lines []string
 
 	// Add title
-	if m.Cursor < len(m.Jobs) {
+	if len(m.Selected) > 0 {
+		title := lipgloss.NewStyle().
+			Bold(true).
+			Render(fmt.Sprintf("Set Status for %d selected jobs", len(m.Selected)))
+		lines = append(lines, title)
+		lines = append(lines, "")
+	} else if m.Cursor < len(m.Jobs) {
 		job := m.Jobs[m.Cursor]
 		title := lipgloss.NewStyle().
 			Bold(true).
@@ -754,7 +772,7 @@ func renderStyledFrontmatter(rawYAML string) string {
 
 	sections := []section{
 		{title: "Identity", properties: []string{"id", "title", "filename"}},
-		{title: "Execution", properties: []string{"status", "type", "template", "model"}},
+		{title: "Execution", properties: []string{"status", "blocked_reason", "type", "template", "model"}},
 		{title: "Context", properties: []string{"repository", "worktree", "depends_on", "prepend_dependencies", "git_changes"}},
 		{title: "Timestamps", properties: []string{"duration", "completed_at", "updated_at", "created_at"}},
 	}
@@ -1052,6 +1070,40 @@ func styleInlineMarkdown(line string) string {
 	return result
 }
 
+// renderBriefingContent styles the briefing pane's raw content as XML, or as a
+// diff when the pane is showing one.
+func (m Model) renderBriefingContent() string {
+	if m.briefingShowDiff {
+		return renderStyledDiff(m.briefingRawContent)
+	}
+	return renderStyledBriefing(m.briefingRawContent)
+}
+
+// renderStyledDiff colors added, removed, and hunk header lines of a unified diff.
+func renderStyledDiff(rawContent string) string {
+	addStyle := lipgloss.NewStyle().Foreground(theme.DefaultColors.Green)
+	removeStyle := lipgloss.NewStyle().Foreground(theme.DefaultColors.Red)
+	hunkStyle := lipgloss.NewStyle().Foreground(theme.DefaultColors.Cyan)
+
+	var builder strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(rawContent, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			builder.WriteString(theme.DefaultTheme.Bold.Render(line))
+		case strings.HasPrefix(line, "@@"):
+			builder.WriteString(hunkStyle.Render(line))
+		case strings.HasPrefix(line, "+"):
+			builder.WriteString(addStyle.Render(line))
+		case strings.HasPrefix(line, "-"):
+			builder.WriteString(removeStyle.Render(line))
+		default:
+			builder.WriteString(line)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
 // renderStyledBriefing applies syntax highlighting to XML briefing content.
 func renderStyledBriefing(rawContent string) string {
 	// Check if it's XML
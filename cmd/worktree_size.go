@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+)
+
+// worktreeDiskUsage returns the total size of the files under worktreePath.
+// It walks the checkout every time it is called, which is slow for large
+// worktrees, so it is only computed on demand for JSON plan list output, never
+// while loading the plan list.
+func worktreeDiskUsage(worktreePath string) (int64, error) {
+	return dirSize(worktreePath)
+}
+
+// dirSize returns the total size of the regular files under dir, skipping
+// entries that disappear or can't be read while walking.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			return nil
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	return size, err
+}
+
+// formatDiskSize renders a byte count with a binary unit, e.g. "1.5 GiB".
+func formatDiskSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
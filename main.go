@@ -43,6 +43,9 @@ func main() {
 	rootCmd.AddCommand(cmd.GetChatCommand())
 	rootCmd.AddCommand(cmd.NewVersionCmd())
 	rootCmd.AddCommand(cmd.NewModelsCmd())
+	rootCmd.AddCommand(cmd.NewDoctorCmd())
+	rootCmd.AddCommand(cmd.NewTemplateCmd())
+	rootCmd.AddCommand(cmd.NewRecipeCmd())
 	rootCmd.AddCommand(cmd.NewStarshipCmd())
 	rootCmd.AddCommand(cmd.GetRegisterCodexSessionCmd())
 	rootCmd.AddCommand(cmd.GetRegisterOpencodeSessionCmd())
@@ -0,0 +1,80 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/grovetools/grove-anthropic/pkg/anthropic"
+	anthropicconfig "github.com/grovetools/grove-anthropic/pkg/config"
+)
+
+// anthropicMaxTokens is the response token budget for Anthropic requests.
+const anthropicMaxTokens = 64000
+
+// AnthropicLLMClient implements LLMClient by calling the Anthropic messages API
+// directly, so claude-* models do not need an external llm binary.
+type AnthropicLLMClient struct {
+	runner *anthropic.RequestRunner
+}
+
+// NewAnthropicLLMClient creates a client backed by the grove-anthropic request runner.
+func NewAnthropicLLMClient() *AnthropicLLMClient {
+	return &AnthropicLLMClient{runner: anthropic.NewRequestRunner()}
+}
+
+// Complete resolves the API key and sends the prompt with include and context
+// files attached. Requests are tagged with the job and plan for logging. The
+// runner sends files as text, so include files typed as binary are rejected.
+func (c *AnthropicLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, prompt string, opts LLMOptions, output io.Writer) (string, error) {
+	if err := checkInlinableAttachments(opts.Model, opts.AttachmentTypes); err != nil {
+		return "", err
+	}
+	apiKey, err := anthropicconfig.ResolveAPIKey()
+	if err != nil {
+		return "", fmt.Errorf("resolving Anthropic API key: %w", err)
+	}
+
+	caller := "grove-flow-oneshot"
+	var jobID, planName string
+	if job != nil {
+		jobID = job.ID
+		if job.Type == JobTypeChat {
+			caller = "grove-flow-chat"
+		}
+	}
+	if plan != nil {
+		planName = plan.Name
+	}
+
+	var files []string
+	files = append(files, opts.IncludeFiles...)
+	files = append(files, opts.ContextFiles...)
+
+	return c.runner.Run(ctx, anthropic.RequestOptions{
+		Model:        opts.Model,
+		Prompt:       prompt,
+		ContextFiles: files,
+		WorkDir:      opts.WorkingDir,
+		APIKey:       apiKey,
+		MaxTokens:    anthropicMaxTokens,
+		Caller:       caller,
+		JobID:        jobID,
+		PlanName:     planName,
+	})
+}
+
+// RequiresLLMCommand reports whether completing a request for model falls
+// through to the external llm command rather than a native API client.
+func RequiresLLMCommand(model string, plan *Plan) bool {
+	switch {
+	case model == "mock":
+		return false
+	case openAIConfigForPlan(plan).MatchesModel(model):
+		return false
+	case strings.HasPrefix(model, "gemini"), strings.HasPrefix(model, "claude"):
+		return false
+	}
+	return true
+}
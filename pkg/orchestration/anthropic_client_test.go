@@ -0,0 +1,23 @@
+package orchestration
+
+import "testing"
+
+func TestRequiresLLMCommand(t *testing.T) {
+	plan := &Plan{Orchestration: &Config{OpenAI: &OpenAIConfig{ModelPrefix: "local/"}}}
+	tests := []struct {
+		model string
+		want  bool
+	}{
+		{"mock", false},
+		{"claude-sonnet-4-5-20250929", false},
+		{"gemini-2.5-pro", false},
+		{"local/llama3", false},
+		{"openai:gpt-4o", true}, // custom prefix replaces the default
+		{"gpt-4o", true},
+	}
+	for _, tt := range tests {
+		if got := RequiresLLMCommand(tt.model, plan); got != tt.want {
+			t.Errorf("RequiresLLMCommand(%q) = %v, want %v", tt.model, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package orchestration
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Attachment types accepted in the type field of an include entry. A full
+// MIME type such as "image/webp" is accepted as well.
+var attachmentTypeShorthands = map[string]string{
+	"text":  "text/plain",
+	"image": "image/png",
+	"pdf":   "application/pdf",
+	"audio": "audio/mpeg",
+	"video": "video/mp4",
+}
+
+// extractIncludeTypes rewrites typed include entries in frontmatter, written as
+// `{path: diagram.png, type: image}`, to plain paths so they unmarshal into
+// Job.Include, and returns the type hints keyed by path. Plain string entries
+// are left untouched.
+func extractIncludeTypes(frontmatter map[string]interface{}) (map[string]string, error) {
+	includes, ok := frontmatter["include"].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var types map[string]string
+	for i, include := range includes {
+		entry, ok := include.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path, _ := entry["path"].(string)
+		if path == "" {
+			return nil, fmt.Errorf("include entry %d: missing path", i+1)
+		}
+		includes[i] = path
+
+		hint, _ := entry["type"].(string)
+		if hint == "" {
+			continue
+		}
+		if _, err := attachmentMIMEType(path, hint); err != nil {
+			return nil, fmt.Errorf("include entry %s: %w", path, err)
+		}
+		if types == nil {
+			types = make(map[string]string)
+		}
+		types[path] = hint
+	}
+	return types, nil
+}
+
+// includeEntries is the inverse of extractIncludeTypes: it rebuilds an include
+// list for frontmatter, writing entries that have a type hint in types back in
+// their `{path, type}` form.
+func includeEntries(includes []string, types map[string]string) []interface{} {
+	entries := make([]interface{}, len(includes))
+	for i, path := range includes {
+		if hint, ok := types[path]; ok {
+			entries[i] = map[string]interface{}{"path": path, "type": hint}
+		} else {
+			entries[i] = path
+		}
+	}
+	return entries
+}
+
+// attachmentMIMEType resolves a type hint for the file at path to a MIME
+// type. Shorthands use the file extension when it agrees with the hint, so
+// `type: image` on a .jpg yields image/jpeg.
+func attachmentMIMEType(path, hint string) (string, error) {
+	hint = strings.ToLower(strings.TrimSpace(hint))
+	if strings.Contains(hint, "/") {
+		return hint, nil
+	}
+	fallback, ok := attachmentTypeShorthands[hint]
+	if !ok {
+		return "", fmt.Errorf("unknown attachment type %q (use text, image, pdf, audio, video or a MIME type)", hint)
+	}
+	if byExt := mime.TypeByExtension(filepath.Ext(path)); byExt != "" {
+		byExt, _, _ = strings.Cut(byExt, ";")
+		major, _, _ := strings.Cut(fallback, "/")
+		if byExt == fallback || strings.HasPrefix(byExt, major+"/") {
+			return byExt, nil
+		}
+	}
+	return fallback, nil
+}
+
+// attachmentTypes maps the resolved include files among files to the MIME
+// types hinted for them in the job's frontmatter. A resolved path matches an
+// include entry if it is the entry itself or ends with it.
+func attachmentTypes(job *Job, files []string) map[string]string {
+	if job == nil || len(job.IncludeTypes) == 0 {
+		return nil
+	}
+
+	types := make(map[string]string)
+	for _, file := range files {
+		for source, hint := range job.IncludeTypes {
+			clean := filepath.Clean(source)
+			if file != clean && !strings.HasSuffix(file, string(filepath.Separator)+clean) {
+				continue
+			}
+			if mimeType, err := attachmentMIMEType(file, hint); err == nil {
+				types[file] = mimeType
+			}
+			break
+		}
+	}
+	return types
+}
+
+// isBinaryAttachment reports whether a MIME type cannot be inlined into a text
+// prompt.
+func isBinaryAttachment(mimeType string) bool {
+	return mimeType != "" && !strings.HasPrefix(mimeType, "text/") &&
+		mimeType != "application/json" && mimeType != "application/xml"
+}
+
+// splitBinaryAttachments separates the files typed as binary in types from
+// those that can be inlined into a text prompt, keeping their order.
+func splitBinaryAttachments(files []string, types map[string]string) (text, binary []string) {
+	for _, file := range files {
+		if isBinaryAttachment(types[file]) {
+			binary = append(binary, file)
+		} else {
+			text = append(text, file)
+		}
+	}
+	return text, binary
+}
+
+// checkInlinableAttachments returns an error if any include file is typed as
+// binary, for clients that can only send file contents as prompt text.
+func checkInlinableAttachments(model string, types map[string]string) error {
+	for path, mimeType := range types {
+		if isBinaryAttachment(mimeType) {
+			return fmt.Errorf("include %s is typed %s, which model %s cannot receive as inlined text; use a gemini or OpenAI-compatible model for binary attachments", filepath.Base(path), mimeType, model)
+		}
+	}
+	return nil
+}
+
+// stageTypedAttachments returns files with each typed file whose extension
+// does not imply its hinted MIME type replaced by a copy in dir named with a
+// matching extension, since the Gemini runner detects MIME types from the
+// file name.
+func stageTypedAttachments(files []string, types map[string]string, dir string) ([]string, error) {
+	staged := make([]string, len(files))
+	for i, file := range files {
+		staged[i] = file
+		mimeType, ok := types[file]
+		if !ok {
+			continue
+		}
+		byExt, _, _ := strings.Cut(mime.TypeByExtension(filepath.Ext(file)), ";")
+		if byExt == mimeType {
+			continue
+		}
+		ext := attachmentExtension(mimeType)
+		if ext == "" {
+			return nil, fmt.Errorf("include %s is typed %s, which has no known file extension to upload it with", filepath.Base(file), mimeType)
+		}
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		copyPath := filepath.Join(dir, fmt.Sprintf("%d-%s%s", i, name, ext))
+		if err := copyFile(file, copyPath); err != nil {
+			return nil, fmt.Errorf("staging include %s: %w", filepath.Base(file), err)
+		}
+		staged[i] = copyPath
+	}
+	return staged, nil
+}
+
+// attachmentExtension returns the usual file extension for mimeType, or "" if
+// none is known.
+func attachmentExtension(mimeType string) string {
+	switch mimeType {
+	case "image/jpeg":
+		return ".jpg"
+	case "audio/mpeg":
+		return ".mp3"
+	}
+	exts, _ := mime.ExtensionsByType(mimeType)
+	if len(exts) == 0 {
+		return ""
+	}
+	return exts[0]
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
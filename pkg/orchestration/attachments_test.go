@@ -0,0 +1,172 @@
+package orchestration
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestAttachmentMIMEType(t *testing.T) {
+	tests := []struct {
+		path, hint, want string
+	}{
+		{"diagram.png", "image", "image/png"},
+		{"photo.jpg", "image", "image/jpeg"},
+		{"spec.pdf", "pdf", "application/pdf"},
+		{"notes.txt", "text", "text/plain"},
+		{"diagram.bin", "image", "image/png"},
+		{"scan.webp", "image/webp", "image/webp"},
+	}
+	for _, tt := range tests {
+		got, err := attachmentMIMEType(tt.path, tt.hint)
+		if err != nil {
+			t.Errorf("attachmentMIMEType(%q, %q): %v", tt.path, tt.hint, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("attachmentMIMEType(%q, %q) = %q, want %q", tt.path, tt.hint, got, tt.want)
+		}
+	}
+
+	if _, err := attachmentMIMEType("x.png", "picture"); err == nil {
+		t.Error("expected an error for an unknown type")
+	}
+}
+
+func TestLoadJobTypedIncludes(t *testing.T) {
+	dir := t.TempDir()
+	jobPath := filepath.Join(dir, "01-review.md")
+	content := `---
+id: review
+title: Review
+status: pending
+type: oneshot
+include:
+  - README.md
+  - path: docs/diagram.png
+    type: image
+  - path: spec.pdf
+---
+Review the design.
+`
+	if err := os.WriteFile(jobPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	job, err := LoadJob(jobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"README.md", "docs/diagram.png", "spec.pdf"}
+	if strings.Join(job.Include, ",") != strings.Join(want, ",") {
+		t.Errorf("Include = %v, want %v", job.Include, want)
+	}
+	if len(job.IncludeTypes) != 1 || job.IncludeTypes["docs/diagram.png"] != "image" {
+		t.Errorf("IncludeTypes = %v", job.IncludeTypes)
+	}
+
+	resolved := []string{"/repo/README.md", "/repo/docs/diagram.png", "/repo/spec.pdf"}
+	types := attachmentTypes(job, resolved)
+	if len(types) != 1 || types["/repo/docs/diagram.png"] != "image/png" {
+		t.Errorf("attachmentTypes = %v", types)
+	}
+	if err := checkInlinableAttachments("claude-sonnet", types); err == nil {
+		t.Error("expected binary attachments to be rejected for inlining clients")
+	}
+
+	bad := strings.Replace(content, "type: image", "type: picture", 1)
+	if err := os.WriteFile(jobPath, []byte(bad), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadJob(jobPath); err == nil {
+		t.Error("expected an error for an unknown include type")
+	}
+}
+
+func TestStageTypedAttachments(t *testing.T) {
+	dir := t.TempDir()
+	png := filepath.Join(dir, "diagram.png")
+	scan := filepath.Join(dir, "scan.bin")
+	notes := filepath.Join(dir, "notes.md")
+	for _, path := range []string{png, scan, notes} {
+		if err := os.WriteFile(path, []byte(filepath.Base(path)), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	types := map[string]string{png: "image/png", scan: "application/pdf"}
+
+	stagingDir := t.TempDir()
+	got, err := stageTypedAttachments([]string{png, scan, notes}, types, stagingDir)
+	if err != nil {
+		t.Fatalf("stageTypedAttachments() error = %v", err)
+	}
+	// Files whose extension already matches are uploaded in place
+	if got[0] != png || got[2] != notes {
+		t.Errorf("staged = %v, want %s and %s unchanged", got, png, notes)
+	}
+	if want := filepath.Join(stagingDir, "1-scan.pdf"); got[1] != want {
+		t.Errorf("staged scan.bin = %s, want %s", got[1], want)
+	}
+	if content, err := os.ReadFile(got[1]); err != nil || string(content) != "scan.bin" {
+		t.Errorf("staged copy content = %q, %v", content, err)
+	}
+}
+
+func TestCommandLLMClientTypedAttachments(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the grove binary")
+	}
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	// A stand-in grove binary that records its arguments and stdin
+	binDir := filepath.Join(dir, "bin")
+	if err := os.Mkdir(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	script := "#!/bin/sh\nprintf '%s\\n' \"$@\" > \"$GROVE_TEST_DIR/args\"\ncat > \"$GROVE_TEST_DIR/stdin\"\necho ok\n"
+	if err := os.WriteFile(filepath.Join(binDir, "grove"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("GROVE_TEST_DIR", dir)
+
+	diagram := filepath.Join(dir, "diagram.png")
+	readme := filepath.Join(dir, "README.md")
+	if err := os.WriteFile(diagram, []byte("\x89PNG"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(readme, []byte("readme body"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewCommandLLMClient(nil)
+	opts := LLMOptions{
+		Model:           "gpt-4o",
+		IncludeFiles:    []string{readme, diagram},
+		AttachmentTypes: map[string]string{diagram: "image/png"},
+	}
+	plan := &Plan{Name: "plan", Directory: dir}
+	if _, err := client.Complete(context.Background(), &Job{ID: "job-1"}, plan, "describe it", opts, io.Discard); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+
+	args, err := os.ReadFile(filepath.Join(dir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(args), "--at\n"+diagram+"\nimage/png\n") {
+		t.Errorf("args do not attach the image with its type:\n%s", args)
+	}
+	stdin, err := os.ReadFile(filepath.Join(dir, "stdin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(stdin), "readme body") || strings.Contains(string(stdin), "diagram.png") {
+		t.Errorf("prompt should inline README.md only:\n%s", stdin)
+	}
+}
@@ -0,0 +1,101 @@
+package orchestration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditLogFile is the per-plan, append-only record of LLM requests, relative to
+// the plan directory.
+const auditLogFile = ".grove/audit.jsonl"
+
+// AuditEntry is one line of a plan's audit log: a single LLM request made for
+// a oneshot job or chat turn.
+type AuditEntry struct {
+	Timestamp      time.Time `json:"timestamp"`
+	JobID          string    `json:"job_id"`
+	RequestID      string    `json:"request_id,omitempty"`
+	Model          string    `json:"model"`
+	PromptSHA256   string    `json:"prompt_sha256"`
+	ResponseLength int       `json:"response_length"`
+	DurationMs     int64     `json:"duration_ms"`
+	Success        bool      `json:"success"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// auditMu serializes appends so concurrent jobs don't interleave lines.
+var auditMu sync.Mutex
+
+// AuditLogPath returns the audit log path for the plan in planDir.
+func AuditLogPath(planDir string) string {
+	return filepath.Join(planDir, auditLogFile)
+}
+
+// appendAuditEntry appends entry as a JSON line to the plan's audit log.
+func appendAuditEntry(planDir string, entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshaling audit entry: %w", err)
+	}
+
+	auditMu.Lock()
+	defer auditMu.Unlock()
+
+	path := AuditLogPath(planDir)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating audit log directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing audit log: %w", err)
+	}
+	return nil
+}
+
+// recordLLMAudit logs one LLM request that started at start to the plan's
+// audit log. An empty or whitespace-only response is recorded as a failure.
+// Failing to write the log only warns; it never fails the job.
+func recordLLMAudit(ctx context.Context, job *Job, plan *Plan, model, prompt string, start time.Time, response string, callErr error) {
+	if plan == nil || plan.Directory == "" {
+		return
+	}
+	if callErr == nil && strings.TrimSpace(response) == "" {
+		callErr = errEmptyResponse
+	}
+	requestID, _ := ctx.Value("request_id").(string)
+	if requestID == "" {
+		requestID = os.Getenv("GROVE_REQUEST_ID")
+	}
+	sum := sha256.Sum256([]byte(prompt))
+	entry := AuditEntry{
+		Timestamp:      start,
+		JobID:          job.ID,
+		RequestID:      requestID,
+		Model:          model,
+		PromptSHA256:   hex.EncodeToString(sum[:]),
+		ResponseLength: len(response),
+		DurationMs:     time.Since(start).Milliseconds(),
+		Success:        callErr == nil,
+	}
+	if callErr != nil {
+		entry.Error = redactSecrets(plan, callErr.Error())
+	}
+	if err := appendAuditEntry(plan.Directory, entry); err != nil {
+		ulog.Warn("Failed to write audit log").
+			Err(err).
+			Field("job_id", job.ID).
+			Log(ctx)
+	}
+}
@@ -0,0 +1,55 @@
+package orchestration
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestRecordLLMAudit(t *testing.T) {
+	dir := t.TempDir()
+	plan := &Plan{Directory: dir}
+	job := &Job{ID: "review"}
+	ctx := context.WithValue(context.Background(), "request_id", "req-1234")
+
+	recordLLMAudit(ctx, job, plan, "gemini-2.5-pro", "prompt", time.Now(), "response", nil)
+	recordLLMAudit(ctx, job, plan, "gemini-2.5-pro", "prompt", time.Now(), "", errors.New("rate limited"))
+	recordLLMAudit(ctx, job, plan, "gemini-2.5-pro", "prompt", time.Now(), "  \n", nil)
+
+	f, err := os.Open(AuditLogPath(dir))
+	if err != nil {
+		t.Fatalf("audit log not written: %v", err)
+	}
+	defer f.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid audit line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d audit entries, want 3", len(entries))
+	}
+
+	ok := entries[0]
+	if !ok.Success || ok.JobID != "review" || ok.RequestID != "req-1234" || ok.Model != "gemini-2.5-pro" || ok.ResponseLength != len("response") {
+		t.Errorf("unexpected success entry: %+v", ok)
+	}
+	if len(ok.PromptSHA256) != 64 {
+		t.Errorf("PromptSHA256 = %q, want a hex SHA-256", ok.PromptSHA256)
+	}
+	if failed := entries[1]; failed.Success || failed.Error != "rate limited" || failed.PromptSHA256 != ok.PromptSHA256 {
+		t.Errorf("unexpected failure entry: %+v", failed)
+	}
+	if empty := entries[2]; empty.Success || empty.Error != errEmptyResponse.Error() {
+		t.Errorf("empty response should be recorded as a failure: %+v", empty)
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
 )
 
 // countLines efficiently counts the number of lines in a file.
@@ -46,19 +49,77 @@ func WriteBriefingFile(plan *Plan, job *Job, content string, turnID string) (str
 		// For chat jobs, use the turn UUID for deterministic naming
 		briefingFilename = fmt.Sprintf("briefing-%s.xml", turnID)
 	} else {
-		// For oneshot/interactive jobs, use timestamp
-		briefingFilename = fmt.Sprintf("briefing-%d.xml", time.Now().Unix())
+		// For oneshot/interactive jobs, use the run number and timestamp so
+		// successive runs sort in order and can be diffed
+		existing, err := BriefingFiles(plan, job)
+		if err != nil {
+			return "", err
+		}
+		briefingFilename = fmt.Sprintf("briefing-%03d-%d.xml", len(existing)+1, time.Now().Unix())
 	}
 	briefingFilePath := filepath.Join(jobArtifactDir, briefingFilename)
 
-	// Write the file
-	if err := os.WriteFile(briefingFilePath, []byte(content), 0644); err != nil {
+	// Record the generated context's stats at the top, then write the file
+	// with secrets redacted if configured
+	content = contextStatsComment(job.ContextStats) + content
+	if err := os.WriteFile(briefingFilePath, []byte(redactSecrets(plan, content)), 0644); err != nil {
 		return "", fmt.Errorf("writing briefing file: %w", err)
 	}
 
 	return briefingFilePath, nil
 }
 
+// BriefingFiles returns the paths of the briefing files saved for job, oldest
+// first.
+func BriefingFiles(plan *Plan, job *Job) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(plan.Directory, ".artifacts", job.ID, "briefing-*.xml"))
+	if err != nil {
+		return nil, fmt.Errorf("finding briefing files: %w", err)
+	}
+	modTimes := make(map[string]time.Time, len(files))
+	for _, file := range files {
+		if info, err := os.Stat(file); err == nil {
+			modTimes[file] = info.ModTime()
+		}
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		ti, tj := modTimes[files[i]], modTimes[files[j]]
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return files[i] < files[j]
+	})
+	return files, nil
+}
+
+// DiffLastBriefings returns a unified diff of the job's two most recent
+// briefing files, or "" if they are identical.
+func DiffLastBriefings(plan *Plan, job *Job) (string, error) {
+	files, err := BriefingFiles(plan, job)
+	if err != nil {
+		return "", err
+	}
+	if len(files) < 2 {
+		return "", fmt.Errorf("job %s has %d briefing file(s); at least two runs are needed to diff", job.ID, len(files))
+	}
+	previous, latest := files[len(files)-2], files[len(files)-1]
+	a, err := os.ReadFile(previous)
+	if err != nil {
+		return "", fmt.Errorf("reading briefing file: %w", err)
+	}
+	b, err := os.ReadFile(latest)
+	if err != nil {
+		return "", fmt.Errorf("reading briefing file: %w", err)
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(a)),
+		B:        difflib.SplitLines(string(b)),
+		FromFile: filepath.Base(previous),
+		ToFile:   filepath.Base(latest),
+		Context:  3,
+	})
+}
+
 // BuildXMLPrompt assembles a structured XML prompt for oneshot and interactive_agent jobs.
 // It returns the final XML string and a list of file paths that should be uploaded separately.
 // contextFiles should include paths to .grove/context, CLAUDE.md, and other project context files.
@@ -66,6 +127,10 @@ func BuildXMLPrompt(job *Job, plan *Plan, workDir string, contextFiles []string)
 	var b strings.Builder
 	filesToUpload = []string{}
 
+	// Variables are expanded in the job's own text only, before anything else is
+	// assembled; dependency, include, and context content is added verbatim.
+	vars := newPromptVarLookup(job, plan, workDir)
+
 	b.WriteString("<prompt>\n")
 
 	// 1. Add system instructions from the job's template, if available.
@@ -76,7 +141,7 @@ func BuildXMLPrompt(job *Job, plan *Plan, workDir string, contextFiles []string)
 			return "", nil, fmt.Errorf("resolving template %s: %w", job.Template, err)
 		}
 		b.WriteString(fmt.Sprintf("    <system_instructions template=\"%s\">\n", job.Template))
-		b.WriteString(template.Prompt)
+		b.WriteString(expandPromptVars(context.Background(), template.Prompt, vars, job))
 		b.WriteString("\n    </system_instructions>\n")
 	}
 
@@ -113,8 +178,9 @@ func BuildXMLPrompt(job *Job, plan *Plan, workDir string, contextFiles []string)
 					return "", nil, fmt.Errorf("reading dependency file %s: %w", dep.FilePath, err)
 				}
 				_, depBody, _ := ParseFrontmatter(depContent)
-				b.WriteString(fmt.Sprintf("        <prepended_dependency file=\"%s\">\n", dep.Filename))
-				b.WriteString(string(depBody))
+				body, attrs := limitDependencyBody(job, plan, dep, string(depBody))
+				b.WriteString(fmt.Sprintf("        <prepended_dependency file=\"%s\"%s>\n", dep.Filename, attrs))
+				b.WriteString(body)
 				b.WriteString("\n        </prepended_dependency>\n")
 			} else {
 				// Use different tags based on job type
@@ -206,7 +272,7 @@ func BuildXMLPrompt(job *Job, plan *Plan, workDir string, contextFiles []string)
 	// 6. Add the main task from the job's prompt body.
 	if strings.TrimSpace(job.PromptBody) != "" {
 		b.WriteString("\n    <user_request priority=\"high\">\n")
-		b.WriteString(job.PromptBody)
+		b.WriteString(wrapUserRequest(plan, expandPromptVars(context.Background(), job.PromptBody, vars, job)))
 		b.WriteString("\n    </user_request>\n")
 	}
 
@@ -215,6 +281,31 @@ func BuildXMLPrompt(job *Job, plan *Plan, workDir string, contextFiles []string)
 	return b.String(), filesToUpload, nil
 }
 
+// wrapUserRequest places the run-wide prompt prefix and suffix given to
+// `flow plan run` around a job's request. Each is tagged as a run_instruction
+// so the briefing file records that it was added for this run and is not part
+// of the job file.
+func wrapUserRequest(plan *Plan, request string) string {
+	if plan == nil || plan.Orchestration == nil {
+		return request
+	}
+	prefix := strings.TrimSpace(plan.Orchestration.PromptPrefix)
+	suffix := strings.TrimSpace(plan.Orchestration.PromptSuffix)
+	if prefix == "" && suffix == "" {
+		return request
+	}
+
+	var b strings.Builder
+	if prefix != "" {
+		b.WriteString(fmt.Sprintf("<run_instruction position=\"prefix\" source=\"--prompt-prefix\">\n%s\n</run_instruction>\n", prefix))
+	}
+	b.WriteString(request)
+	if suffix != "" {
+		b.WriteString(fmt.Sprintf("\n<run_instruction position=\"suffix\" source=\"--prompt-suffix\">\n%s\n</run_instruction>", suffix))
+	}
+	return b.String()
+}
+
 // resolveSourceBlock reads and extracts content from a source_block reference
 func resolveSourceBlock(sourceBlock string, plan *Plan) (string, error) {
 	parts := strings.SplitN(sourceBlock, "#", 2)
@@ -0,0 +1,99 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiffLastBriefings(t *testing.T) {
+	plan := &Plan{Directory: t.TempDir()}
+	job := &Job{ID: "review-1234"}
+
+	if _, err := WriteBriefingFile(plan, job, "<prompt>\nkeep\nold line\n</prompt>\n", ""); err != nil {
+		t.Fatalf("WriteBriefingFile: %v", err)
+	}
+	if _, err := DiffLastBriefings(plan, job); err == nil {
+		t.Error("expected an error with only one briefing file")
+	}
+
+	second, err := WriteBriefingFile(plan, job, "<prompt>\nkeep\nnew line\n</prompt>\n", "")
+	if err != nil {
+		t.Fatalf("WriteBriefingFile: %v", err)
+	}
+	if !strings.HasPrefix(filepath.Base(second), "briefing-002-") {
+		t.Errorf("second briefing = %s, want run index 002", filepath.Base(second))
+	}
+
+	files, err := BriefingFiles(plan, job)
+	if err != nil {
+		t.Fatalf("BriefingFiles: %v", err)
+	}
+	if len(files) != 2 || files[1] != second {
+		t.Fatalf("BriefingFiles = %v, want the second run last", files)
+	}
+
+	diff, err := DiffLastBriefings(plan, job)
+	if err != nil {
+		t.Fatalf("DiffLastBriefings: %v", err)
+	}
+	for _, want := range []string{"--- briefing-001-", "+++ briefing-002-", "-old line\n", "+new line\n", " keep\n"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diff missing %q:\n%s", want, diff)
+		}
+	}
+}
+
+func TestBuildXMLPromptRunInstructions(t *testing.T) {
+	plan := &Plan{Directory: t.TempDir(), Orchestration: &Config{}}
+	job := &Job{ID: "review", Type: JobTypeOneshot, PromptBody: "Review the design."}
+
+	prompt, _, err := BuildXMLPrompt(job, plan, t.TempDir(), nil)
+	if err != nil {
+		t.Fatalf("BuildXMLPrompt: %v", err)
+	}
+	if strings.Contains(prompt, "run_instruction") {
+		t.Errorf("prompt has run instructions without a prefix or suffix:\n%s", prompt)
+	}
+
+	plan.Orchestration.PromptPrefix = "Respond in French."
+	plan.Orchestration.PromptSuffix = "Be terse."
+	prompt, _, err = BuildXMLPrompt(job, plan, t.TempDir(), nil)
+	if err != nil {
+		t.Fatalf("BuildXMLPrompt: %v", err)
+	}
+	prefix := strings.Index(prompt, "<run_instruction position=\"prefix\" source=\"--prompt-prefix\">\nRespond in French.\n</run_instruction>")
+	body := strings.Index(prompt, "Review the design.")
+	suffix := strings.Index(prompt, "<run_instruction position=\"suffix\" source=\"--prompt-suffix\">\nBe terse.\n</run_instruction>")
+	if prefix < 0 || body < 0 || suffix < 0 || !(prefix < body && body < suffix) {
+		t.Errorf("expected prefix, request and suffix in order:\n%s", prompt)
+	}
+	if end := strings.Index(prompt, "</user_request>"); end < suffix {
+		t.Errorf("run instructions should be inside user_request:\n%s", prompt)
+	}
+}
+
+func TestWriteBriefingFileContextStats(t *testing.T) {
+	plan := &Plan{Directory: t.TempDir()}
+	job := &Job{ID: "review"}
+
+	path, err := WriteBriefingFile(plan, job, "<prompt>\n</prompt>\n", "")
+	if err != nil {
+		t.Fatalf("WriteBriefingFile: %v", err)
+	}
+	if data, _ := os.ReadFile(path); !strings.HasPrefix(string(data), "<prompt>") {
+		t.Errorf("briefing without generated context should start with the prompt:\n%s", data)
+	}
+
+	job.ContextStats = &ContextStats{Files: 42, Tokens: 12345, Languages: []string{"Go: 81.2%", "Markdown: 9.5%"}}
+	path, err = WriteBriefingFile(plan, job, "<prompt>\n</prompt>\n", "")
+	if err != nil {
+		t.Fatalf("WriteBriefingFile: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	want := "<!-- context_files: 42, context_tokens: 12345, languages: Go: 81.2%, Markdown: 9.5% -->\n<prompt>"
+	if !strings.HasPrefix(string(data), want) {
+		t.Errorf("briefing = %q, want prefix %q", data, want)
+	}
+}
@@ -1,6 +1,10 @@
 package orchestration
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -14,10 +18,52 @@ type ChatTurn struct {
 
 // ChatDirective represents the JSON payload in the user's comment
 type ChatDirective struct {
-	ID       string                 `json:"id,omitempty"`
-	Template string                 `json:"template,omitempty"`
-	Model    string                 `json:"model,omitempty"`
-	Type     string                 `json:"type,omitempty"`     // Job type override for this turn
-	Action   string                 `json:"action,omitempty"`
-	Vars     map[string]interface{} `json:"vars,omitempty"`
+	ID           string                 `json:"id,omitempty"`
+	Template     string                 `json:"template,omitempty"`
+	TemplateFile string                 `json:"template_file,omitempty"` // One-off template path for this turn; overrides Template
+	Model        string                 `json:"model,omitempty"`
+	Type         string                 `json:"type,omitempty"` // Job type override for this turn
+	Action       string                 `json:"action,omitempty"`
+	Vars         map[string]interface{} `json:"vars,omitempty"`
+}
+
+// resolveDirectiveTemplate loads the template for a chat turn. A template_file
+// is read directly from disk, resolved relative to the chat file's directory
+// and then the project root; otherwise the named template is looked up.
+func resolveDirectiveTemplate(directive *ChatDirective, job *Job, plan *Plan) (*JobTemplate, error) {
+	templateManager := NewTemplateManager()
+	if directive.TemplateFile == "" {
+		template, err := templateManager.FindTemplate(directive.Template)
+		if err != nil {
+			return nil, fmt.Errorf("resolving template %s: %w", directive.Template, err)
+		}
+		return template, nil
+	}
+
+	path := directive.TemplateFile
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[2:])
+		}
+	}
+	if !filepath.IsAbs(path) {
+		candidates := []string{filepath.Join(filepath.Dir(job.FilePath), path)}
+		if plan != nil {
+			candidates = append(candidates, filepath.Join(GetProjectRootSafe(plan.Directory), path))
+		}
+		path = candidates[0]
+		for _, candidate := range candidates {
+			if _, err := os.Stat(candidate); err == nil {
+				path = candidate
+				break
+			}
+		}
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	template, err := templateManager.LoadTemplate(path, name, "file")
+	if err != nil {
+		return nil, fmt.Errorf("loading template file %s: %w", directive.TemplateFile, err)
+	}
+	return templateManager.ResolveInheritance(template)
 }
\ No newline at end of file
@@ -0,0 +1,128 @@
+package orchestration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var llmResponseTimestampRegex = regexp.MustCompile(`## LLM Response \((\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2})\)`)
+
+// TranscriptTurn is one turn of an exported chat.
+type TranscriptTurn struct {
+	Role      string `json:"role"` // "user" or "assistant"
+	Content   string `json:"content"`
+	Timestamp string `json:"timestamp,omitempty"`
+	Model     string `json:"model,omitempty"`
+	Template  string `json:"template,omitempty"`
+}
+
+// ChatTranscript is a chat job with the grove control comments removed.
+type ChatTranscript struct {
+	Title string           `json:"title"`
+	Turns []TranscriptTurn `json:"turns"`
+}
+
+// BuildChatTranscript parses a chat job's file content into alternating user
+// and assistant turns. Each assistant turn records the model it was generated
+// with: the preceding user turn's model directive, or the chat's model.
+func BuildChatTranscript(job *Job, content []byte) (*ChatTranscript, error) {
+	turns, err := ParseChatFile(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing chat: %w", err)
+	}
+
+	transcript := &ChatTranscript{Title: job.Title}
+	var pendingModel, pendingTemplate string
+	for _, turn := range turns {
+		if turn.Directive != nil {
+			if state, ok := turn.Directive.Vars["state"].(string); ok && (state == "running" || state == "pending") {
+				continue
+			}
+		}
+
+		if turn.Speaker == "user" {
+			pendingModel, pendingTemplate = "", ""
+			if turn.Directive != nil {
+				pendingModel = turn.Directive.Model
+				pendingTemplate = turn.Directive.Template
+			}
+			if text := cleanTurnContent(turn.Content); text != "" {
+				transcript.Turns = append(transcript.Turns, TranscriptTurn{Role: "user", Content: text})
+			}
+			continue
+		}
+
+		entry := TranscriptTurn{
+			Role:     "assistant",
+			Model:    pendingModel,
+			Template: pendingTemplate,
+		}
+		if entry.Model == "" {
+			entry.Model = job.Model
+		}
+		if matches := llmResponseTimestampRegex.FindStringSubmatch(turn.Content); len(matches) > 1 {
+			entry.Timestamp = matches[1]
+		}
+		entry.Content = cleanTurnContent(turn.Content)
+		transcript.Turns = append(transcript.Turns, entry)
+	}
+	return transcript, nil
+}
+
+// Markdown renders the transcript as a markdown document.
+func (t *ChatTranscript) Markdown() string {
+	var b strings.Builder
+	if t.Title != "" {
+		fmt.Fprintf(&b, "# %s\n", t.Title)
+	}
+	for _, turn := range t.Turns {
+		heading := "User"
+		if turn.Role == "assistant" {
+			heading = "Assistant"
+			var details []string
+			if turn.Model != "" {
+				details = append(details, turn.Model)
+			}
+			if turn.Timestamp != "" {
+				details = append(details, turn.Timestamp)
+			}
+			if len(details) > 0 {
+				heading += " (" + strings.Join(details, ", ") + ")"
+			}
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n%s\n", heading, turn.Content)
+	}
+	return b.String()
+}
+
+// TruncateChatAtTurn returns the chat file content up to and including the LLM
+// response with the given turn ID, followed by an empty user turn so the chat
+// can be continued from that point. Frontmatter is kept unchanged.
+func TruncateChatAtTurn(content []byte, turnID string) ([]byte, error) {
+	matches := groveDirectiveRegex.FindAllSubmatchIndex(content, -1)
+	for i, m := range matches {
+		var directive ChatDirective
+		if err := json.Unmarshal(content[m[2]:m[3]], &directive); err != nil || directive.ID != turnID {
+			continue
+		}
+
+		end := len(content)
+		template := "chat"
+		if i+1 < len(matches) {
+			next := matches[i+1]
+			end = next[0]
+			var nextDirective ChatDirective
+			if err := json.Unmarshal(content[next[2]:next[3]], &nextDirective); err == nil && nextDirective.Template != "" {
+				template = nextDirective.Template
+			}
+		}
+
+		truncated := append([]byte{}, bytes.TrimRight(content[:end], "\n")...)
+		truncated = append(truncated, fmt.Sprintf("\n\n<!-- grove: {\"template\": \"%s\"} -->\n", template)...)
+		return truncated, nil
+	}
+	return nil, fmt.Errorf("turn '%s' not found in chat", turnID)
+}
@@ -0,0 +1,85 @@
+package orchestration
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildChatTranscript(t *testing.T) {
+	content := "---\n" +
+		"id: chat\n" +
+		"title: API Design\n" +
+		"type: chat\n" +
+		"model: gemini-2.5-flash\n" +
+		"---\n\n" +
+		"<!-- grove: {\"template\": \"chat\"} -->\n" +
+		"How should I structure the API?\n\n" +
+		"<!-- grove: {\"id\": \"abc123\"} -->\n" +
+		"## LLM Response (2024-01-15 10:30:00)\n\n" +
+		"Use resources:\n\n```go\n// <!-- not a directive -->\nfunc main() {}\n```\n\n" +
+		"<!-- grove: {\"template\": \"chat\", \"model\": \"claude-sonnet-4\"} -->\n" +
+		"What about auth?\n\n" +
+		"<!-- grove: {\"id\": \"def456\"} -->\n" +
+		"## LLM Response (2024-01-15 10:35:00)\n\n" +
+		"Use JWT.\n\n" +
+		"<!-- grove: {\"template\": \"chat\"} -->\n"
+
+	job := &Job{Title: "API Design", Model: "gemini-2.5-flash"}
+	transcript, err := BuildChatTranscript(job, []byte(content))
+	if err != nil {
+		t.Fatalf("BuildChatTranscript() error = %v", err)
+	}
+
+	if len(transcript.Turns) != 4 {
+		t.Fatalf("got %d turns, want 4 (empty trailing user turn dropped): %+v", len(transcript.Turns), transcript.Turns)
+	}
+	first := transcript.Turns[1]
+	if first.Role != "assistant" || first.Model != "gemini-2.5-flash" || first.Timestamp != "2024-01-15 10:30:00" {
+		t.Errorf("first response = %+v", first)
+	}
+	if !strings.Contains(first.Content, "```go\n// <!-- not a directive -->\nfunc main() {}\n```") {
+		t.Errorf("code block not preserved:\n%s", first.Content)
+	}
+	if strings.Contains(first.Content, "LLM Response") {
+		t.Errorf("response header not stripped:\n%s", first.Content)
+	}
+	if second := transcript.Turns[3]; second.Model != "claude-sonnet-4" {
+		t.Errorf("second response model = %q, want the directive's model", second.Model)
+	}
+
+	md := transcript.Markdown()
+	if strings.Contains(md, "<!-- grove:") {
+		t.Errorf("markdown still contains grove comments:\n%s", md)
+	}
+	if !strings.Contains(md, "## Assistant (claude-sonnet-4, 2024-01-15 10:35:00)") {
+		t.Errorf("markdown missing assistant heading:\n%s", md)
+	}
+}
+
+func TestTruncateChatAtTurn(t *testing.T) {
+	content := "---\nid: chat\ntype: chat\n---\n\n" +
+		"<!-- grove: {\"template\": \"chat\"} -->\nFirst question\n\n" +
+		"<!-- grove: {\"id\": \"t1\"} -->\n## LLM Response (2024-01-15 10:30:00)\n\nFirst answer\n\n" +
+		"<!-- grove: {\"template\": \"chef\"} -->\nSecond question\n\n" +
+		"<!-- grove: {\"id\": \"t2\"} -->\n## LLM Response (2024-01-15 10:35:00)\n\nSecond answer\n\n" +
+		"<!-- grove: {\"template\": \"chat\"} -->\n"
+
+	truncated, err := TruncateChatAtTurn([]byte(content), "t1")
+	if err != nil {
+		t.Fatalf("TruncateChatAtTurn() error = %v", err)
+	}
+	got := string(truncated)
+	if !strings.Contains(got, "First answer") || strings.Contains(got, "Second question") {
+		t.Errorf("wrong content kept:\n%s", got)
+	}
+	if !strings.HasSuffix(got, "First answer\n\n<!-- grove: {\"template\": \"chef\"} -->\n") {
+		t.Errorf("expected an empty user turn using the next turn's template:\n%s", got)
+	}
+	if !strings.HasPrefix(got, "---\nid: chat\n") {
+		t.Errorf("frontmatter not preserved:\n%s", got)
+	}
+
+	if _, err := TruncateChatAtTurn([]byte(content), "missing"); err == nil {
+		t.Error("expected an error for an unknown turn ID")
+	}
+}
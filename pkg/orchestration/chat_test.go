@@ -0,0 +1,42 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveDirectiveTemplate_TemplateFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "one-off.md"), []byte("---\ndescription: One-off\n---\nBe terse.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	job := &Job{ID: "chat", FilePath: filepath.Join(dir, "01-chat.md")}
+
+	template, err := resolveDirectiveTemplate(&ChatDirective{Template: "chat", TemplateFile: "one-off.md"}, job, &Plan{Directory: dir})
+	if err != nil {
+		t.Fatalf("resolveDirectiveTemplate() error = %v", err)
+	}
+	if template.Name != "one-off" || template.Prompt != "Be terse.\n" {
+		t.Errorf("got template %q with prompt %q", template.Name, template.Prompt)
+	}
+
+	if _, err := resolveDirectiveTemplate(&ChatDirective{TemplateFile: "missing.md"}, job, &Plan{Directory: dir}); err == nil {
+		t.Error("expected an error for a missing template file")
+	}
+}
+
+func TestLastResponseCompletesChat(t *testing.T) {
+	base := "---\ntype: chat\n---\n\n<!-- grove: {\"template\": \"chat\"} -->\nPlan the work.\n\n" +
+		"<!-- grove: {\"id\": \"abc\"} -->\n## LLM Response (2024-01-15 10:30:00)\n\n"
+
+	done := base + "All steps are finished.\n<!-- grove: {\"action\": \"complete\"} -->\n\n<!-- grove: {\"template\": \"chat\"} -->\n"
+	if !lastResponseCompletesChat([]byte(done)) {
+		t.Error("expected a complete directive in the last response to end the chat")
+	}
+
+	ongoing := base + "Step one is done.\n\n<!-- grove: {\"template\": \"chat\"} -->\n"
+	if lastResponseCompletesChat([]byte(ongoing)) {
+		t.Error("a response without a complete directive should not end the chat")
+	}
+}
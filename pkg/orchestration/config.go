@@ -1,9 +1,21 @@
 package orchestration
 
+import "time"
+
 // Config holds orchestration-specific settings, decoupled from grove-core.
 type Config struct {
 	OneshotModel         string
 	TargetAgentContainer string
 	PlansDirectory       string
 	MaxConsecutiveSteps  int
-}
\ No newline at end of file
+	Timeout              time.Duration // Global default LLM timeout for oneshot jobs
+	RetryCount           *int          // Global default LLM retry count for oneshot jobs
+	OpenAI               *OpenAIConfig // OpenAI-compatible endpoint for prefixed models
+	FetchTimeout         time.Duration // Timeout for downloading URL prompt sources
+	Offline              bool          // Fail instead of downloading URL prompt sources
+	NoFetch              bool          // Resolve URL prompt sources to an earlier download, or the URL itself, without fetching
+	PromptPrefix         string        // Run-wide instruction placed before each job's request
+	PromptSuffix         string        // Run-wide instruction placed after each job's request
+	Redact               *RedactConfig // Secret redaction for briefing files and the audit log
+	SummaryModel         string        // Model for summarizing oversized dependencies; truncate if empty
+}
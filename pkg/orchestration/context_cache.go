@@ -0,0 +1,139 @@
+package orchestration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// contextCacheKey identifies the inputs of a context generation. The generated
+// context is reused as long as none of them change.
+type contextCacheKey struct {
+	worktree     string
+	subProject   string
+	rulesFile    string
+	rulesModTime time.Time
+	gitState     string // Fingerprint of the worktree's HEAD and changed files
+}
+
+// contextCache remembers the last context generated for each context directory
+// during this process, so jobs sharing a worktree/sub-project in the same run
+// don't regenerate it when neither the rules nor the worktree changed.
+type contextCache struct {
+	mu      sync.Mutex
+	entries map[string]contextCacheKey
+	stats   map[string]*ContextStats // Stats of the cached context, for briefing files
+}
+
+var generatedContexts = &contextCache{entries: make(map[string]contextCacheKey)}
+
+// newContextCacheKey builds the cache key for generating contextDir's context
+// from rulesFile. ok is false if the rules file cannot be stat'ed.
+func newContextCacheKey(worktreePath, contextDir, rulesFile string) (key contextCacheKey, ok bool) {
+	info, err := os.Stat(rulesFile)
+	if err != nil {
+		return contextCacheKey{}, false
+	}
+	absRules, err := filepath.Abs(rulesFile)
+	if err != nil {
+		absRules = rulesFile
+	}
+	return contextCacheKey{
+		worktree:     worktreePath,
+		subProject:   contextDir,
+		rulesFile:    absRules,
+		rulesModTime: info.ModTime(),
+		gitState:     worktreeGitState(worktreePath),
+	}, true
+}
+
+// worktreeGitState fingerprints the git state of dir: HEAD plus the status,
+// size and modification time of every changed or untracked file, so a job that
+// edits files invalidates the context generated before it. flow's own .grove
+// files, including the generated context, are ignored. It returns "" outside a
+// git repository.
+func worktreeGitState(dir string) string {
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "--show-toplevel", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	lines := strings.Fields(string(out))
+	if len(lines) != 2 {
+		return ""
+	}
+	root, head := lines[0], lines[1]
+
+	status, err := exec.Command("git", "-C", dir, "status", "--porcelain", "-z", "--untracked-files=all").Output()
+	if err != nil {
+		return ""
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00", head)
+	for _, entry := range strings.Split(string(status), "\x00") {
+		if len(entry) < 4 {
+			continue
+		}
+		path := entry[3:]
+		if strings.HasPrefix(path, ".grove/") || strings.Contains(path, "/.grove/") {
+			continue
+		}
+		fmt.Fprintf(h, "%s\x00", entry)
+		if info, err := os.Stat(filepath.Join(root, path)); err == nil {
+			fmt.Fprintf(h, "%d %d\x00", info.Size(), info.ModTime().UnixNano())
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// upToDate reports whether contextDir's context was generated from key in this
+// run and the generated context file still exists.
+func (c *contextCache) upToDate(contextDir string, key contextCacheKey) bool {
+	c.mu.Lock()
+	cached, ok := c.entries[contextDir]
+	c.mu.Unlock()
+	if !ok || cached != key {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(contextDir, ".grove", "context"))
+	return err == nil
+}
+
+// store records that contextDir's context was generated from key.
+func (c *contextCache) store(contextDir string, key contextCacheKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[contextDir] = key
+}
+
+// forget drops what is recorded for contextDir, so its context is regenerated
+// by the next job that uses it.
+func (c *contextCache) forget(contextDir string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, contextDir)
+	delete(c.stats, contextDir)
+}
+
+// storeStats records the stats of the context generated in contextDir.
+func (c *contextCache) storeStats(contextDir string, stats *ContextStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stats == nil {
+		c.stats = make(map[string]*ContextStats)
+	}
+	c.stats[contextDir] = stats
+}
+
+// statsFor returns the stats recorded for contextDir's context, if any.
+func (c *contextCache) statsFor(contextDir string) *ContextStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats[contextDir]
+}
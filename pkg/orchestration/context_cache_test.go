@@ -0,0 +1,104 @@
+package orchestration
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestContextCache(t *testing.T) {
+	dir := t.TempDir()
+	rules := filepath.Join(dir, ".grove", "rules")
+	if err := os.MkdirAll(filepath.Dir(rules), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(rules, []byte("*.go\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache := &contextCache{entries: make(map[string]contextCacheKey)}
+	key, ok := newContextCacheKey(dir, dir, rules)
+	if !ok {
+		t.Fatal("expected a cache key for an existing rules file")
+	}
+	cache.store(dir, key)
+	if cache.upToDate(dir, key) {
+		t.Error("context should not be reused when the context file is missing")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ".grove", "context"), []byte("ctx"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if !cache.upToDate(dir, key) {
+		t.Error("context should be reused when nothing changed")
+	}
+
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(rules, later, later); err != nil {
+		t.Fatal(err)
+	}
+	newKey, _ := newContextCacheKey(dir, dir, rules)
+	if cache.upToDate(dir, newKey) {
+		t.Error("editing the rules file should invalidate the cache")
+	}
+
+	if _, ok := newContextCacheKey(dir, dir, filepath.Join(dir, "missing")); ok {
+		t.Error("a missing rules file should not be cacheable")
+	}
+}
+
+func TestContextCache_WorktreeChanges(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	git("init", "-q")
+	write("main.go", "package main\n")
+	write(".grove/rules", "*.go\n")
+	git("add", "main.go")
+	git("commit", "-q", "-m", "initial")
+	rules := filepath.Join(dir, ".grove", "rules")
+
+	key, _ := newContextCacheKey(dir, dir, rules)
+	write(".grove/context", "generated")
+	if again, _ := newContextCacheKey(dir, dir, rules); again != key {
+		t.Error("writing the generated context should not change the key")
+	}
+
+	write("main.go", "package main\n\nfunc main() {}\n")
+	edited, _ := newContextCacheKey(dir, dir, rules)
+	if edited == key {
+		t.Error("editing a tracked file should change the key")
+	}
+
+	write("main.go", "package main\n\nfunc main() { println() }\n")
+	if again, _ := newContextCacheKey(dir, dir, rules); again == edited {
+		t.Error("editing an already modified file should change the key")
+	}
+
+	git("commit", "-q", "-am", "edit")
+	if committed, _ := newContextCacheKey(dir, dir, rules); committed == key {
+		t.Error("a new commit should change the key")
+	}
+}
@@ -0,0 +1,118 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	grovelogging "github.com/grovetools/core/logging"
+	grovecontext "github.com/grovetools/cx/pkg/context"
+)
+
+// changedFilesSince lists the files under dir changed between base and HEAD
+// (git diff base...HEAD), relative to dir. Files deleted since base are left
+// out, as there is nothing to put in the context for them.
+func changedFilesSince(dir, base string) ([]string, error) {
+	cmd := exec.Command("git", "diff", "--name-only", "-z", "--relative", base+"...HEAD")
+	cmd.Dir = dir
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff %s...HEAD: %w: %s", base, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var files []string
+	for _, file := range strings.Split(string(output), "\x00") {
+		// A rules file has one pattern per line, so a name with a newline can't be listed
+		if file == "" || strings.ContainsAny(file, "\r\n") {
+			continue
+		}
+		if info, err := os.Stat(filepath.Join(dir, file)); err != nil || info.IsDir() {
+			continue
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// rulesPattern escapes path so that, as a line of a rules file, it matches
+// only that file: glob characters are backslash-escaped, as is a leading ! or
+// # that would otherwise make the line an exclusion or a comment.
+func rulesPattern(path string) string {
+	var b strings.Builder
+	for i, r := range path {
+		if strings.ContainsRune(`\*?[]{}`, r) || (i == 0 && (r == '!' || r == '#')) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// generateContextSince generates contextDir's context from only the files
+// changed since job.ContextSince, by writing them to a temporary rules file.
+// It returns false, and generates nothing, when the changed files can't be
+// determined or there are none, so the caller falls back to the normal
+// rules-based context.
+func (e *OneShotExecutor) generateContextSince(ctx context.Context, contextDir string, ctxMgr *grovecontext.Manager, job *Job) (bool, error) {
+	files, err := changedFilesSince(contextDir, job.ContextSince)
+	if err != nil {
+		ulog.Warn("Could not compute changed files for context_since; using the normal context").
+			Field("job_id", job.ID).
+			Field("context_since", job.ContextSince).
+			Err(err).
+			Log(ctx)
+		return false, nil
+	}
+	if len(files) == 0 {
+		ulog.Info("No files changed for context_since; using the normal context").
+			Field("job_id", job.ID).
+			Field("context_since", job.ContextSince).
+			Log(ctx)
+		return false, nil
+	}
+
+	// A uniquely named file, so jobs sharing the directory don't overwrite each other's rules
+	rulesDir := filepath.Join(contextDir, ".grove")
+	if err := os.MkdirAll(rulesDir, 0o755); err != nil {
+		return true, fmt.Errorf("create rules directory: %w", err)
+	}
+	rulesFile, err := os.CreateTemp(rulesDir, "rules.since-*")
+	if err != nil {
+		return true, fmt.Errorf("create context_since rules: %w", err)
+	}
+	rulesPath := rulesFile.Name()
+	defer os.Remove(rulesPath)
+	var rules strings.Builder
+	for _, file := range files {
+		rules.WriteString(rulesPattern(file) + "\n")
+	}
+	_, err = rulesFile.WriteString(rules.String())
+	if closeErr := rulesFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return true, fmt.Errorf("write context_since rules: %w", err)
+	}
+
+	ulog.Info("Limiting context to files changed since base").
+		Field("job_id", job.ID).
+		Field("context_since", job.ContextSince).
+		Field("files", len(files)).
+		Pretty(fmt.Sprintf("Using context from %d files changed since %s", len(files), job.ContextSince)).
+		Log(ctx)
+	fmt.Fprintf(grovelogging.GetWriter(ctx), "Using context from files changed since %s\n", job.ContextSince)
+
+	if err := ctxMgr.GenerateContextFromRulesFile(rulesPath, true); err != nil {
+		return true, fmt.Errorf("generate context_since context: %w", err)
+	}
+	// The context no longer matches the rules, so later jobs must regenerate it
+	generatedContexts.forget(contextDir)
+	job.ContextStats = e.summarizeContext(ctx, ctxMgr, job)
+
+	return true, e.displayContextInfo(ctx, contextDir)
+}
@@ -0,0 +1,86 @@
+package orchestration
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestChangedFilesSince(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	root := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", root}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	git("init", "-q", "-b", "main")
+	write("unchanged.go", "package a\n")
+	write("removed.go", "package a\n")
+	write("sub/edited.go", "package sub\n")
+	git("add", "-A")
+	git("commit", "-q", "-m", "initial")
+
+	git("checkout", "-q", "-b", "feature")
+	write("sub/edited.go", "package sub\n\nvar x = 1\n")
+	write("added.go", "package a\n")
+	write("docs/café notes.md", "# Notes\n") // quoted by git unless -z is used
+	git("rm", "-q", "removed.go")
+	git("add", "-A")
+	git("commit", "-q", "-m", "feature")
+
+	files, err := changedFilesSince(root, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"added.go", "docs/café notes.md", "sub/edited.go"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("changed files = %v, want %v", files, want)
+	}
+
+	files, err = changedFilesSince(filepath.Join(root, "sub"), "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"edited.go"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("changed files in sub-project = %v, want %v", files, want)
+	}
+
+	if _, err := changedFilesSince(root, "no-such-ref"); err == nil {
+		t.Error("expected an error for an unknown base ref")
+	}
+}
+
+func TestRulesPattern(t *testing.T) {
+	tests := map[string]string{
+		"pkg/main.go":       "pkg/main.go",
+		"docs/[draft].md":   `docs/\[draft\].md`,
+		"a*b?.go":           `a\*b\?.go`,
+		"{x}.go":            `\{x\}.go`,
+		"!important.md":     `\!important.md`,
+		"#notes.md":         `\#notes.md`,
+		"sub/!not-first.md": "sub/!not-first.md",
+	}
+	for path, want := range tests {
+		if got := rulesPattern(path); got != want {
+			t.Errorf("rulesPattern(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
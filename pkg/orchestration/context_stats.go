@@ -0,0 +1,29 @@
+package orchestration
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ContextStats summarizes the repository context generated for a job, so the
+// briefing file records what the prompt was built from.
+type ContextStats struct {
+	Files     int
+	Tokens    int
+	Languages []string // Top languages by token share, e.g. "Go: 81.2%"
+}
+
+// contextStatsComment renders stats as the comment placed at the top of a
+// briefing file, or "" if no context was generated for the job.
+func contextStatsComment(stats *ContextStats) string {
+	if stats == nil {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!-- context_files: %d, context_tokens: %d", stats.Files, stats.Tokens)
+	if len(stats.Languages) > 0 {
+		fmt.Fprintf(&b, ", languages: %s", strings.Join(stats.Languages, ", "))
+	}
+	b.WriteString(" -->\n")
+	return b.String()
+}
@@ -62,8 +62,23 @@ func DetermineWorkingDirectory(plan *Plan, job *Job) (string, error) {
 // within an ecosystem worktree when job.Repository is specified.
 // This ensures that context generation, command execution, and agent sessions
 // all operate in the correct sub-project directory rather than the ecosystem root.
+//
+// If job.WorkDir is set, it is resolved relative to workDir and takes precedence
+// over the repository. Like the repository, it is ignored when the directory
+// doesn't exist, so callers should check it first with ValidateJobWorkDir.
 func ScopeToSubProject(workDir string, job *Job) string {
-	if job == nil || job.Repository == "" {
+	if job == nil {
+		return workDir
+	}
+	if job.WorkDir != "" {
+		if dir := jobWorkDirPath(workDir, job); isDir(dir) {
+			return dir
+		}
+		if job.Repository == "" {
+			return workDir
+		}
+	}
+	if job.Repository == "" {
 		return workDir
 	}
 
@@ -76,6 +91,32 @@ func ScopeToSubProject(workDir string, job *Job) string {
 	return workDir
 }
 
+// ValidateJobWorkDir checks that the job's work_dir, if set, is an existing
+// directory relative to root (the worktree or git root).
+func ValidateJobWorkDir(root string, job *Job) error {
+	if job == nil || job.WorkDir == "" {
+		return nil
+	}
+	dir := jobWorkDirPath(root, job)
+	if !isDir(dir) {
+		return fmt.Errorf("work_dir '%s' does not exist (resolved to %s)", job.WorkDir, dir)
+	}
+	return nil
+}
+
+// jobWorkDirPath resolves job.WorkDir against root unless it is absolute.
+func jobWorkDirPath(root string, job *Job) string {
+	if filepath.IsAbs(job.WorkDir) {
+		return filepath.Clean(job.WorkDir)
+	}
+	return filepath.Join(root, job.WorkDir)
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
 // GetProjectRootSafe returns the project root using the workspace model.
 // It supports both Grove projects (with grove.yml) and non-Grove repos.
 // Falls back to git root or current directory if workspace discovery fails.
@@ -192,6 +233,11 @@ func ResolveLogDirectory(plan *Plan, job *Job) string {
 
 // ResolvePromptSource resolves a prompt source file with multiple strategies
 func ResolvePromptSource(source string, plan *Plan) (string, error) {
+	// URLs are downloaded to a temporary file that is attached like any other
+	if IsURLSource(source) {
+		return fetchURLSource(source, plan)
+	}
+
 	// If absolute path, use as-is
 	if filepath.IsAbs(source) {
 		return source, nil
@@ -0,0 +1,35 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScopeToSubProject_WorkDir(t *testing.T) {
+	root := t.TempDir()
+	pkgDir := filepath.Join(root, "packages", "api")
+	if err := os.MkdirAll(pkgDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	job := &Job{WorkDir: "packages/api"}
+	if err := ValidateJobWorkDir(root, job); err != nil {
+		t.Fatalf("ValidateJobWorkDir() error = %v", err)
+	}
+	if got := ScopeToSubProject(root, job); got != pkgDir {
+		t.Errorf("ScopeToSubProject() = %s, want %s", got, pkgDir)
+	}
+	// Scoping an already-scoped directory keeps it
+	if got := ScopeToSubProject(pkgDir, job); got != pkgDir {
+		t.Errorf("ScopeToSubProject() on scoped dir = %s, want %s", got, pkgDir)
+	}
+
+	missing := &Job{WorkDir: "packages/web"}
+	if err := ValidateJobWorkDir(root, missing); err == nil {
+		t.Error("expected an error for a missing work_dir")
+	}
+	if got := ScopeToSubProject(root, missing); got != root {
+		t.Errorf("ScopeToSubProject() with missing work_dir = %s, want %s", got, root)
+	}
+}
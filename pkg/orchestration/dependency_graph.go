@@ -2,6 +2,7 @@ package orchestration
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -108,6 +109,51 @@ func (dg *DependencyGraph) UpdateJobStatus(jobID string, status JobStatus) {
 	}
 }
 
+// Dependents returns the IDs of the jobs that directly depend on jobID, sorted.
+func (dg *DependencyGraph) Dependents(jobID string) []string {
+	var dependents []string
+	for id, deps := range dg.edges {
+		for _, dep := range deps {
+			if dep == jobID {
+				dependents = append(dependents, id)
+				break
+			}
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+// Upstream returns the IDs of every job jobID transitively depends on, sorted.
+func (dg *DependencyGraph) Upstream(jobID string) []string {
+	return dg.reachable(jobID, func(id string) []string { return dg.edges[id] })
+}
+
+// Downstream returns the IDs of every job that transitively depends on jobID, sorted.
+func (dg *DependencyGraph) Downstream(jobID string) []string {
+	return dg.reachable(jobID, dg.Dependents)
+}
+
+// reachable walks next from jobID and returns every job visited, excluding jobID.
+func (dg *DependencyGraph) reachable(jobID string, next func(string) []string) []string {
+	seen := map[string]bool{jobID: true}
+	queue := []string{jobID}
+	var result []string
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, n := range next(id) {
+			if !seen[n] {
+				seen[n] = true
+				result = append(result, n)
+				queue = append(queue, n)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
 // GetRunnableJobs finds all jobs that can be run immediately.
 func (dg *DependencyGraph) GetRunnableJobs() []*Job {
 	runnable := []*Job{}
@@ -311,6 +311,12 @@ func TestDependencyGraph_DetectCycles(t *testing.T) {
 	if !strings.Contains(err.Error(), "circular dependency") {
 		t.Fatalf("Expected circular dependency error, got: %v", err)
 	}
+	if !strings.Contains(err.Error(), "B -> C -> D -> B") {
+		t.Errorf("Expected every job in the cycle to be named, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "A ->") {
+		t.Errorf("Job A leads into the cycle but is not part of it: %v", err)
+	}
 }
 
 func TestDependencyGraph_ToMermaid(t *testing.T) {
@@ -347,4 +353,32 @@ func TestDependencyGraph_ToMermaid(t *testing.T) {
 	if !strings.Contains(mermaid, "classDef completed") {
 		t.Errorf("Expected style definitions")
 	}
-}
\ No newline at end of file
+}
+
+func TestDependencyGraph_UpstreamDownstream(t *testing.T) {
+	plan := createTestPlan([]*Job{
+		{ID: "spec", Status: JobStatusCompleted},
+		{ID: "design", Status: JobStatusCompleted, DependsOn: []string{"spec"}},
+		{ID: "impl", Status: JobStatusPending, DependsOn: []string{"design"}},
+		{ID: "docs", Status: JobStatusPending, DependsOn: []string{"design"}},
+		{ID: "review", Status: JobStatusPending, DependsOn: []string{"impl", "docs"}},
+	})
+
+	graph, err := BuildDependencyGraph(plan)
+	if err != nil {
+		t.Fatalf("Failed to build graph: %v", err)
+	}
+
+	if got := strings.Join(graph.Upstream("review"), ","); got != "design,docs,impl,spec" {
+		t.Errorf("Upstream(review) = %s", got)
+	}
+	if got := strings.Join(graph.Downstream("design"), ","); got != "docs,impl,review" {
+		t.Errorf("Downstream(design) = %s", got)
+	}
+	if got := strings.Join(graph.Dependents("design"), ","); got != "docs,impl" {
+		t.Errorf("Dependents(design) = %s", got)
+	}
+	if got := graph.Upstream("spec"); len(got) != 0 {
+		t.Errorf("Upstream(spec) = %v, want none", got)
+	}
+}
@@ -0,0 +1,111 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+const dependencySummaryPrompt = "The following is the output of an upstream job in an LLM pipeline. It is too large to pass on in full, so summarize it in no more than %d bytes for the next job. Keep decisions, file names, interfaces and open questions; drop discussion. Reply with the summary only.\n\n---\n\n%s"
+
+// dependencyMaxBytes returns the size limit for each inlined dependency body:
+// the job's dependency_max_bytes, else the plan's, else 0 for no limit.
+func dependencyMaxBytes(job *Job, plan *Plan) int {
+	switch {
+	case job.DependencyMaxBytes > 0:
+		return job.DependencyMaxBytes
+	case plan != nil && plan.Config != nil && plan.Config.DependencyMaxBytes > 0:
+		return plan.Config.DependencyMaxBytes
+	}
+	return 0
+}
+
+// limitDependencyBody applies the job's dependency size limit to the body of
+// dep before it is inlined. An oversized body is replaced by the summary
+// prepared by summarizeLargeDependencies, if there is one, or else truncated
+// with a marker. The returned attributes describe the substitution for the
+// prepended_dependency tag, so briefing files show what was changed.
+func limitDependencyBody(job *Job, plan *Plan, dep *Job, body string) (string, string) {
+	limit := dependencyMaxBytes(job, plan)
+	if limit == 0 || len(body) <= limit {
+		return body, ""
+	}
+	if summary, ok := job.DependencySummaries[dep.FilePath]; ok {
+		return summary, fmt.Sprintf(" substituted=\"summary\" original_bytes=\"%d\"", len(body))
+	}
+	return truncateDependencyBody(body, limit), fmt.Sprintf(" substituted=\"truncated\" original_bytes=\"%d\"", len(body))
+}
+
+// truncateDependencyBody cuts body to at most limit bytes, on a UTF-8
+// boundary, and appends a marker saying how much was dropped.
+func truncateDependencyBody(body string, limit int) string {
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+	return body[:cut] + fmt.Sprintf("\n\n[... truncated %d of %d bytes (dependency_max_bytes is %d) ...]", len(body)-cut, len(body), limit)
+}
+
+// summarizeLargeDependencies asks the configured summary model for a short
+// summary of each inlined dependency larger than the job's limit and stores
+// them in job.DependencySummaries for limitDependencyBody. Without a summary
+// model, or if a summary fails, the dependency is truncated instead.
+func summarizeLargeDependencies(ctx context.Context, job *Job, plan *Plan) {
+	limit := dependencyMaxBytes(job, plan)
+	if limit == 0 || !job.ShouldInline(InlineDependencies) {
+		return
+	}
+	if plan == nil || plan.Orchestration == nil || plan.Orchestration.SummaryModel == "" {
+		return
+	}
+
+	for _, dep := range job.Dependencies {
+		if dep == nil || dep.FilePath == "" {
+			continue
+		}
+		if _, done := job.DependencySummaries[dep.FilePath]; done {
+			continue
+		}
+		content, err := os.ReadFile(dep.FilePath)
+		if err != nil {
+			continue
+		}
+		_, body, _ := ParseFrontmatter(content)
+		if len(body) <= limit {
+			continue
+		}
+
+		summary, err := NewCommandLLMClient(nil).Complete(ctx, job, plan, fmt.Sprintf(dependencySummaryPrompt, limit, body), LLMOptions{
+			Model:      plan.Orchestration.SummaryModel,
+			WorkingDir: plan.Directory,
+		}, io.Discard)
+		if err == nil && strings.TrimSpace(summary) == "" {
+			err = fmt.Errorf("empty summary")
+		}
+		if err != nil {
+			ulog.Warn("Could not summarize large dependency; truncating it instead").
+				Field("job_id", job.ID).
+				Field("dependency", dep.Filename).
+				Err(err).
+				Log(ctx)
+			continue
+		}
+
+		summary = strings.TrimSpace(summary)
+		if len(summary) > limit {
+			summary = truncateDependencyBody(summary, limit)
+		}
+		if job.DependencySummaries == nil {
+			job.DependencySummaries = make(map[string]string)
+		}
+		job.DependencySummaries[dep.FilePath] = summary
+		ulog.Info("Replaced large dependency with a summary").
+			Field("job_id", job.ID).
+			Field("dependency", dep.Filename).
+			Field("original_bytes", len(body)).
+			Log(ctx)
+	}
+}
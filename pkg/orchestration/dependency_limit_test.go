@@ -0,0 +1,99 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLimitDependencyBody(t *testing.T) {
+	plan := &Plan{Config: &PlanConfig{}}
+	job := &Job{ID: "impl"}
+	dep := &Job{ID: "spec", Filename: "01-spec.md", FilePath: "/plan/01-spec.md"}
+	body := strings.Repeat("spec line\n", 20)
+
+	if got, attrs := limitDependencyBody(job, plan, dep, body); got != body || attrs != "" {
+		t.Errorf("without a limit the body should be inlined in full, got attrs %q", attrs)
+	}
+
+	plan.Config.DependencyMaxBytes = 50
+	got, attrs := limitDependencyBody(job, plan, dep, body)
+	if !strings.HasPrefix(got, body[:50]) || !strings.Contains(got, "[... truncated 150 of 200 bytes") {
+		t.Errorf("expected truncation at the plan's limit, got:\n%s", got)
+	}
+	if attrs != ` substituted="truncated" original_bytes="200"` {
+		t.Errorf("attrs = %q", attrs)
+	}
+
+	job.DependencyMaxBytes = 500
+	if got, _ := limitDependencyBody(job, plan, dep, body); got != body {
+		t.Error("the job's limit should override the plan's")
+	}
+
+	job.DependencyMaxBytes = 100
+	job.DependencySummaries = map[string]string{dep.FilePath: "The spec defines two endpoints."}
+	got, attrs = limitDependencyBody(job, plan, dep, body)
+	if got != "The spec defines two endpoints." || attrs != ` substituted="summary" original_bytes="200"` {
+		t.Errorf("expected the summary to replace the body, got %q with attrs %q", got, attrs)
+	}
+}
+
+func TestTruncateDependencyBodyRuneBoundary(t *testing.T) {
+	got := truncateDependencyBody("aé", 2)
+	if !strings.HasPrefix(got, "a\n\n[... truncated 2 of 3 bytes") {
+		t.Errorf("truncation split a multi-byte rune: %q", got)
+	}
+}
+
+func TestBuildXMLPromptMarksTruncatedDependency(t *testing.T) {
+	dir := t.TempDir()
+	depPath := filepath.Join(dir, "01-spec.md")
+	if err := os.WriteFile(depPath, []byte("---\nid: spec\n---\n"+strings.Repeat("x", 100)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dep := &Job{ID: "spec", Filename: "01-spec.md", FilePath: depPath}
+	job := &Job{
+		ID:                 "impl",
+		Type:               JobTypeOneshot,
+		PromptBody:         "Implement it.",
+		Dependencies:       []*Job{dep},
+		Inline:             InlineConfig{Categories: []InlineCategory{InlineDependencies}},
+		DependencyMaxBytes: 40,
+	}
+
+	prompt, _, err := BuildXMLPrompt(job, &Plan{Directory: dir}, dir, nil)
+	if err != nil {
+		t.Fatalf("BuildXMLPrompt: %v", err)
+	}
+	if !strings.Contains(prompt, `<prepended_dependency file="01-spec.md" substituted="truncated"`) {
+		t.Errorf("briefing does not record the truncation:\n%s", prompt)
+	}
+}
+
+func TestBuildPromptMarksSummarizedDependency(t *testing.T) {
+	dir := t.TempDir()
+	depPath := filepath.Join(dir, "01-spec.md")
+	if err := os.WriteFile(depPath, []byte("---\nid: spec\n---\n"+strings.Repeat("x", 100)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dep := &Job{ID: "spec", Filename: "01-spec.md", FilePath: depPath}
+	job := &Job{
+		ID:                  "impl",
+		Type:                JobTypeOneshot,
+		PromptBody:          "Implement it.",
+		Dependencies:        []*Job{dep},
+		Inline:              InlineConfig{Categories: []InlineCategory{InlineDependencies}},
+		DependencyMaxBytes:  40,
+		DependencySummaries: map[string]string{depPath: "The spec in brief."},
+	}
+
+	executor := NewOneShotExecutor(NewMockLLMClient(), nil)
+	prompt, _, _, err := executor.buildPrompt(job, &Plan{Directory: dir}, dir)
+	if err != nil {
+		t.Fatalf("buildPrompt: %v", err)
+	}
+	if !strings.Contains(prompt, `## Context from 01-spec.md (substituted="summary" original_bytes="100")`) || !strings.Contains(prompt, "The spec in brief.") {
+		t.Errorf("prompt does not record the summary substitution:\n%s", prompt)
+	}
+}
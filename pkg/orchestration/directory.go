@@ -81,12 +81,27 @@ func getWorkspaceContext() (repository, branch, worktree string) {
 
 // AddJob adds a new job to the plan directory.
 func AddJob(plan *Plan, job *Job) (string, error) {
+	if err := prepareNewJob(plan, job); err != nil {
+		return "", err
+	}
+
+	// Generate filename
+	nextNum, err := GetNextJobNumber(plan.Directory)
+	if err != nil {
+		return "", fmt.Errorf("getting next job number: %w", err)
+	}
+
+	return writeNewJob(plan, job, nextNum)
+}
+
+// prepareNewJob validates a job about to be added to the plan and fills in defaults.
+func prepareNewJob(plan *Plan, job *Job) error {
 	// Validate job
 	if job.ID == "" {
-		return "", fmt.Errorf("job ID is required")
+		return fmt.Errorf("job ID is required")
 	}
 	if job.Title == "" {
-		return "", fmt.Errorf("job title is required")
+		return fmt.Errorf("job title is required")
 	}
 	if job.Type == "" {
 		job.Type = JobTypeOneshot
@@ -111,20 +126,20 @@ func AddJob(plan *Plan, job *Job) (string, error) {
 
 	// Check for duplicate ID
 	if existing, exists := plan.JobsByID[job.ID]; exists {
-		return "", fmt.Errorf("job with ID %q already exists in file %s", job.ID, existing.Filename)
-	}
-
-	// Generate filename
-	nextNum, err := GetNextJobNumber(plan.Directory)
-	if err != nil {
-		return "", fmt.Errorf("getting next job number: %w", err)
+		return fmt.Errorf("job with ID %q already exists in file %s", job.ID, existing.Filename)
 	}
+	return nil
+}
 
-	filename := GenerateJobFilename(nextNum, job.Title)
+// writeNewJob writes a validated job to the plan as job number number and adds it
+// to the in-memory plan.
+func writeNewJob(plan *Plan, job *Job, number int) (string, error) {
+	filename := GenerateJobFilename(number, job.Title)
 	filepath := filepath.Join(plan.Directory, filename)
 
 	// Generate job content
 	var content []byte
+	var err error
 	if job.Type == JobTypeAgent || job.Type == JobTypeInteractiveAgent || job.Type == JobTypeHeadlessAgent {
 		content, err = generateAgentJobContent(job)
 	} else {
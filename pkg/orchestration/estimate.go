@@ -0,0 +1,91 @@
+package orchestration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	grovecontext "github.com/grovetools/cx/pkg/context"
+)
+
+// PromptEstimate describes the input a oneshot job would send to its model.
+type PromptEstimate struct {
+	JobID       string `json:"job_id"`
+	Filename    string `json:"filename"`
+	Model       string `json:"model"`
+	ModelSource string `json:"model_source"`
+	Chars       int    `json:"chars"`
+	Files       int    `json:"files"`
+	Tokens      int    `json:"tokens_est"`
+}
+
+// EstimatePrompt assembles the prompt a oneshot job would send, without calling
+// an LLM or creating worktrees, and estimates its input token count. The model is
+// resolved with the same precedence Execute uses.
+func (e *OneShotExecutor) EstimatePrompt(job *Job, plan *Plan) (*PromptEstimate, error) {
+	workDir := ScopeToSubProject(estimateWorkDir(job, plan), job)
+
+	_, _, contextFiles, err := e.buildPrompt(job, plan, workDir)
+	if err != nil {
+		return nil, fmt.Errorf("determining context files: %w", err)
+	}
+
+	prompt, promptSourceFiles, err := BuildXMLPrompt(job, plan, workDir, contextFiles)
+	if err != nil {
+		return nil, fmt.Errorf("building XML prompt: %w", err)
+	}
+
+	model, source := e.resolveModel(job, plan)
+	budget := promptBudget{Chars: assembledPromptSize(prompt, promptSourceFiles, contextFiles)}
+
+	files := make(map[string]bool)
+	for _, f := range append(promptSourceFiles, contextFiles...) {
+		files[f] = true
+	}
+
+	return &PromptEstimate{
+		JobID:       job.ID,
+		Filename:    job.Filename,
+		Model:       model,
+		ModelSource: source,
+		Chars:       budget.Chars,
+		Files:       len(files),
+		Tokens:      estimateTokens(prompt, files),
+	}, nil
+}
+
+// estimateTokens counts the prompt's tokens plus those of each attached file,
+// using grove-context's file stats so the estimate matches `cx stats`.
+func estimateTokens(prompt string, files map[string]bool) int {
+	tokens := promptBudget{Chars: len(prompt)}.EstimatedTokens()
+	stats := grovecontext.GetStatsProvider()
+	for f := range files {
+		if info, err := stats.GetFileStats(f); err == nil {
+			tokens += info.Tokens
+		}
+	}
+	return tokens
+}
+
+// estimateWorkDir returns the directory a job would run in without preparing a
+// worktree. An existing worktree is used if present; otherwise the project root.
+func estimateWorkDir(job *Job, plan *Plan) string {
+	gitRoot, err := GetProjectGitRoot(plan.Directory)
+	if err != nil {
+		return plan.Directory
+	}
+	if job.Worktree == "" {
+		return gitRoot
+	}
+
+	realGitRoot := gitRoot
+	if idx := strings.Index(gitRoot, "/.grove-worktrees/"); idx != -1 {
+		realGitRoot = gitRoot[:idx]
+	}
+	worktreePath := filepath.Join(realGitRoot, ".grove-worktrees", job.Worktree)
+	if info, err := os.Stat(worktreePath); err == nil && info.IsDir() {
+		return worktreePath
+	}
+	return gitRoot
+}
@@ -76,10 +76,15 @@ func (e *GenerateRecipeExecutor) Execute(ctx context.Context, job *Job, plan *Pl
 			gitRoot = plan.Directory
 		}
 
+		state, err := inspectWorktree(ctx, gitRoot, job.Worktree, "main")
+		if err != nil {
+			return fmt.Errorf("getting worktree: %w", err)
+		}
 		worktreeDir, _, err := e.worktreeManager.GetOrPrepareWorktree(ctx, gitRoot, job.Worktree, "main")
 		if err != nil {
 			return fmt.Errorf("getting worktree: %w", err)
 		}
+		logWorktreePrepared(ctx, state, job.Worktree, worktreeDir)
 		workDir = worktreeDir
 	} else {
 		// No worktree specified, use project git root or plan directory (notebook-aware)
@@ -178,7 +183,7 @@ func (e *GenerateRecipeExecutor) Execute(ctx context.Context, job *Job, plan *Pl
 	content = append(content, []byte(outputSection)...)
 	
 	// Write back
-	if err := os.WriteFile(job.FilePath, content, 0644); err != nil {
+	if err := writeFileAtomic(job.FilePath, content); err != nil {
 		return fmt.Errorf("writing job file with output: %w", err)
 	}
 
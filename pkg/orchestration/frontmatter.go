@@ -176,11 +176,16 @@ func updateNodeValue(node *yaml.Node, key string, value interface{}) {
 				valueNode.Value = ""
 				valueNode.Content = make([]*yaml.Node, len(v))
 				for j, item := range v {
-					valueNode.Content[j] = &yaml.Node{
-						Kind:  yaml.ScalarNode,
-						Value: fmt.Sprint(item),
-						Tag:   resolveYAMLTag(item),
+					valueNode.Content[j] = sequenceItemNode(item)
+				}
+			case map[string]interface{}:
+				// Merge into an existing mapping so its other keys are kept
+				if valueNode.Kind == yaml.MappingNode {
+					for k, item := range v {
+						updateNodeValue(valueNode, k, item)
 					}
+				} else if err := valueNode.Encode(v); err != nil {
+					return
 				}
 			default:
 				// Handle scalar values
@@ -223,11 +228,12 @@ func updateNodeValue(node *yaml.Node, key string, value interface{}) {
 			Content: make([]*yaml.Node, len(v)),
 		}
 		for j, item := range v {
-			valueNode.Content[j] = &yaml.Node{
-				Kind:  yaml.ScalarNode,
-				Value: fmt.Sprint(item),
-				Tag:   resolveYAMLTag(item),
-			}
+			valueNode.Content[j] = sequenceItemNode(item)
+		}
+	case map[string]interface{}:
+		valueNode = &yaml.Node{}
+		if err := valueNode.Encode(v); err != nil {
+			return
 		}
 	default:
 		valueNode = &yaml.Node{
@@ -240,6 +246,22 @@ func updateNodeValue(node *yaml.Node, key string, value interface{}) {
 	node.Content = append(node.Content, keyNode, valueNode)
 }
 
+// sequenceItemNode builds the node for one item of a generic array. Maps, such
+// as typed include entries, become mapping nodes; anything else a scalar.
+func sequenceItemNode(item interface{}) *yaml.Node {
+	if m, ok := item.(map[string]interface{}); ok {
+		var mapNode yaml.Node
+		if err := mapNode.Encode(m); err == nil {
+			return &mapNode
+		}
+	}
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Value: fmt.Sprint(item),
+		Tag:   resolveYAMLTag(item),
+	}
+}
+
 // resolveYAMLTag determines the appropriate YAML tag for a value.
 func resolveYAMLTag(value interface{}) string {
 	switch value.(type) {
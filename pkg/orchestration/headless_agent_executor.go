@@ -267,7 +267,7 @@ func (e *HeadlessAgentExecutor) runOnHost(ctx context.Context, worktreePath stri
 
 	// Set environment variables to enable grove-hooks integration for session registration.
 	escapedTitle := "'" + strings.ReplaceAll(job.Title, "'", "'\\''") + "'"
-	cmd.Env = append(os.Environ(),
+	cmd.Env = jobEnvironment(plan, job,
 		"GROVE_FLOW_JOB_ID="+job.ID,
 		"GROVE_FLOW_JOB_PATH="+job.FilePath,
 		"GROVE_FLOW_PLAN_NAME="+plan.Name,
@@ -1,6 +1,7 @@
 package orchestration
 
 import (
+	"os"
 	"strings"
 	"time"
 )
@@ -88,8 +89,18 @@ const (
 	JobStatusTodo        JobStatus = "todo"
 	JobStatusAbandoned   JobStatus = "abandoned"
 	JobStatusIdle        JobStatus = "idle" // Agent finished responding, waiting for next input
+	JobStatusSkipped     JobStatus = "skipped" // run_if was false, or a dependency was skipped
 )
 
+// JobStatuses returns every status a job file may declare.
+func JobStatuses() []JobStatus {
+	return []JobStatus{
+		JobStatusPending, JobStatusRunning, JobStatusCompleted, JobStatusFailed, JobStatusBlocked,
+		JobStatusNeedsReview, JobStatusPendingUser, JobStatusPendingLLM, JobStatusHold,
+		JobStatusTodo, JobStatusAbandoned, JobStatusIdle, JobStatusSkipped,
+	}
+}
+
 // JobType represents the type of job execution.
 type JobType string
 
@@ -104,6 +115,14 @@ const (
 	JobTypeFile             JobType = "file" // Non-executable job for storing context/reference content
 )
 
+// JobTypes returns every type LoadJob accepts.
+func JobTypes() []JobType {
+	return []JobType{
+		JobTypeOneshot, JobTypeAgent, JobTypeHeadlessAgent, JobTypeShell, JobTypeChat,
+		JobTypeInteractiveAgent, JobTypeGenerateRecipe, JobTypeFile,
+	}
+}
+
 // Job represents a single orchestration job.
 type Job struct {
 	// From frontmatter
@@ -117,6 +136,7 @@ type Job struct {
 	SourceBlock          string       `yaml:"source_block,omitempty" json:"source_block,omitempty"`
 	Template             string       `yaml:"template,omitempty" json:"template,omitempty"`
 	Repository           string       `yaml:"repository,omitempty" json:"repository,omitempty"`
+	WorkDir              string       `yaml:"work_dir,omitempty" json:"work_dir,omitempty"` // Working directory, relative to the worktree/git root
 	Branch               string       `yaml:"branch,omitempty" json:"branch,omitempty"`
 	Worktree             string       `yaml:"worktree" json:"worktree,omitempty"`
 	TargetAgentContainer string       `yaml:"target_agent_container,omitempty" json:"target_agent_container,omitempty"`
@@ -125,6 +145,7 @@ type Job struct {
 	OnCompleteStatus     string       `yaml:"on_complete_status,omitempty" json:"on_complete_status,omitempty"`
 	CreatedAt            time.Time     `yaml:"created_at,omitempty" json:"created_at,omitempty"`
 	UpdatedAt            time.Time     `yaml:"updated_at,omitempty" json:"updated_at,omitempty"`
+	StartedAt            time.Time     `yaml:"started_at,omitempty" json:"started_at,omitempty"`
 	CompletedAt          time.Time     `yaml:"completed_at,omitempty" json:"completed_at,omitempty"`
 	Duration             time.Duration `yaml:"duration,omitempty" json:"duration,omitempty"`
 	Summary              string        `yaml:"summary,omitempty" json:"summary,omitempty"`
@@ -135,17 +156,62 @@ type Job struct {
 	GatherConceptNotes   bool         `yaml:"gather_concept_notes,omitempty" json:"gather_concept_notes,omitempty"`
 	GatherConceptPlans   bool         `yaml:"gather_concept_plans,omitempty" json:"gather_concept_plans,omitempty"`
 	RulesFile            string       `yaml:"rules_file,omitempty" json:"rules_file,omitempty"`
+	ContextSince         string       `yaml:"context_since,omitempty" json:"context_since,omitempty"` // Limit context to files changed since this git ref
 	NoteRef              string       `yaml:"note_ref,omitempty" json:"note_ref,omitempty"`
 	SourceFile           string       `yaml:"source_file,omitempty" json:"source_file,omitempty"` // Origin file path (e.g., Claude plan file)
+	Output               OutputConfig `yaml:"output,omitempty" json:"output,omitempty"`
+	CommitSHA            string       `yaml:"commit_sha,omitempty" json:"commit_sha,omitempty"` // Commit created by output type "commit"
+	RanModel             string       `yaml:"ran_model,omitempty" json:"ran_model,omitempty"`     // Model the last successful run used
+	PromptHash           string       `yaml:"prompt_hash,omitempty" json:"prompt_hash,omitempty"` // SHA-256 of the prompt sent by the last successful run
+	Timeout              time.Duration `yaml:"timeout,omitempty" json:"timeout,omitempty"`       // Overrides the executor's LLM timeout (e.g. "10m")
+	RetryCount           *int          `yaml:"retry_count,omitempty" json:"retry_count,omitempty"` // Overrides the executor's LLM retry count
+	LastError            string       `yaml:"last_error,omitempty" json:"last_error,omitempty"` // Failure message from the most recent run
+	BlockedReason        string       `yaml:"blocked_reason,omitempty" json:"blocked_reason,omitempty"` // Why the orchestrator blocked this job
+	RunIf                *RunIfCondition `yaml:"run_if,omitempty" json:"run_if,omitempty"`           // Only run when a dependency's output matches
+	SkipReason           string       `yaml:"skip_reason,omitempty" json:"skip_reason,omitempty"` // Why the orchestrator skipped this job
+	AllowSkippedDeps     bool         `yaml:"allow_skipped_deps,omitempty" json:"allow_skipped_deps,omitempty"` // Treat skipped dependencies as satisfied
+	PromptOverflow       string       `yaml:"prompt_overflow,omitempty" json:"prompt_overflow,omitempty"` // fail, truncate-context, or drop-oldest-deps
+	OnComplete           string       `yaml:"on_complete,omitempty" json:"on_complete,omitempty"`                   // Shell command run after a oneshot job completes
+	OnCompleteRequired   *bool        `yaml:"on_complete_required,omitempty" json:"on_complete_required,omitempty"` // Fail the job if on_complete fails (default true)
+	MaxTurns             int          `yaml:"max_turns,omitempty" json:"max_turns,omitempty"`                       // Chat turns to run unattended before returning to pending_user
+	SaveRawOutput        bool         `yaml:"save_raw_output,omitempty" json:"save_raw_output,omitempty"`           // Keep the verbatim LLM response in <id>.raw.md
+	ConcurrencyGroup     string       `yaml:"concurrency_group,omitempty" json:"concurrency_group,omitempty"`       // At most one job per group runs at a time
+	Env                  map[string]string `yaml:"env,omitempty" json:"env,omitempty"`                              // Environment variables for this job's subprocesses, overriding the plan's
+	DependencyMaxBytes   int          `yaml:"dependency_max_bytes,omitempty" json:"dependency_max_bytes,omitempty"` // Summarize or truncate inlined dependencies larger than this
 
 	// Derived fields
-	Filename     string      `json:"filename,omitempty"`     // The markdown filename
-	FilePath     string      `json:"file_path,omitempty"`    // Full path to the file
-	PromptBody   string      `json:"-"`                       // Content after frontmatter
-	Dependencies []*Job      `json:"-"`                       // Resolved job references
-	StartTime    time.Time   `json:"start_time,omitempty"`   // When job started
-	EndTime      time.Time   `json:"end_time,omitempty"`     // When job completed
-	Metadata     JobMetadata `json:"metadata,omitempty"`
+	Filename            string            `json:"filename,omitempty"`               // The markdown filename
+	FilePath            string            `json:"file_path,omitempty"`              // Full path to the file
+	PromptBody          string            `json:"-"`                                // Content after frontmatter
+	Dependencies        []*Job            `json:"-"`                                // Resolved job references
+	IncludeTypes        map[string]string `yaml:"-" json:"include_types,omitempty"` // Attachment type hints from typed include entries, keyed by path
+	DependencySummaries map[string]string `yaml:"-" json:"-"`                       // Summaries replacing oversized inlined dependencies, keyed by file path
+	ContextStats        *ContextStats     `yaml:"-" json:"-"`                       // Stats of the context generated for the current run
+	StartTime           time.Time         `json:"start_time,omitempty"`             // When job started
+	EndTime             time.Time         `json:"end_time,omitempty"`               // When job completed
+	Metadata            JobMetadata       `json:"metadata,omitempty"`
+}
+
+// Output types supported by the output frontmatter block.
+const (
+	OutputTypeFile     = "file"      // Append the response to the job file (default)
+	OutputTypeCommit   = "commit"    // Append the response, then commit files changed during the job
+	OutputTypeAppendTo = "append-to" // Append the response to another job's file, named by Target
+)
+
+// OutputTypes returns every value output.type accepts.
+func OutputTypes() []string {
+	return []string{OutputTypeFile, OutputTypeCommit, OutputTypeAppendTo}
+}
+
+// OutputConfig controls what happens with a job's output once it completes.
+type OutputConfig struct {
+	Type    string `yaml:"type,omitempty" json:"type,omitempty"`
+	Path    string `yaml:"path,omitempty" json:"path,omitempty"`
+	Message string `yaml:"message,omitempty" json:"message,omitempty"` // Commit message for type "commit"
+	Target  string `yaml:"target,omitempty" json:"target,omitempty"`   // Job ID or filename for type "append-to"
+
+	UnwrapCodeFence bool `yaml:"unwrap_code_fence,omitempty" json:"unwrap_code_fence,omitempty"` // Strip a single fenced block wrapping the whole response
 }
 
 // JobMetadata holds additional job metadata.
@@ -208,6 +274,9 @@ func (j *Job) IsRunnable() bool {
 		} else if (j.Type == JobTypeInteractiveAgent || j.Type == JobTypeAgent) && dep.Type == JobTypeChat && dep.Status == JobStatusPendingUser {
 			// Special case: an interactive agent can run if its chat dependency is pending user input.
 			dependencyMet = true
+		} else if dep.Status == JobStatusSkipped && j.AllowSkippedDeps {
+			// A skipped dependency is not completed unless the job opts in.
+			dependencyMet = true
 		}
 
 		if !dependencyMet {
@@ -218,6 +287,61 @@ func (j *Job) IsRunnable() bool {
 	return true
 }
 
+// FailedDependency returns the failed job that blocks j, following pending and
+// blocked dependencies back to the root cause, or nil if none has failed.
+func (j *Job) FailedDependency() *Job {
+	return j.failedDependency(make(map[string]bool))
+}
+
+func (j *Job) failedDependency(seen map[string]bool) *Job {
+	for _, dep := range j.Dependencies {
+		if dep == nil || seen[dep.ID] {
+			continue
+		}
+		seen[dep.ID] = true
+		switch dep.Status {
+		case JobStatusFailed:
+			return dep
+		case JobStatusBlocked, JobStatusPending:
+			if failed := dep.failedDependency(seen); failed != nil {
+				return failed
+			}
+		}
+	}
+	return nil
+}
+
+// WaitReason explains why a job isn't running: the recorded blocked or skip
+// reason, or for a pending job the dependencies it is still waiting on. It returns ""
+// for jobs that are runnable or not waiting.
+func (j *Job) WaitReason() string {
+	if j.Status == JobStatusBlocked {
+		if j.BlockedReason != "" {
+			return j.BlockedReason
+		}
+		return "blocked"
+	}
+	if j.Status == JobStatusSkipped {
+		if j.SkipReason != "" {
+			return j.SkipReason
+		}
+		return "skipped"
+	}
+	if j.Status != JobStatusPending || j.IsRunnable() {
+		return ""
+	}
+	var waiting []string
+	for _, dep := range j.Dependencies {
+		if dep != nil && dep.Status != JobStatusCompleted && dep.Status != JobStatusAbandoned && !(dep.Status == JobStatusSkipped && j.AllowSkippedDeps) {
+			waiting = append(waiting, dep.ID)
+		}
+	}
+	if len(waiting) == 0 {
+		return ""
+	}
+	return "waiting on " + strings.Join(waiting, ", ")
+}
+
 // CanBeRetried checks if a failed job can be manually retried.
 // This is used when a user explicitly targets a failed job for re-execution.
 func (j *Job) CanBeRetried() bool {
@@ -238,6 +362,9 @@ func (j *Job) CanBeRetried() bool {
 		} else if (j.Type == JobTypeInteractiveAgent || j.Type == JobTypeAgent) && dep.Type == JobTypeChat && dep.Status == JobStatusPendingUser {
 			// Special case: an interactive agent can run if its chat dependency is pending user input.
 			dependencyMet = true
+		} else if dep.Status == JobStatusSkipped && j.AllowSkippedDeps {
+			// A skipped dependency is not completed unless the job opts in.
+			dependencyMet = true
 		}
 
 		if !dependencyMet {
@@ -248,6 +375,51 @@ func (j *Job) CanBeRetried() bool {
 	return true
 }
 
+// ActiveSince reports whether the job started, finished, or was updated at or
+// after t. The job file's modification time is used when no timestamp is set.
+func (j *Job) ActiveSince(t time.Time) bool {
+	hasTimestamp := false
+	for _, ts := range []time.Time{j.StartTime, j.EndTime, j.UpdatedAt, j.CompletedAt} {
+		if ts.IsZero() {
+			continue
+		}
+		hasTimestamp = true
+		if !ts.Before(t) {
+			return true
+		}
+	}
+	if !hasTimestamp && j.FilePath != "" {
+		if info, err := os.Stat(j.FilePath); err == nil && !info.ModTime().Before(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Elapsed returns how long the job ran: the recorded duration for finished
+// jobs, or the time since it started for running ones. Zero means unknown.
+func (j *Job) Elapsed(now time.Time) time.Duration {
+	if j.Duration > 0 {
+		return j.Duration
+	}
+	start := j.StartTime
+	if start.IsZero() {
+		start = j.StartedAt
+	}
+	if start.IsZero() {
+		return 0
+	}
+	switch {
+	case j.Status == JobStatusRunning:
+		return now.Sub(start)
+	case !j.EndTime.IsZero():
+		return j.EndTime.Sub(start)
+	case !j.CompletedAt.IsZero():
+		return j.CompletedAt.Sub(start)
+	}
+	return 0
+}
+
 // UpdateStatus updates the job status using the state persister.
 func (j *Job) UpdateStatus(sp *StatePersister, newStatus JobStatus) error {
 	return sp.UpdateJobStatus(j, newStatus)
@@ -6,8 +6,10 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/grovetools/core/pkg/sessions"
 	"github.com/grovetools/core/util/delegation"
@@ -88,7 +90,7 @@ func AppendAgentTranscript(job *Job, plan *Plan) error {
 		if !strings.Contains(string(content), "# Agent Chat Transcript") && !strings.Contains(string(content), "## Transcript") {
 			note := "\n# Agent Chat Transcript\n\n*This interactive agent job was never run.*"
 			newContent := string(content) + note
-			if writeErr := os.WriteFile(job.FilePath, []byte(newContent), 0644); writeErr != nil {
+			if writeErr := writeFileAtomic(job.FilePath, []byte(newContent)); writeErr != nil {
 				return fmt.Errorf("writing note to job file %s: %w", job.FilePath, writeErr)
 			}
 		}
@@ -150,7 +152,7 @@ func AppendAgentTranscript(job *Job, plan *Plan) error {
 		newContent = string(content) + transcriptHeader + transcriptOutput
 	}
 
-	if err := os.WriteFile(job.FilePath, []byte(newContent), 0644); err != nil {
+	if err := writeFileAtomic(job.FilePath, []byte(newContent)); err != nil {
 		return fmt.Errorf("writing transcript to job file %s: %w", job.FilePath, err)
 	}
 
@@ -238,7 +240,7 @@ func RenameJob(plan *Plan, jobToRename *Job, newTitle string) error {
 			updates["depends_on"] = newDeps
 		}
 
-		// Check and update include files
+		// Check and update include files, keeping any type hints
 		var newInclude []string
 		var includeUpdated bool
 		for _, source := range job.Include {
@@ -250,7 +252,14 @@ func RenameJob(plan *Plan, jobToRename *Job, newTitle string) error {
 			}
 		}
 		if includeUpdated {
-			updates["include"] = newInclude
+			types := make(map[string]string)
+			for path, hint := range job.IncludeTypes {
+				if path == jobToRename.Filename {
+					path = newFilename
+				}
+				types[path] = hint
+			}
+			updates["include"] = includeEntries(newInclude, types)
 		}
 
 		// Only write if there are updates to make
@@ -263,7 +272,7 @@ func RenameJob(plan *Plan, jobToRename *Job, newTitle string) error {
 			if err != nil {
 				return fmt.Errorf("updating references in %s: %w", job.Filename, err)
 			}
-			if err := os.WriteFile(job.FilePath, updatedDepContent, 0644); err != nil {
+			if err := writeFileAtomic(job.FilePath, updatedDepContent); err != nil {
 				return fmt.Errorf("writing updated job file %s: %w", job.Filename, err)
 			}
 		}
@@ -286,6 +295,161 @@ func RenameJob(plan *Plan, jobToRename *Job, newTitle string) error {
 	return nil
 }
 
+// InsertJobAfter adds job to the plan directly after anchor. The new job depends on
+// the anchor and takes the number following the anchor's; later job files are
+// renumbered to make room, and depends_on, include and append-to output
+// references to them are updated. With insert set, jobs that depended on the anchor also depend on the
+// new job.
+func InsertJobAfter(plan *Plan, job *Job, anchor *Job, insert bool) (string, error) {
+	// Renumbering renames job files, which would pull them out from under a run
+	for _, other := range plan.Jobs {
+		if other.Status == JobStatusRunning {
+			return "", fmt.Errorf("cannot insert a job while %s is running", other.Filename)
+		}
+	}
+
+	if err := prepareNewJob(plan, job); err != nil {
+		return "", err
+	}
+
+	prefix := regexp.MustCompile(`^(\d+)-`)
+	matches := prefix.FindStringSubmatch(anchor.Filename)
+	if len(matches) < 2 {
+		return "", fmt.Errorf("could not parse numeric prefix from filename: %s", anchor.Filename)
+	}
+	anchorNum, _ := strconv.Atoi(matches[1])
+	newNum := anchorNum + 1
+
+	// Shift every job numbered at or after the new slot up by one
+	renames := make(map[string]string)
+	numbers := make(map[*Job]int)
+	var shifted []*Job
+	for _, other := range plan.Jobs {
+		m := prefix.FindStringSubmatch(other.Filename)
+		if len(m) < 2 {
+			continue
+		}
+		if num, _ := strconv.Atoi(m[1]); num >= newNum {
+			renames[other.Filename] = fmt.Sprintf("%0*d-%s", len(m[1]), num+1, other.Filename[len(m[0]):])
+			numbers[other] = num
+			shifted = append(shifted, other)
+		}
+	}
+	for _, other := range shifted {
+		dest := renames[other.Filename]
+		if _, taken := renames[dest]; taken {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(plan.Directory, dest)); err == nil {
+			return "", fmt.Errorf("cannot renumber %s: %s already exists", other.Filename, dest)
+		}
+	}
+
+	// Rename from the highest number down so no file is overwritten. If a rename
+	// fails, the ones already done are undone so the plan keeps its numbering.
+	sort.Slice(shifted, func(i, j int) bool { return numbers[shifted[i]] > numbers[shifted[j]] })
+	type rename struct {
+		job                *Job
+		filename, filePath string
+	}
+	var done []rename
+	for _, other := range shifted {
+		newPath := filepath.Join(plan.Directory, renames[other.Filename])
+		if err := os.Rename(other.FilePath, newPath); err != nil {
+			for i := len(done) - 1; i >= 0; i-- {
+				r := done[i]
+				if os.Rename(r.job.FilePath, r.filePath) == nil {
+					r.job.Filename, r.job.FilePath = r.filename, r.filePath
+				}
+			}
+			return "", fmt.Errorf("renumbering %s: %w", other.Filename, err)
+		}
+		done = append(done, rename{job: other, filename: other.Filename, filePath: other.FilePath})
+		other.Filename = renames[other.Filename]
+		other.FilePath = newPath
+	}
+
+	remap := func(refs []string) ([]string, bool) {
+		var changed bool
+		out := make([]string, len(refs))
+		for i, ref := range refs {
+			if renamed, ok := renames[ref]; ok {
+				ref = renamed
+				changed = true
+			}
+			out[i] = ref
+		}
+		return out, changed
+	}
+
+	newFilename := GenerateJobFilename(newNum, job.Title)
+	for _, other := range plan.Jobs {
+		updates := make(map[string]interface{})
+		if deps, changed := remap(other.DependsOn); changed {
+			other.DependsOn = deps
+			updates["depends_on"] = deps
+		}
+		if include, changed := remap(other.Include); changed {
+			if len(other.IncludeTypes) > 0 {
+				types := make(map[string]string)
+				for path, hint := range other.IncludeTypes {
+					if renamed, ok := renames[path]; ok {
+						path = renamed
+					}
+					types[path] = hint
+				}
+				other.IncludeTypes = types
+			}
+			other.Include = include
+			updates["include"] = includeEntries(include, other.IncludeTypes)
+		}
+		if other.Output.Type == OutputTypeAppendTo {
+			if renamed, ok := renames[other.Output.Target]; ok {
+				other.Output.Target = renamed
+				updates["output"] = map[string]interface{}{"target": renamed}
+			}
+		}
+		if insert {
+			for _, dep := range other.DependsOn {
+				if dep == anchor.Filename || dep == anchor.ID {
+					other.DependsOn = append(other.DependsOn, newFilename)
+					updates["depends_on"] = other.DependsOn
+					break
+				}
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+
+		content, err := os.ReadFile(other.FilePath)
+		if err != nil {
+			return "", fmt.Errorf("reading job file %s: %w", other.Filename, err)
+		}
+		updated, err := UpdateFrontmatter(content, updates)
+		if err != nil {
+			return "", fmt.Errorf("updating references in %s: %w", other.Filename, err)
+		}
+		if err := writeFileAtomic(other.FilePath, updated); err != nil {
+			return "", fmt.Errorf("writing updated job file %s: %w", other.Filename, err)
+		}
+	}
+
+	job.DependsOn, _ = remap(job.DependsOn)
+	hasAnchor := false
+	for _, dep := range job.DependsOn {
+		if dep == anchor.Filename || dep == anchor.ID {
+			hasAnchor = true
+			break
+		}
+	}
+	if !hasAnchor {
+		job.DependsOn = append(job.DependsOn, anchor.Filename)
+	}
+
+	return writeNewJob(plan, job, newNum)
+}
+
 // UpdateJobDependencies updates a job's depends_on field in its frontmatter.
 func UpdateJobDependencies(job *Job, newDeps []string) error {
 	// Read current job file content
@@ -301,7 +465,7 @@ func UpdateJobDependencies(job *Job, newDeps []string) error {
 	}
 
 	// Write back to file
-	if err := os.WriteFile(job.FilePath, updatedContent, 0644); err != nil {
+	if err := writeFileAtomic(job.FilePath, updatedContent); err != nil {
 		return fmt.Errorf("writing job file %s: %w", job.Filename, err)
 	}
 
@@ -310,3 +474,58 @@ func UpdateJobDependencies(job *Job, newDeps []string) error {
 
 	return nil
 }
+
+// outputSectionSeparator marks the start of the output appended by appendToJobFile.
+const outputSectionSeparator = "\n\n---\n\n## Output\n\n"
+
+// runStateFields are the frontmatter fields recording a job's last run, cleared
+// when the job is reset to pending.
+var runStateFields = []string{"started_at", "completed_at", "duration", "last_error", "blocked_reason", "skip_reason", "commit_sha", "ran_model", "prompt_hash"}
+
+// ResetJobForRerun sets a job back to pending so it can run again. It clears the
+// timing and error fields recorded by previous runs and removes any appended
+// output sections from the job body, along with any leftover .partial output.
+func ResetJobForRerun(job *Job) error {
+	content, err := os.ReadFile(job.FilePath)
+	if err != nil {
+		return fmt.Errorf("reading job file: %w", err)
+	}
+
+	frontmatter, body, err := ParseFrontmatter(content)
+	if err != nil {
+		return fmt.Errorf("parsing frontmatter: %w", err)
+	}
+
+	frontmatter["status"] = string(JobStatusPending)
+	for _, key := range runStateFields {
+		delete(frontmatter, key)
+	}
+
+	if idx := strings.Index(string(body), outputSectionSeparator); idx != -1 {
+		body = body[:idx]
+	}
+
+	newContent, err := RebuildMarkdownWithFrontmatter(frontmatter, body)
+	if err != nil {
+		return fmt.Errorf("rebuilding job content: %w", err)
+	}
+	if err := writeFileAtomic(job.FilePath, newContent); err != nil {
+		return fmt.Errorf("writing job file: %w", err)
+	}
+
+	os.Remove(partialFileName(job.FilePath))
+
+	job.Status = JobStatusPending
+	job.StartTime = time.Time{}
+	job.EndTime = time.Time{}
+	job.StartedAt = time.Time{}
+	job.CompletedAt = time.Time{}
+	job.Duration = 0
+	job.LastError = ""
+	job.BlockedReason = ""
+	job.SkipReason = ""
+	job.CommitSHA = ""
+	job.RanModel = ""
+	job.PromptHash = ""
+	return nil
+}
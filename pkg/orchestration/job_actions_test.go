@@ -0,0 +1,178 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResetJobForRerun(t *testing.T) {
+	dir := t.TempDir()
+	jobPath := filepath.Join(dir, "01-build.md")
+	content := `---
+id: build
+title: Build
+status: failed
+type: oneshot
+started_at: "2024-01-01T10:00:00Z"
+completed_at: "2024-01-01T10:05:00Z"
+duration: 5m0s
+last_error: timed out after 5m0s
+---
+Build the thing.
+
+---
+
+## Output
+
+partial response
+`
+	if err := os.WriteFile(jobPath, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	job, err := LoadJob(jobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	job.FilePath = jobPath
+
+	if err := ResetJobForRerun(job); err != nil {
+		t.Fatalf("ResetJobForRerun() error = %v", err)
+	}
+	if job.Status != JobStatusPending {
+		t.Errorf("in-memory status = %s, want pending", job.Status)
+	}
+
+	updated, err := os.ReadFile(jobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(updated)
+	for _, gone := range []string{"started_at", "completed_at", "duration", "last_error", "## Output", "partial response"} {
+		if strings.Contains(got, gone) {
+			t.Errorf("reset job file still contains %q:\n%s", gone, got)
+		}
+	}
+	if !strings.Contains(got, "status: pending") || !strings.Contains(got, "Build the thing.") {
+		t.Errorf("reset job file missing status or prompt body:\n%s", got)
+	}
+}
+
+func TestRenameJob_KeepsTypedIncludes(t *testing.T) {
+	plan := loadTestPlan(t, map[string]string{
+		"01-design.md": "---\nid: design\ntitle: Design\nstatus: completed\ntype: oneshot\n---\n",
+		"02-review.md": "---\nid: review\ntitle: Review\nstatus: pending\ntype: oneshot\ninclude:\n  - path: 01-design.md\n    type: text\n  - path: diagram.png\n    type: image\n---\n",
+	})
+	design, _ := plan.GetJobByID("design")
+
+	if err := RenameJob(plan, design, "Architecture"); err != nil {
+		t.Fatalf("RenameJob() error = %v", err)
+	}
+
+	reloaded, err := LoadPlan(plan.Directory)
+	if err != nil {
+		t.Fatalf("reloading plan: %v", err)
+	}
+	review, _ := reloaded.GetJobByID("review")
+	if strings.Join(review.Include, ",") != "01-architecture.md,diagram.png" {
+		t.Errorf("include = %v, want the renamed file and diagram.png", review.Include)
+	}
+	if review.IncludeTypes["01-architecture.md"] != "text" || review.IncludeTypes["diagram.png"] != "image" {
+		t.Errorf("include types = %v, want both type hints kept", review.IncludeTypes)
+	}
+}
+
+func TestInsertJobAfter(t *testing.T) {
+	plan := loadTestPlan(t, map[string]string{
+		"01-design.md": "---\nid: design\ntitle: Design\nstatus: completed\ntype: oneshot\n---\n",
+		"02-build.md":  "---\nid: build\ntitle: Build\nstatus: pending\ntype: oneshot\ndepends_on:\n  - 01-design.md\n---\n",
+		"03-ship.md":   "---\nid: ship\ntitle: Ship\nstatus: pending\ntype: oneshot\ndepends_on:\n  - 02-build.md\noutput:\n  type: append-to\n  target: 02-build.md\n---\n",
+	})
+	anchor, _ := plan.GetJobByID("design")
+
+	job := &Job{ID: "review-design", Title: "Review Design", Type: JobTypeOneshot, Repository: "repo", Branch: "main"}
+	filename, err := InsertJobAfter(plan, job, anchor, true)
+	if err != nil {
+		t.Fatalf("InsertJobAfter() error = %v", err)
+	}
+	if filename != "02-review-design.md" {
+		t.Errorf("filename = %s, want 02-review-design.md", filename)
+	}
+
+	reloaded, err := LoadPlan(plan.Directory)
+	if err != nil {
+		t.Fatalf("reloading plan: %v", err)
+	}
+	build, ok := reloaded.GetJobByFilename("03-build.md")
+	if !ok {
+		t.Fatal("02-build.md should have been renumbered to 03-build.md")
+	}
+	if strings.Join(build.DependsOn, ",") != "01-design.md,02-review-design.md" {
+		t.Errorf("build depends_on = %v, want the anchor and the inserted job", build.DependsOn)
+	}
+	ship, ok := reloaded.GetJobByFilename("04-ship.md")
+	if !ok {
+		t.Fatal("03-ship.md should have been renumbered to 04-ship.md")
+	}
+	if strings.Join(ship.DependsOn, ",") != "03-build.md" {
+		t.Errorf("ship depends_on = %v, want [03-build.md]", ship.DependsOn)
+	}
+	if ship.Output.Type != OutputTypeAppendTo || ship.Output.Target != "03-build.md" {
+		t.Errorf("ship output = %+v, want append-to 03-build.md", ship.Output)
+	}
+	inserted, _ := reloaded.GetJobByID("review-design")
+	if strings.Join(inserted.DependsOn, ",") != "01-design.md" {
+		t.Errorf("inserted depends_on = %v, want [01-design.md]", inserted.DependsOn)
+	}
+}
+
+func TestInsertJobAfter_Refusals(t *testing.T) {
+	files := map[string]string{
+		"01-design.md": "---\nid: design\ntitle: Design\nstatus: completed\ntype: oneshot\n---\n",
+		"02-build.md":  "---\nid: build\ntitle: Build\nstatus: pending\ntype: oneshot\n---\n",
+		"03-ship.md":   "---\nid: ship\ntitle: Ship\nstatus: pending\ntype: oneshot\n---\n",
+	}
+
+	t.Run("running job", func(t *testing.T) {
+		plan := loadTestPlan(t, files)
+		build, _ := plan.GetJobByID("build")
+		build.Status = JobStatusRunning
+		anchor, _ := plan.GetJobByID("design")
+		if _, err := InsertJobAfter(plan, &Job{ID: "review", Title: "Review", Type: JobTypeOneshot}, anchor, false); err == nil {
+			t.Fatal("expected an error while a job is running")
+		}
+	})
+
+	t.Run("rolls back a partial renumbering", func(t *testing.T) {
+		plan := loadTestPlan(t, files)
+		build, _ := plan.GetJobByID("build")
+		build.FilePath = filepath.Join(plan.Directory, "missing.md")
+		anchor, _ := plan.GetJobByID("design")
+		if _, err := InsertJobAfter(plan, &Job{ID: "review", Title: "Review", Type: JobTypeOneshot}, anchor, false); err == nil {
+			t.Fatal("expected a renumbering error")
+		}
+		if _, err := os.Stat(filepath.Join(plan.Directory, "03-ship.md")); err != nil {
+			t.Errorf("03-ship.md should have been renamed back: %v", err)
+		}
+		if ship, _ := plan.GetJobByID("ship"); ship.Filename != "03-ship.md" {
+			t.Errorf("ship filename = %s, want 03-ship.md", ship.Filename)
+		}
+	})
+
+	t.Run("three-digit prefixes", func(t *testing.T) {
+		plan := loadTestPlan(t, map[string]string{
+			"099-design.md": "---\nid: design\ntitle: Design\nstatus: completed\ntype: oneshot\n---\n",
+			"100-build.md":  "---\nid: build\ntitle: Build\nstatus: pending\ntype: oneshot\n---\n",
+		})
+		anchor, _ := plan.GetJobByID("build")
+		filename, err := InsertJobAfter(plan, &Job{ID: "review", Title: "Review", Type: JobTypeOneshot}, anchor, false)
+		if err != nil {
+			t.Fatalf("InsertJobAfter() error = %v", err)
+		}
+		if filename != "101-review.md" {
+			t.Errorf("filename = %s, want 101-review.md", filename)
+		}
+	})
+}
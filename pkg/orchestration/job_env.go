@@ -0,0 +1,34 @@
+package orchestration
+
+import (
+	"os"
+	"sort"
+)
+
+// jobEnvironment returns the environment for a subprocess launched on behalf
+// of job: the current environment, then the plan's env entries, then the
+// job's, then extra. Later entries override earlier ones, so job-level values
+// win over plan-level ones. Either plan or job may be nil.
+func jobEnvironment(plan *Plan, job *Job, extra ...string) []string {
+	env := os.Environ()
+	if plan != nil && plan.Config != nil {
+		env = appendEnvMap(env, plan.Config.Env)
+	}
+	if job != nil {
+		env = appendEnvMap(env, job.Env)
+	}
+	return append(env, extra...)
+}
+
+// appendEnvMap appends vars to env as KEY=value entries in key order.
+func appendEnvMap(env []string, vars map[string]string) []string {
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		env = append(env, key+"="+vars[key])
+	}
+	return env
+}
@@ -0,0 +1,32 @@
+package orchestration
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestJobEnvironment(t *testing.T) {
+	t.Setenv("FLOW_TEST_SHARED", "from-shell")
+	plan := &Plan{Config: &PlanConfig{Env: map[string]string{
+		"FLOW_TEST_SHARED": "from-plan",
+		"FLOW_TEST_PLAN":   "plan-only",
+	}}}
+	job := &Job{Env: map[string]string{"FLOW_TEST_SHARED": "from-job"}}
+
+	cmd := exec.Command("sh", "-c", `printf '%s %s %s' "$FLOW_TEST_SHARED" "$FLOW_TEST_PLAN" "$FLOW_TEST_EXTRA"`)
+	cmd.Env = jobEnvironment(plan, job, "FLOW_TEST_EXTRA=extra")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Skipf("sh not available: %v", err)
+	}
+	if got := strings.TrimSpace(string(out)); got != "from-job plan-only extra" {
+		t.Errorf("subprocess saw %q, want job env to override plan env", got)
+	}
+
+	cmd = exec.Command("sh", "-c", `printf '%s' "$FLOW_TEST_SHARED"`)
+	cmd.Env = jobEnvironment(plan, nil)
+	if out, _ := cmd.Output(); string(out) != "from-plan" {
+		t.Errorf("without a job, subprocess saw %q, want from-plan", out)
+	}
+}
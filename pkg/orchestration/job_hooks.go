@@ -0,0 +1,218 @@
+package orchestration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// onCompleteHookRequired reports whether a failing on_complete hook fails the job.
+// Hooks are required unless the job sets on_complete_required: false.
+func (j *Job) onCompleteHookRequired() bool {
+	return j.OnCompleteRequired == nil || *j.OnCompleteRequired
+}
+
+// jobOutputPath returns the file a completed oneshot job wrote its output to.
+func jobOutputPath(job *Job, plan *Plan) string {
+	if job.Output.Type == OutputTypeAppendTo {
+		if target, ok := plan.GetJobByID(job.Output.Target); ok {
+			return target.FilePath
+		}
+		if target, ok := plan.GetJobByFilename(job.Output.Target); ok {
+			return target.FilePath
+		}
+	}
+	return job.FilePath
+}
+
+// runOnCompleteHook runs the job's on_complete command with `sh -c` in workDir.
+// The job file and output paths are exported as FLOW_JOB_FILE and
+// FLOW_OUTPUT_PATH, and the combined output is written to on_complete.log in the
+// job's artifact directory.
+func runOnCompleteHook(ctx context.Context, job *Job, plan *Plan, workDir string) error {
+	if job.OnComplete == "" {
+		return nil
+	}
+
+	logPath, err := GetJobLogPath(plan, job)
+	if err != nil {
+		return fmt.Errorf("resolving hook log path: %w", err)
+	}
+	logPath = filepath.Join(filepath.Dir(logPath), "on_complete.log")
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return fmt.Errorf("creating hook log: %w", err)
+	}
+	defer logFile.Close()
+
+	fmt.Fprintf(logFile, "# on_complete: %s\n# started: %s\n\n", job.OnComplete, time.Now().Format(time.RFC3339))
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", job.OnComplete)
+	cmd.Dir = workDir
+	cmd.Env = jobEnvironment(plan, job,
+		"FLOW_JOB_ID="+job.ID,
+		"FLOW_JOB_FILE="+job.FilePath,
+		"FLOW_OUTPUT_PATH="+jobOutputPath(job, plan),
+		"FLOW_PLAN_DIR="+plan.Directory,
+	)
+	if job.CommitSHA != "" {
+		cmd.Env = append(cmd.Env, "FLOW_COMMIT_SHA="+job.CommitSHA)
+	}
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("on_complete hook failed (see %s): %w", logPath, err)
+	}
+	return nil
+}
+
+// HookJob is one job's entry in the plan hook template data.
+type HookJob struct {
+	ID       string
+	Title    string
+	Filename string
+	Status   JobStatus
+	Error    string
+}
+
+// PlanHookData is the template data available to the plan hooks in
+// .grove-plan.yml (on_start, on_review, on_finish, on_fail).
+type PlanHookData struct {
+	PlanName     string
+	PlanDir      string
+	NoteRef      string
+	Worktree     string    // Worktree name from the plan config
+	WorktreePath string    // Absolute path of the worktree, if it exists
+	Branch       string    // Branch checked out in the worktree, or the worktree name
+	Jobs         []HookJob // All jobs, in filename order
+	FailedJobs   []HookJob // Jobs with status failed; for on_fail, only those that failed in that run
+}
+
+// NewPlanHookData collects the template data for plan's hooks.
+func NewPlanHookData(plan *Plan) PlanHookData {
+	data := PlanHookData{
+		PlanName: plan.Name,
+		PlanDir:  plan.Directory,
+	}
+	for _, job := range plan.GetJobsSortedByFilename() {
+		if data.NoteRef == "" {
+			data.NoteRef = job.NoteRef
+		}
+		hookJob := HookJob{ID: job.ID, Title: job.Title, Filename: job.Filename, Status: job.Status, Error: job.LastError}
+		data.Jobs = append(data.Jobs, hookJob)
+		if job.Status == JobStatusFailed {
+			data.FailedJobs = append(data.FailedJobs, hookJob)
+		}
+	}
+
+	if plan.Config == nil || plan.Config.Worktree == "" {
+		return data
+	}
+	data.Worktree = plan.Config.Worktree
+	data.Branch = plan.Config.Worktree // Worktree branches are named after the worktree
+
+	gitRoot, err := GetProjectGitRoot(plan.Directory)
+	if err != nil {
+		return data
+	}
+	if idx := strings.Index(gitRoot, "/.grove-worktrees/"); idx != -1 {
+		gitRoot = gitRoot[:idx]
+	}
+	worktreePath := filepath.Join(gitRoot, ".grove-worktrees", data.Worktree)
+	if info, err := os.Stat(worktreePath); err != nil || !info.IsDir() {
+		return data
+	}
+	data.WorktreePath = worktreePath
+	if out, err := exec.Command("git", "-C", worktreePath, "rev-parse", "--abbrev-ref", "HEAD").Output(); err == nil {
+		if branch := strings.TrimSpace(string(out)); branch != "" && branch != "HEAD" {
+			data.Branch = branch
+		}
+	}
+	return data
+}
+
+// RenderHookCommand renders a plan hook command template with data.
+func RenderHookCommand(hookCmd string, data PlanHookData) (string, error) {
+	tmpl, err := template.New("hook").Parse(hookCmd)
+	if err != nil {
+		return "", fmt.Errorf("parsing hook template: %w", err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", fmt.Errorf("rendering hook template: %w", err)
+	}
+	return rendered.String(), nil
+}
+
+// runOnFailHook runs the plan's on_fail hook after a run in which the failed
+// jobs failed. The command is rendered with PlanHookData, whose FailedJobs
+// holds only those jobs, and their IDs and titles are also exported as
+// FLOW_FAILED_JOB_IDS and FLOW_FAILED_JOB_TITLES (comma-separated). Output goes
+// to on_fail.log in the plan's artifact directory.
+func runOnFailHook(ctx context.Context, plan *Plan, failed []*Job) error {
+	if plan.Config == nil || plan.Config.Hooks["on_fail"] == "" {
+		return nil
+	}
+
+	failedThisRun := make(map[string]bool, len(failed))
+	for _, job := range failed {
+		failedThisRun[job.ID] = true
+	}
+	data := NewPlanHookData(plan)
+	var failedJobs []HookJob
+	for _, job := range data.FailedJobs {
+		if failedThisRun[job.ID] {
+			failedJobs = append(failedJobs, job)
+		}
+	}
+	data.FailedJobs = failedJobs
+	if len(data.FailedJobs) == 0 {
+		return nil
+	}
+	var ids, titles []string
+	for _, job := range data.FailedJobs {
+		ids = append(ids, job.ID)
+		titles = append(titles, job.Title)
+	}
+
+	rendered, err := RenderHookCommand(plan.Config.Hooks["on_fail"], data)
+	if err != nil {
+		return fmt.Errorf("on_fail hook: %w", err)
+	}
+
+	artifactsDir := filepath.Join(plan.Directory, ".artifacts")
+	if err := os.MkdirAll(artifactsDir, 0o755); err != nil {
+		return fmt.Errorf("creating artifacts directory: %w", err)
+	}
+	logPath := filepath.Join(artifactsDir, "on_fail.log")
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return fmt.Errorf("creating hook log: %w", err)
+	}
+	defer logFile.Close()
+
+	fmt.Fprintf(logFile, "# on_fail: %s\n# started: %s\n\n", rendered, time.Now().Format(time.RFC3339))
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", rendered)
+	cmd.Dir = plan.Directory
+	cmd.Env = jobEnvironment(plan, nil,
+		"FLOW_PLAN_NAME="+plan.Name,
+		"FLOW_PLAN_DIR="+plan.Directory,
+		"FLOW_FAILED_JOB_IDS="+strings.Join(ids, ","),
+		"FLOW_FAILED_JOB_TITLES="+strings.Join(titles, ","),
+	)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("on_fail hook failed (see %s): %w", logPath, err)
+	}
+	return nil
+}
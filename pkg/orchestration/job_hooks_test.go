@@ -0,0 +1,122 @@
+package orchestration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunOnCompleteHook(t *testing.T) {
+	dir := t.TempDir()
+	plan := &Plan{Directory: dir}
+	job := &Job{ID: "job", FilePath: filepath.Join(dir, "01-job.md"), OnComplete: `echo "$FLOW_JOB_FILE $FLOW_OUTPUT_PATH"`}
+
+	if err := runOnCompleteHook(context.Background(), job, plan, dir); err != nil {
+		t.Fatalf("runOnCompleteHook() error = %v", err)
+	}
+	logContent, err := os.ReadFile(filepath.Join(dir, ".artifacts", "job", "on_complete.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(logContent), job.FilePath+" "+job.FilePath) {
+		t.Errorf("hook output not captured with env vars:\n%s", logContent)
+	}
+
+	job.OnComplete = "exit 3"
+	if err := runOnCompleteHook(context.Background(), job, plan, dir); err == nil {
+		t.Error("expected an error for a failing hook")
+	}
+	if !job.onCompleteHookRequired() {
+		t.Error("hooks should be required by default")
+	}
+	optional := false
+	job.OnCompleteRequired = &optional
+	if job.onCompleteHookRequired() {
+		t.Error("on_complete_required: false should make the hook optional")
+	}
+}
+
+func TestRunOnFailHook(t *testing.T) {
+	dir := t.TempDir()
+	plan := &Plan{
+		Name:      "my-plan",
+		Directory: dir,
+		Config: &PlanConfig{Hooks: map[string]string{
+			"on_fail": `echo "{{.PlanName}} {{range .FailedJobs}}{{.Filename}} {{end}}$FLOW_FAILED_JOB_IDS"`,
+		}},
+		Jobs: []*Job{
+			{ID: "a", Filename: "01-a.md", Status: JobStatusCompleted},
+			{ID: "b", Filename: "02-b.md", Status: JobStatusFailed},
+			{ID: "c", Filename: "03-c.md", Status: JobStatusFailed},
+		},
+	}
+
+	if err := runOnFailHook(context.Background(), plan, plan.Jobs[1:]); err != nil {
+		t.Fatalf("runOnFailHook() error = %v", err)
+	}
+	logContent, err := os.ReadFile(filepath.Join(dir, ".artifacts", "on_fail.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(logContent), "my-plan 02-b.md 03-c.md b,c") {
+		t.Errorf("hook output missing failed jobs:\n%s", logContent)
+	}
+
+	// Jobs that were already failed before the run aren't reported
+	if err := runOnFailHook(context.Background(), plan, plan.Jobs[2:]); err != nil {
+		t.Fatal(err)
+	}
+	logContent, err = os.ReadFile(filepath.Join(dir, ".artifacts", "on_fail.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(logContent), "my-plan 03-c.md c") {
+		t.Errorf("hook output should only list this run's failures:\n%s", logContent)
+	}
+
+	plan.Jobs[1].Status = JobStatusCompleted
+	plan.Jobs[2].Status = JobStatusCompleted
+	os.Remove(filepath.Join(dir, ".artifacts", "on_fail.log"))
+	if err := runOnFailHook(context.Background(), plan, plan.Jobs[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".artifacts", "on_fail.log")); !os.IsNotExist(err) {
+		t.Error("hook should not run when no jobs failed")
+	}
+}
+
+func TestNewPlanHookData(t *testing.T) {
+	plan := &Plan{
+		Name:      "my-plan",
+		Directory: t.TempDir(),
+		Config:    &PlanConfig{Worktree: "my-branch"},
+		Jobs: []*Job{
+			{ID: "b", Filename: "02-b.md", Status: JobStatusFailed, NoteRef: "notes/b.md"},
+			{ID: "a", Filename: "01-a.md", Status: JobStatusCompleted},
+		},
+	}
+
+	data := NewPlanHookData(plan)
+	if data.PlanDir != plan.Directory || data.Worktree != "my-branch" || data.Branch != "my-branch" {
+		t.Errorf("unexpected plan fields: %+v", data)
+	}
+	if data.NoteRef != "notes/b.md" {
+		t.Errorf("NoteRef = %q, want notes/b.md", data.NoteRef)
+	}
+	if len(data.Jobs) != 2 || data.Jobs[0].ID != "a" || data.Jobs[1].Status != JobStatusFailed {
+		t.Errorf("Jobs = %+v, want both jobs in filename order", data.Jobs)
+	}
+	if len(data.FailedJobs) != 1 || data.FailedJobs[0].ID != "b" {
+		t.Errorf("FailedJobs = %+v, want job b", data.FailedJobs)
+	}
+
+	rendered, err := RenderHookCommand("gh pr create --head {{.Branch}} # {{len .Jobs}}", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rendered != "gh pr create --head my-branch # 2" {
+		t.Errorf("rendered = %q", rendered)
+	}
+}
@@ -97,7 +97,7 @@ func AddSummaryToJobFile(job *Job, summary string) error {
 	if err != nil {
 		return fmt.Errorf("update frontmatter with summary: %w", err)
 	}
-	if err := os.WriteFile(job.FilePath, newContent, 0644); err != nil {
+	if err := writeFileAtomic(job.FilePath, newContent); err != nil {
 		return fmt.Errorf("write summary to job file: %w", err)
 	}
 	job.Summary = summary // update in-memory object
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"text/template"
 
@@ -34,7 +35,37 @@ func NewTemplateManager() *TemplateManager {
 }
 
 // FindTemplate searches for a template by name, traversing upwards to find it.
+// The returned template has its extends: parent and include directives resolved.
 func (tm *TemplateManager) FindTemplate(name string) (*JobTemplate, error) {
+	template, err := tm.findTemplate(name)
+	if err != nil {
+		return nil, err
+	}
+	return tm.resolveTemplate(template, []*JobTemplate{template})
+}
+
+// findTemplate locates a template by name without resolving inheritance.
+func (tm *TemplateManager) findTemplate(name string) (*JobTemplate, error) {
+	return tm.findTemplateAfter(name, "")
+}
+
+// findTemplateAfter is findTemplate, but when skipPath is set every location up
+// to and including the template at skipPath is skipped. This lets a template
+// that overrides another of the same name extend the one it shadows.
+func (tm *TemplateManager) findTemplateAfter(name, skipPath string) (*JobTemplate, error) {
+	skipping := skipPath != ""
+	// found reports whether path should be loaded, consuming the skipped prefix
+	found := func(path string) bool {
+		if _, err := os.Stat(path); err != nil {
+			return false
+		}
+		if skipping {
+			skipping = path != skipPath
+			return false
+		}
+		return true
+	}
+
 	// Start from current directory and traverse upwards
 	currentDir, err := os.Getwd()
 	if err != nil {
@@ -45,12 +76,7 @@ func (tm *TemplateManager) FindTemplate(name string) (*JobTemplate, error) {
 	dir := currentDir
 	for {
 		templatePath := filepath.Join(dir, ".grove", "job-templates", name+".md")
-		if _, err := os.Stat(templatePath); err == nil {
-			// Calculate relative path for cleaner display
-			relPath, _ := filepath.Rel(currentDir, templatePath)
-			if relPath == "" {
-				relPath = templatePath
-			}
+		if found(templatePath) {
 			return tm.LoadTemplate(templatePath, name, "project")
 		}
 
@@ -65,7 +91,7 @@ func (tm *TemplateManager) FindTemplate(name string) (*JobTemplate, error) {
 	// 2. Check notebook-scoped templates
 	if notebookTemplatesDir, err := getNotebookTemplatesDir(); err == nil {
 		templatePath := filepath.Join(notebookTemplatesDir, name+".md")
-		if _, err := os.Stat(templatePath); err == nil {
+		if found(templatePath) {
 			return tm.LoadTemplate(templatePath, name, "notebook")
 		}
 	}
@@ -74,19 +100,112 @@ func (tm *TemplateManager) FindTemplate(name string) (*JobTemplate, error) {
 	homeDir, err := os.UserHomeDir()
 	if err == nil {
 		userPath := filepath.Join(homeDir, ".config", "grove", "job-templates", name+".md")
-		if _, err := os.Stat(userPath); err == nil {
+		if found(userPath) {
 			return tm.LoadTemplate(userPath, name, "user")
 		}
 	}
 
 	// 4. Check built-in templates
-	if template, ok := BuiltinTemplates[name]; ok {
+	if template, ok := BuiltinTemplates[name]; ok && !skipping {
 		return template, nil
 	}
 
 	return nil, fmt.Errorf("template '%s' not found", name)
 }
 
+// templateIncludePattern matches {{ include "name" }} directives in a template body.
+var templateIncludePattern = regexp.MustCompile(`\{\{\s*include\s+"([^"]+)"\s*\}\}`)
+
+// ResolveInheritance resolves a loaded template's extends: parent and include
+// directives. It is applied automatically by FindTemplate; use it for templates
+// loaded directly with LoadTemplate.
+func (tm *TemplateManager) ResolveInheritance(t *JobTemplate) (*JobTemplate, error) {
+	return tm.resolveTemplate(t, []*JobTemplate{t})
+}
+
+// resolveTemplate returns a copy of t with its parent's body prepended and its
+// includes expanded. chain holds the templates being resolved, ending with t,
+// to detect cycles.
+func (tm *TemplateManager) resolveTemplate(t *JobTemplate, chain []*JobTemplate) (*JobTemplate, error) {
+	resolved := *t
+	parentName, _ := t.Frontmatter["extends"].(string)
+
+	if parentName != "" {
+		parent, err := tm.resolveReference(parentName, chain)
+		if err != nil {
+			return nil, err
+		}
+
+		// The child's frontmatter wins; unset fields are inherited from the parent.
+		frontmatter := make(map[string]interface{}, len(parent.Frontmatter)+len(t.Frontmatter))
+		for k, v := range parent.Frontmatter {
+			frontmatter[k] = v
+		}
+		for k, v := range t.Frontmatter {
+			frontmatter[k] = v
+		}
+		resolved.Frontmatter = frontmatter
+		if resolved.Type == "" {
+			resolved.Type = parent.Type
+		}
+		if resolved.Domain == "" {
+			resolved.Domain = parent.Domain
+		}
+		resolved.Prompt = strings.TrimRight(parent.Prompt, "\n") + "\n\n" + strings.TrimLeft(t.Prompt, "\n")
+	}
+
+	var includeErr error
+	resolved.Prompt = templateIncludePattern.ReplaceAllStringFunc(resolved.Prompt, func(match string) string {
+		if includeErr != nil {
+			return match
+		}
+		name := templateIncludePattern.FindStringSubmatch(match)[1]
+		included, err := tm.resolveReference(name, chain)
+		if err != nil {
+			includeErr = err
+			return match
+		}
+		return strings.TrimRight(included.Prompt, "\n")
+	})
+	if includeErr != nil {
+		return nil, includeErr
+	}
+
+	return &resolved, nil
+}
+
+// resolveReference finds and resolves a template referenced by extends or
+// include from the last template in chain. A template referencing its own name
+// gets the template it overrides, e.g. a project "code-review" extending the
+// built-in one.
+func (tm *TemplateManager) resolveReference(name string, chain []*JobTemplate) (*JobTemplate, error) {
+	from := chain[len(chain)-1]
+	names := make([]string, 0, len(chain)+1)
+	for _, t := range chain {
+		names = append(names, t.Name)
+	}
+	names = append(names, name)
+
+	var template *JobTemplate
+	var err error
+	if name == from.Name {
+		template, err = tm.findTemplateAfter(name, from.Path)
+	} else {
+		template, err = tm.findTemplate(name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("template '%s' references '%s', which was not found (chain: %s)",
+			from.Name, name, strings.Join(names, " -> "))
+	}
+
+	for _, seen := range chain {
+		if seen.Name == template.Name && seen.Path == template.Path {
+			return nil, fmt.Errorf("template inheritance cycle: %s", strings.Join(names, " -> "))
+		}
+	}
+	return tm.resolveTemplate(template, append(append([]*JobTemplate{}, chain...), template))
+}
+
 // ListTemplates lists all discoverable templates by searching upwards.
 func (tm *TemplateManager) ListTemplates() ([]*JobTemplate, error) {
 	templates := make([]*JobTemplate, 0)
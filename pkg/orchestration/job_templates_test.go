@@ -0,0 +1,94 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTestTemplates(t *testing.T, templates map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	templatesDir := filepath.Join(dir, ".grove", "job-templates")
+	if err := os.MkdirAll(templatesDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range templates {
+		if err := os.WriteFile(filepath.Join(templatesDir, name+".md"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(dir)
+}
+
+func TestFindTemplate_ExtendsAndInclude(t *testing.T) {
+	writeTestTemplates(t, map[string]string{
+		"base":     "---\ntype: oneshot\nmodel: base-model\n---\nShared preamble.\n",
+		"footer":   "---\n---\nShared footer.\n",
+		"reviewer": "---\nextends: base\ndescription: Review\n---\nReview the code.\n\n{{ include \"footer\" }}\n",
+	})
+
+	template, err := NewTemplateManager().FindTemplate("reviewer")
+	if err != nil {
+		t.Fatalf("FindTemplate() error = %v", err)
+	}
+	want := "Shared preamble.\n\nReview the code.\n\nShared footer.\n"
+	if template.Prompt != want {
+		t.Errorf("Prompt = %q, want %q", template.Prompt, want)
+	}
+	if template.Type != "oneshot" || template.Frontmatter["model"] != "base-model" {
+		t.Errorf("parent frontmatter not inherited: type=%q model=%v", template.Type, template.Frontmatter["model"])
+	}
+	if template.Description != "Review" {
+		t.Errorf("Description = %q, want child's description", template.Description)
+	}
+}
+
+func TestFindTemplate_OverrideExtendsShadowed(t *testing.T) {
+	root := t.TempDir()
+	write := func(dir, content string) {
+		t.Helper()
+		templatesDir := filepath.Join(dir, ".grove", "job-templates")
+		if err := os.MkdirAll(templatesDir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(templatesDir, "review.md"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	project := filepath.Join(root, "project")
+	write(root, "---\ntype: oneshot\n---\nShared review steps.\n")
+	write(project, "---\nextends: review\n---\nAlso check the migrations.\n")
+	t.Chdir(project)
+
+	template, err := NewTemplateManager().FindTemplate("review")
+	if err != nil {
+		t.Fatalf("FindTemplate() error = %v", err)
+	}
+	want := "Shared review steps.\n\nAlso check the migrations.\n"
+	if template.Prompt != want {
+		t.Errorf("Prompt = %q, want %q", template.Prompt, want)
+	}
+	if template.Type != "oneshot" {
+		t.Errorf("Type = %q, want the shadowed template's type", template.Type)
+	}
+}
+
+func TestFindTemplate_InheritanceErrors(t *testing.T) {
+	writeTestTemplates(t, map[string]string{
+		"a":      "---\nextends: b\n---\nA\n",
+		"b":      "---\n---\n{{ include \"a\" }}\n",
+		"orphan": "---\nextends: missing-parent\n---\nBody\n",
+	})
+
+	_, err := NewTemplateManager().FindTemplate("a")
+	if err == nil || !strings.Contains(err.Error(), "a -> b -> a") {
+		t.Errorf("expected cycle error naming the chain, got %v", err)
+	}
+
+	_, err = NewTemplateManager().FindTemplate("orphan")
+	if err == nil || !strings.Contains(err.Error(), "orphan -> missing-parent") {
+		t.Errorf("expected missing parent error naming the chain, got %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestJob_ActiveSince(t *testing.T) {
+	now := time.Now()
+	since := now.Add(-time.Hour)
+
+	if !(&Job{CompletedAt: now.Add(-10 * time.Minute)}).ActiveSince(since) {
+		t.Error("job completed 10m ago should be active in the last hour")
+	}
+	if (&Job{UpdatedAt: now.Add(-2 * time.Hour)}).ActiveSince(since) {
+		t.Error("job updated 2h ago should not be active in the last hour")
+	}
+
+	// Falls back to the file's modification time
+	path := filepath.Join(t.TempDir(), "01-job.md")
+	if err := os.WriteFile(path, []byte("---\n---\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if !(&Job{FilePath: path}).ActiveSince(since) {
+		t.Error("recently written job file should count as active")
+	}
+	if (&Job{FilePath: path, UpdatedAt: now.Add(-2 * time.Hour)}).ActiveSince(since) {
+		t.Error("the file's modification time should only be used when no timestamp is set")
+	}
+	old := now.Add(-3 * time.Hour)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+	if (&Job{FilePath: path}).ActiveSince(since) {
+		t.Error("job file modified 3h ago should not count as active")
+	}
+}
+
+func TestJob_Elapsed(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		job  Job
+		want time.Duration
+	}{
+		{"recorded duration", Job{Status: JobStatusCompleted, Duration: 90 * time.Second}, 90 * time.Second},
+		{"running from frontmatter", Job{Status: JobStatusRunning, StartedAt: now.Add(-5 * time.Minute)}, 5 * time.Minute},
+		{"running in memory", Job{Status: JobStatusRunning, StartTime: now.Add(-time.Minute), StartedAt: now.Add(-time.Hour)}, time.Minute},
+		{"failed without duration", Job{Status: JobStatusFailed, StartedAt: now.Add(-3 * time.Minute), CompletedAt: now.Add(-time.Minute)}, 2 * time.Minute},
+		{"never started", Job{Status: JobStatusPending}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.job.Elapsed(now); got != tt.want {
+				t.Errorf("Elapsed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJob_WaitReason(t *testing.T) {
+	failed := &Job{ID: "a", Status: JobStatusFailed}
+	running := &Job{ID: "b", Status: JobStatusRunning}
+	waiting := &Job{ID: "c", Type: JobTypeOneshot, Status: JobStatusPending, Dependencies: []*Job{running}}
+	blocked := &Job{ID: "d", Status: JobStatusBlocked, BlockedReason: "dependency a failed", Dependencies: []*Job{failed}}
+	downstream := &Job{ID: "e", Status: JobStatusPending, Dependencies: []*Job{blocked}}
+
+	if got := waiting.WaitReason(); got != "waiting on b" {
+		t.Errorf("WaitReason() = %q, want %q", got, "waiting on b")
+	}
+	if got := blocked.WaitReason(); got != "dependency a failed" {
+		t.Errorf("WaitReason() = %q, want the recorded reason", got)
+	}
+	if got := running.WaitReason(); got != "" {
+		t.Errorf("WaitReason() for a running job = %q, want empty", got)
+	}
+	if dep := downstream.FailedDependency(); dep != failed {
+		t.Errorf("FailedDependency() = %v, want the failed root job", dep)
+	}
+	if dep := waiting.FailedDependency(); dep != nil {
+		t.Errorf("FailedDependency() = %v, want nil", dep)
+	}
+}
+
+func TestJob_IsRunnable_SkippedDependency(t *testing.T) {
+	skipped := &Job{ID: "fix", Status: JobStatusSkipped, SkipReason: "run_if not met"}
+	strict := &Job{ID: "verify", Type: JobTypeOneshot, Status: JobStatusPending, Dependencies: []*Job{skipped}}
+	lenient := &Job{ID: "report", Type: JobTypeOneshot, Status: JobStatusPending, Dependencies: []*Job{skipped}, AllowSkippedDeps: true}
+
+	if strict.IsRunnable() {
+		t.Error("a skipped dependency should not count as completed")
+	}
+	if got := strict.WaitReason(); got != "waiting on fix" {
+		t.Errorf("WaitReason() = %q, want %q", got, "waiting on fix")
+	}
+	if !lenient.IsRunnable() {
+		t.Error("allow_skipped_deps should treat a skipped dependency as satisfied")
+	}
+	if got := skipped.WaitReason(); got != "run_if not met" {
+		t.Errorf("WaitReason() for a skipped job = %q, want its skip reason", got)
+	}
+}
@@ -17,11 +17,13 @@ import (
 
 // LLMOptions defines configuration for an LLM completion request.
 type LLMOptions struct {
-	Model             string
-	SchemaPath        string   // Path to JSON schema file for structured output
-	WorkingDir        string   // Working directory for the LLM command
-	ContextFiles      []string // Paths to context files (.grove/context, CLAUDE.md)
-	IncludeFiles      []string // Paths to include files from job configuration
+	Model           string
+	SchemaPath      string            // Path to JSON schema file for structured output
+	WorkingDir      string            // Working directory for the LLM command
+	ContextFiles    []string          // Paths to context files (.grove/context, CLAUDE.md)
+	IncludeFiles    []string          // Paths to include files from job configuration
+	Stream          io.Writer         // Receives response chunks (stdout only) as they arrive
+	AttachmentTypes map[string]string // MIME types hinted for include files, keyed by path
 }
 
 // LLMClient defines the interface for LLM interactions.
@@ -62,6 +64,11 @@ func (c *CommandLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, p
 	if opts.SchemaPath != "" {
 		args = append(args, "--schema", opts.SchemaPath)
 	}
+	// Binary include files are passed as typed attachments instead of being inlined
+	includeFiles, binaryFiles := splitBinaryAttachments(opts.IncludeFiles, opts.AttachmentTypes)
+	for _, path := range binaryFiles {
+		args = append(args, "--at", path, opts.AttachmentTypes[path])
+	}
 
 	// Track LLM request start
 	requestStart := time.Now()
@@ -80,17 +87,16 @@ func (c *CommandLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, p
 		Field("schema_path", opts.SchemaPath).
 		Log(ctx)
 
-	// Build full prompt with all file contents
-	// Note: This is for non-Gemini models that don't support file attachments
+	// Build full prompt with the contents of all text files
 	var fullPrompt strings.Builder
 
 	// First add include files if any
-	if len(opts.IncludeFiles) > 0 {
+	if len(includeFiles) > 0 {
 		ulog.Debug("Adding include files").
-			Field("count", len(opts.IncludeFiles)).
+			Field("count", len(includeFiles)).
 			Log(ctx)
 
-		for i, sourceFile := range opts.IncludeFiles {
+		for i, sourceFile := range includeFiles {
 			ulog.Debug("Adding include file").
 				Field("file", sourceFile).
 				Log(ctx)
@@ -120,7 +126,7 @@ func (c *CommandLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, p
 				Field("file", contextFile).
 				Log(ctx)
 
-			if i > 0 || opts.IncludeFiles != nil {
+			if i > 0 || includeFiles != nil {
 				fullPrompt.WriteString("\n\n")
 			}
 			fullPrompt.WriteString(fmt.Sprintf("=== Context from %s ===\n", filepath.Base(contextFile)))
@@ -202,9 +208,10 @@ func (c *CommandLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, p
 			Log(ctx)
 	}
 
-	// Propagate request ID to child process via environment
+	// Pass plan/job env vars and the request ID to the child process
+	execCmd.Env = jobEnvironment(plan, job)
 	if requestID != "" {
-		execCmd.Env = append(os.Environ(), "GROVE_REQUEST_ID="+requestID)
+		execCmd.Env = append(execCmd.Env, "GROVE_REQUEST_ID="+requestID)
 	}
 
 	// Pipe full prompt (with all file contents) to stdin
@@ -231,17 +238,20 @@ func (c *CommandLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, p
 
 	// Capture output and stream to the provided writer
 	var stdout, stderr bytes.Buffer
+	stdoutWriters := []io.Writer{&stdout, output}
+	stderrWriters := []io.Writer{&stderr, output}
 	if logFile != nil {
 		// Tee output to buffers, log file, and the live output writer
-		execCmd.Stdout = io.MultiWriter(&stdout, logFile, output)
-		execCmd.Stderr = io.MultiWriter(&stderr, logFile, output)
-	} else {
-		// Fallback if log file creation failed
-		execCmd.Stdout = io.MultiWriter(&stdout, output)
-		execCmd.Stderr = io.MultiWriter(&stderr, output)
+		stdoutWriters = append(stdoutWriters, logFile)
+		stderrWriters = append(stderrWriters, logFile)
+	}
+	if opts.Stream != nil {
+		stdoutWriters = append(stdoutWriters, opts.Stream)
 	}
+	execCmd.Stdout = io.MultiWriter(stdoutWriters...)
+	execCmd.Stderr = io.MultiWriter(stderrWriters...)
 
-	if err := execCmd.Run(); err != nil {
+	if err := runCommandWithContext(ctx, execCmd); err != nil {
 		duration := time.Since(startTime)
 		ulog.Error("LLM request failed").
 			Field("request_id", requestID).
@@ -250,6 +260,9 @@ func (c *CommandLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, p
 			Field("stderr", stderr.String()).
 			Field("model", opts.Model).
 			Log(ctx)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return "", fmt.Errorf("llm command killed: %w", ctxErr)
+		}
 		return "", fmt.Errorf("llm command failed: %s: %w", stderr.String(), err)
 	}
 
@@ -271,3 +284,28 @@ func (c *CommandLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, p
 
 	return stdout.String(), nil
 }
+
+// runCommandWithContext runs cmd and kills the process if ctx is done before it exits.
+// The command may not have been created with exec.CommandContext, so cancellation
+// is enforced here rather than relying on the builder.
+func runCommandWithContext(ctx context.Context, cmd *exec.Cmd) error {
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if cmd.Process != nil {
+			cmd.Process.Kill()
+		}
+		<-done
+		return ctx.Err()
+	}
+}
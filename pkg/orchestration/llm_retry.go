@@ -0,0 +1,102 @@
+package orchestration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grovetools/core/tui/theme"
+)
+
+// defaultRetryBackoff is the initial delay between LLM retries when none is configured.
+const defaultRetryBackoff = 2 * time.Second
+
+// errEmptyResponse is returned when the LLM replies with nothing but whitespace.
+// It is retried like a transient failure.
+var errEmptyResponse = errors.New("empty response (the model returned no output)")
+
+// nonRetryableErrorMarkers identify errors that will fail the same way on every attempt.
+var nonRetryableErrorMarkers = []string{
+	"prompt too large",
+	"prompt is too long",
+	"exceeds maximum",
+	"context length",
+	"api key",
+	"unauthorized",
+	"unauthenticated",
+	"authentication",
+	"permission denied",
+	"forbidden",
+	"status 401",
+	"status 403",
+	"401 unauthorized",
+	"403 forbidden",
+}
+
+// isRetryableLLMError reports whether an LLM error is worth retrying.
+// Errors are assumed to be transient (network failures, 429s, 5xx) unless they
+// match a known permanent failure such as an oversized prompt or bad credentials.
+func isRetryableLLMError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, marker := range nonRetryableErrorMarkers {
+		if strings.Contains(msg, marker) {
+			return false
+		}
+	}
+	return true
+}
+
+// completeWithRetry runs call, retrying transient failures with exponential backoff
+// up to retries additional attempts. An empty or whitespace-only response counts
+// as a failure. The job stays in its current (running) status while retries are
+// in progress.
+func (e *OneShotExecutor) completeWithRetry(ctx context.Context, job *Job, retries int, call func(ctx context.Context) (string, error)) (string, error) {
+	backoff := e.config.RetryBackoff
+	if backoff <= 0 {
+		backoff = defaultRetryBackoff
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			delay := backoff * time.Duration(1<<(attempt-1))
+			ulog.Warn("Retrying LLM request").
+				Err(lastErr).
+				Field("job_id", job.ID).
+				Field("attempt", attempt+1).
+				Field("max_attempts", retries+1).
+				Field("delay", delay.String()).
+				Pretty(theme.DefaultTheme.Warning.Render(fmt.Sprintf("%s LLM request failed, retrying in %s (attempt %d/%d): %v",
+					theme.IconWarning, delay, attempt+1, retries+1, lastErr))).
+				Log(ctx)
+
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		response, err := call(ctx)
+		if err == nil && strings.TrimSpace(response) == "" {
+			err = errEmptyResponse
+		}
+		if err == nil {
+			return response, nil
+		}
+		lastErr = err
+		if !isRetryableLLMError(err) {
+			break
+		}
+	}
+
+	return "", lastErr
+}
@@ -0,0 +1,110 @@
+package orchestration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableLLMError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"rate limited", errors.New("googleapi: Error 429: Resource has been exhausted"), true},
+		{"connection reset", errors.New("read tcp: connection reset by peer"), true},
+		{"server error", errors.New("503 Service Unavailable"), true},
+		{"prompt too large", errors.New("prompt too large for model"), false},
+		{"bad api key", errors.New("resolving Anthropic API key: not found"), false},
+		{"unauthorized", errors.New("401 Unauthorized"), false},
+		{"forbidden status", errors.New("request failed with status 403"), false},
+		{"status code in text", errors.New("timeout after 4010ms fetching chunk 403"), true},
+		{"canceled", context.Canceled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableLLMError(tt.err); got != tt.want {
+				t.Errorf("isRetryableLLMError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOneShotExecutor_CompleteWithRetry(t *testing.T) {
+	job := &Job{ID: "retry-job"}
+
+	t.Run("succeeds after transient failures", func(t *testing.T) {
+		executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{
+			RetryBackoff: time.Millisecond,
+		})
+		attempts := 0
+		response, err := executor.completeWithRetry(context.Background(), job, 3, func(ctx context.Context) (string, error) {
+			attempts++
+			if attempts < 3 {
+				return "", errors.New("429 too many requests")
+			}
+			return "ok", nil
+		})
+		if err != nil {
+			t.Fatalf("completeWithRetry() error = %v", err)
+		}
+		if response != "ok" || attempts != 3 {
+			t.Errorf("got response %q after %d attempts, want \"ok\" after 3", response, attempts)
+		}
+	})
+
+	t.Run("gives up after configured retries", func(t *testing.T) {
+		executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{
+			RetryBackoff: time.Millisecond,
+		})
+		attempts := 0
+		_, err := executor.completeWithRetry(context.Background(), job, 2, func(ctx context.Context) (string, error) {
+			attempts++
+			return "", errors.New("connection refused")
+		})
+		if err == nil {
+			t.Fatal("expected an error after exhausting retries")
+		}
+		if attempts != 3 {
+			t.Errorf("attempts = %d, want 3", attempts)
+		}
+	})
+
+	t.Run("does not retry permanent failures", func(t *testing.T) {
+		executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{
+			RetryBackoff: time.Millisecond,
+		})
+		attempts := 0
+		_, err := executor.completeWithRetry(context.Background(), job, 5, func(ctx context.Context) (string, error) {
+			attempts++
+			return "", errors.New("401 Unauthorized")
+		})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if attempts != 1 {
+			t.Errorf("attempts = %d, want 1", attempts)
+		}
+	})
+
+	t.Run("retries empty responses", func(t *testing.T) {
+		executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{
+			RetryBackoff: time.Millisecond,
+		})
+		attempts := 0
+		_, err := executor.completeWithRetry(context.Background(), job, 1, func(ctx context.Context) (string, error) {
+			attempts++
+			return " \n\t", nil
+		})
+		if !errors.Is(err, errEmptyResponse) {
+			t.Fatalf("completeWithRetry() error = %v, want errEmptyResponse", err)
+		}
+		if attempts != 2 {
+			t.Errorf("attempts = %d, want 2", attempts)
+		}
+	})
+}
@@ -53,7 +53,9 @@ func LoadPlan(dir string) (*Plan, error) {
 		return nil, fmt.Errorf("reading plan directory: %w", err)
 	}
 
-	// Load each job file
+	// Load each job file, collecting every file that shares an ID
+	idFiles := make(map[string][]string)
+	var duplicateIDs []string
 	for _, entry := range entries {
 		if entry.IsDir() {
 			continue
@@ -83,12 +85,21 @@ func LoadPlan(dir string) (*Plan, error) {
 		// Add to plan
 		plan.Jobs = append(plan.Jobs, job)
 		if job.ID != "" {
-			if existing, exists := plan.JobsByID[job.ID]; exists {
-				return nil, fmt.Errorf("duplicate job ID %q in files %s and %s",
-					job.ID, existing.Filename, job.Filename)
+			idFiles[job.ID] = append(idFiles[job.ID], job.Filename)
+			if len(idFiles[job.ID]) == 2 {
+				duplicateIDs = append(duplicateIDs, job.ID)
 			}
-			plan.JobsByID[job.ID] = job
+			if _, exists := plan.JobsByID[job.ID]; !exists {
+				plan.JobsByID[job.ID] = job
+			}
+		}
+	}
+	if len(duplicateIDs) > 0 {
+		dupErr := &DuplicateJobIDError{IDs: duplicateIDs, Files: make(map[string][]string)}
+		for _, id := range duplicateIDs {
+			dupErr.Files[id] = idFiles[id]
 		}
+		return nil, dupErr
 	}
 
 	// Resolve dependencies
@@ -99,6 +110,22 @@ func LoadPlan(dir string) (*Plan, error) {
 	return plan, nil
 }
 
+// DuplicateJobIDError is returned by LoadPlan when several job files share an
+// ID, which would make depends_on references ambiguous.
+type DuplicateJobIDError struct {
+	IDs   []string            // Duplicated IDs, in the order they were found
+	Files map[string][]string // Files using each duplicated ID, in filename order
+}
+
+func (e *DuplicateJobIDError) Error() string {
+	parts := make([]string, len(e.IDs))
+	for i, id := range e.IDs {
+		files := e.Files[id]
+		parts[i] = fmt.Sprintf("duplicate job ID %q in files %s and %s", id, strings.Join(files[:len(files)-1], ", "), files[len(files)-1])
+	}
+	return strings.Join(parts, "; ") + "; run 'flow plan fix-ids' to give the copies new IDs"
+}
+
 // ErrNotAJob is returned when a file is not a valid job file
 type ErrNotAJob struct {
 	Reason string
@@ -132,6 +159,13 @@ func LoadJob(filepath string) (*Job, error) {
 		PromptBody: sanitize.UTF8(body),
 	}
 
+	// Typed include entries ({path, type}) are flattened to paths here
+	includeTypes, err := extractIncludeTypes(frontmatter)
+	if err != nil {
+		return nil, fmt.Errorf("parsing include: %w", err)
+	}
+	job.IncludeTypes = includeTypes
+
 	// Marshal frontmatter to YAML and unmarshal to Job struct
 	// This handles the type conversions properly
 	yamlBytes, err := yaml.Marshal(frontmatter)
@@ -166,7 +200,7 @@ func LoadJob(filepath string) (*Job, error) {
 	switch job.Status {
 	case JobStatusPending, JobStatusRunning, JobStatusCompleted,
 		JobStatusFailed, JobStatusBlocked, JobStatusNeedsReview, JobStatusPendingUser,
-		JobStatusPendingLLM, JobStatusHold, JobStatusTodo, JobStatusAbandoned, JobStatusIdle:
+		JobStatusPendingLLM, JobStatusHold, JobStatusTodo, JobStatusAbandoned, JobStatusIdle, JobStatusSkipped:
 		// Valid status
 	default:
 		return nil, fmt.Errorf("invalid job status: %s", job.Status)
@@ -216,7 +250,7 @@ func (p *Plan) ResolveDependencies() error {
 		if job == nil || job.ID == "" {
 			continue
 		}
-		if err := p.checkCycles(job.ID, visited, recStack); err != nil {
+		if err := p.checkCycles(job.ID, visited, recStack, nil); err != nil {
 			return err
 		}
 	}
@@ -224,10 +258,12 @@ func (p *Plan) ResolveDependencies() error {
 	return nil
 }
 
-// checkCycles uses DFS to detect circular dependencies.
-func (p *Plan) checkCycles(jobID string, visited, recStack map[string]bool) error {
+// checkCycles uses DFS to detect circular dependencies. path holds the jobs on the
+// current DFS branch so a cycle can be reported in full.
+func (p *Plan) checkCycles(jobID string, visited, recStack map[string]bool, path []string) error {
 	visited[jobID] = true
 	recStack[jobID] = true
+	path = append(path, jobID)
 
 	job := p.JobsByID[jobID]
 	if job == nil {
@@ -241,12 +277,12 @@ func (p *Plan) checkCycles(jobID string, visited, recStack map[string]bool) erro
 		}
 		depID := dep.ID
 		if !visited[depID] {
-			if err := p.checkCycles(depID, visited, recStack); err != nil {
+			if err := p.checkCycles(depID, visited, recStack, path); err != nil {
 				return err
 			}
 		} else if recStack[depID] {
-			// Found a cycle
-			return fmt.Errorf("circular dependency detected: %s -> %s", jobID, depID)
+			// Found a cycle; it runs from depID's position on the path back to depID
+			return p.cycleError(path, depID)
 		}
 	}
 
@@ -254,6 +290,30 @@ func (p *Plan) checkCycles(jobID string, visited, recStack map[string]bool) erro
 	return nil
 }
 
+// cycleError describes the cycle that closes at depID, naming every job in it.
+func (p *Plan) cycleError(path []string, depID string) error {
+	start := 0
+	for i, id := range path {
+		if id == depID {
+			start = i
+			break
+		}
+	}
+	cycle := append(append([]string{}, path[start:]...), depID)
+
+	files := make([]string, 0, len(cycle)-1)
+	for _, id := range cycle[:len(cycle)-1] {
+		if job := p.JobsByID[id]; job != nil && job.Filename != "" {
+			files = append(files, job.Filename)
+		} else {
+			files = append(files, id)
+		}
+	}
+
+	return fmt.Errorf("circular dependency detected: %s (in %s); remove one of these depends_on entries to break the cycle",
+		strings.Join(cycle, " -> "), strings.Join(files, ", "))
+}
+
 // GetRunnableJobs returns all jobs that can currently be executed.
 func (p *Plan) GetRunnableJobs() []*Job {
 	var runnable []*Job
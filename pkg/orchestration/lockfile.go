@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+
+	"github.com/grovetools/core/pkg/process"
 )
 
 // lockFileName returns the path for a job's lock file.
@@ -42,3 +44,37 @@ func ReadLockFile(jobFilePath string) (int, error) {
 	}
 	return pid, nil
 }
+
+// staleLockPID reports whether a job has a lock file whose process is no longer
+// alive, returning the PID it recorded.
+func staleLockPID(jobFilePath string) (int, bool) {
+	pid, err := ReadLockFile(jobFilePath)
+	if err != nil {
+		return 0, false
+	}
+	return pid, !process.IsProcessAlive(pid)
+}
+
+// RecoverStaleLock cleans up after a run that was killed without removing its
+// lock: if the lock's PID is dead, the lock is removed and a `running` job is
+// reset to `pending`. It reports whether a stale lock was found. Interactive
+// agent jobs are skipped because their lock holds a tmux PID and their liveness
+// is tracked through sessions instead.
+func RecoverStaleLock(job *Job) (bool, error) {
+	if job.Type == JobTypeInteractiveAgent || job.Type == JobTypeAgent {
+		return false, nil
+	}
+	if _, stale := staleLockPID(job.FilePath); !stale {
+		return false, nil
+	}
+	if err := RemoveLockFile(job.FilePath); err != nil {
+		return true, fmt.Errorf("removing stale lock file: %w", err)
+	}
+	if job.Status == JobStatusRunning {
+		job.Status = JobStatusPending
+		if err := updateJobFile(job); err != nil {
+			return true, fmt.Errorf("resetting job status: %w", err)
+		}
+	}
+	return true, nil
+}
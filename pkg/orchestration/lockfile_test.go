@@ -0,0 +1,49 @@
+package orchestration
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecoverStaleLock(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "01-job.md")
+	if err := os.WriteFile(path, []byte("---\nid: job\nstatus: running\ntype: oneshot\n---\nBody\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	job := &Job{ID: "job", Type: JobTypeOneshot, Status: JobStatusRunning, FilePath: path}
+
+	// A live lock is left alone
+	if err := CreateLockFile(path, os.Getpid()); err != nil {
+		t.Fatal(err)
+	}
+	if recovered, err := RecoverStaleLock(job); err != nil || recovered {
+		t.Fatalf("RecoverStaleLock() with live PID = %v, %v; want false, nil", recovered, err)
+	}
+
+	// A lock naming a dead process is removed and the job reset
+	exited := exec.Command("true")
+	if err := exited.Run(); err != nil {
+		t.Skip("cannot start a helper process")
+	}
+	if err := CreateLockFile(path, exited.Process.Pid); err != nil {
+		t.Fatal(err)
+	}
+	recovered, err := RecoverStaleLock(job)
+	if err != nil || !recovered {
+		t.Fatalf("RecoverStaleLock() with dead PID = %v, %v; want true, nil", recovered, err)
+	}
+	if _, err := ReadLockFile(path); !os.IsNotExist(err) {
+		t.Error("stale lock file should have been removed")
+	}
+	if job.Status != JobStatusPending {
+		t.Errorf("job status = %s, want pending", job.Status)
+	}
+	content, _ := os.ReadFile(path)
+	if !strings.Contains(string(content), "status: pending") {
+		t.Errorf("job file status not reset:\n%s", content)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"time"
 )
 
 // GetJobLogPath returns the path to the log file for a given job.
@@ -25,3 +27,44 @@ func GetJobLogPath(plan *Plan, job *Job) (string, error) {
 	logPath := filepath.Join(jobArtifactDir, "job.log")
 	return logPath, nil
 }
+
+// FindJobLogs returns the log files written for a job, oldest first: the
+// job.log and hook logs under .artifacts/<job.ID>, and the <job.ID>-*.log files
+// (LLM and cx output) in the log directories ResolveLogDirectory may choose.
+// Unlike GetJobLogPath it does not create any directories.
+func FindJobLogs(plan *Plan, job *Job) ([]string, error) {
+	if plan == nil || job == nil {
+		return nil, fmt.Errorf("plan and job are required")
+	}
+
+	patterns := []string{
+		filepath.Join(plan.Directory, ".artifacts", job.ID, "*.log"),
+		filepath.Join(plan.Directory, ".logs", job.ID+"-*.log"),
+	}
+	if cwd, err := os.Getwd(); err == nil {
+		patterns = append(patterns, filepath.Join(cwd, ".grove", "logs", plan.Name, job.ID+"-*.log"))
+	}
+
+	seen := make(map[string]bool)
+	var logs []string
+	modTimes := make(map[string]time.Time)
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil || info.IsDir() || seen[match] {
+				continue
+			}
+			seen[match] = true
+			modTimes[match] = info.ModTime()
+			logs = append(logs, match)
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		return modTimes[logs[i]].Before(modTimes[logs[j]])
+	})
+	return logs, nil
+}
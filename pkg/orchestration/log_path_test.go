@@ -0,0 +1,41 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFindJobLogs(t *testing.T) {
+	planDir := t.TempDir()
+	plan := &Plan{Name: "find-logs-test", Directory: planDir}
+	job := &Job{ID: "review"}
+
+	files := []string{
+		filepath.Join(planDir, ".logs", "review-101500-llm.log"),
+		filepath.Join(planDir, ".artifacts", "review", "job.log"),
+		filepath.Join(planDir, ".logs", "other-101500-llm.log"),
+	}
+	base := time.Now().Add(-time.Hour)
+	for i, file := range files {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte("log"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		modTime := base.Add(time.Duration(i) * time.Minute)
+		if err := os.Chtimes(file, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	logs, err := FindJobLogs(plan, job)
+	if err != nil {
+		t.Fatalf("FindJobLogs() error = %v", err)
+	}
+	if len(logs) != 2 || logs[0] != files[0] || logs[1] != files[1] {
+		t.Errorf("FindJobLogs() = %v, want %v oldest first", logs, files[:2])
+	}
+}
@@ -2,9 +2,12 @@ package orchestration
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -12,13 +15,12 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/mattn/go-isatty"
 	grovecontext "github.com/grovetools/cx/pkg/context"
-	"github.com/grovetools/grove-anthropic/pkg/anthropic"
-	anthropicconfig "github.com/grovetools/grove-anthropic/pkg/config"
 	anthropicmodels "github.com/grovetools/grove-anthropic/pkg/models"
 	"github.com/grovetools/core/git"
 	grovelogging "github.com/grovetools/core/logging"
@@ -57,9 +59,18 @@ type ExecutorConfig struct {
 	MaxPromptLength int
 	Timeout         time.Duration
 	RetryCount      int
+	RetryBackoff    time.Duration // Initial delay between retries, doubled after each attempt
 	Model           string
 	ModelOverride   string // Override model from CLI
+	TimeoutOverride time.Duration // Override timeout from CLI
+	RetryOverride   *int          // Override retry count from CLI
 	SkipInteractive bool   // Skip interactive prompts
+	DryRun          bool   // Assemble and print prompts without calling the LLM
+	ForceContext    bool   // Regenerate context for every job, bypassing the context cache
+	MaxTurns        int    // Override the max_turns of chat jobs from CLI
+	ContextFiles    []string // Extra files attached to every job's prompt (--context-file)
+	NoContext       bool     // Skip context generation and omit .grove/context and CLAUDE.md
+	SaveRawOutput   bool     // Save the verbatim LLM response to a sidecar in the log directory
 }
 
 // OneShotExecutor executes oneshot jobs.
@@ -68,7 +79,7 @@ type OneShotExecutor struct {
 	config          *ExecutorConfig
 	worktreeManager *git.WorktreeManager
 	geminiRunner    *gemini.RequestRunner
-	anthropicRunner *anthropic.RequestRunner
+	anthropicClient *AnthropicLLMClient
 }
 
 // NewOneShotExecutor creates a new oneshot executor.
@@ -87,7 +98,7 @@ func NewOneShotExecutor(llmClient LLMClient, config *ExecutorConfig) *OneShotExe
 		config:          config,
 		worktreeManager: git.NewWorktreeManager(),
 		geminiRunner:    gemini.NewRequestRunner(),
-		anthropicRunner: anthropic.NewRequestRunner(),
+		anthropicClient: NewAnthropicLLMClient(),
 	}
 }
 
@@ -113,31 +124,43 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 		return e.executeChatJob(ctx, job, plan, output)
 	}
 
-	// Create lock file with the current process's PID.
-	if err := CreateLockFile(job.FilePath, os.Getpid()); err != nil {
-		return fmt.Errorf("failed to create lock file: %w", err)
-	}
-	// Ensure lock file is removed when execution finishes.
-	defer RemoveLockFile(job.FilePath)
+	// Dry runs leave the job file untouched, so skip locking and status updates.
+	if !e.config.DryRun {
+		// Clear a lock left behind by a run that was killed before it could clean up
+		if recovered, err := RecoverStaleLock(job); err != nil {
+			return fmt.Errorf("recovering stale lock: %w", err)
+		} else if recovered {
+			ulog.Warn("Removed stale lock file from a previous run").
+				Field("job_id", job.ID).
+				Log(ctx)
+		}
 
-	// Update job status to running
-	job.Status = JobStatusRunning
-	job.StartTime = time.Now()
-	if err := updateJobFile(job); err != nil {
-		return fmt.Errorf("updating job status: %w", err)
+		// Create lock file with the current process's PID.
+		if err := CreateLockFile(job.FilePath, os.Getpid()); err != nil {
+			return fmt.Errorf("failed to create lock file: %w", err)
+		}
+		// Ensure lock file is removed when execution finishes.
+		defer RemoveLockFile(job.FilePath)
+
+		// Update job status to running
+		job.Status = JobStatusRunning
+		job.StartTime = time.Now()
+		if err := updateJobFile(job); err != nil {
+			return fmt.Errorf("updating job status: %w", err)
+		}
 	}
 
 	var execErr error
 
 	// Determine the working directory for the job
 	var workDir string
-	if job.Worktree != "" {
+	if job.Worktree != "" && e.config.DryRun {
+		workDir = dryRunWorktreePath(ctx, job, plan)
+	} else if job.Worktree != "" {
 		// Prepare git worktree
 		path, err := e.prepareWorktree(ctx, job, plan)
 		if err != nil {
-			job.Status = JobStatusFailed
-			job.EndTime = time.Now()
-			updateJobFile(job)
+			e.markFailed(job)
 			execErr = fmt.Errorf("prepare worktree: %w", err)
 			return execErr
 		}
@@ -157,6 +180,12 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 		}
 	}
 
+	if err := ValidateJobWorkDir(workDir, job); err != nil {
+		e.markFailed(job)
+		execErr = err
+		return execErr
+	}
+
 	// Always regenerate context to ensure oneshot has latest view
 	if err := e.regenerateContextInWorktree(ctx, workDir, "oneshot", job, plan); err != nil {
 		// Log warning but don't fail the job
@@ -186,6 +215,11 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 	// This ensures buildPrompt uses the correct context files
 	workDir = ScopeToSubProject(workDir, job)
 
+	// Replace oversized inlined dependencies with summaries if a summary model is configured
+	if !e.config.DryRun {
+		summarizeLargeDependencies(ctx, job, plan)
+	}
+
 	// We need to gather context files first for BuildXMLPrompt
 	_, _, contextFiles, err := e.buildPrompt(job, plan, workDir)
 	if err != nil {
@@ -196,12 +230,29 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 	// Build the XML prompt and get the list of files to upload
 	prompt, promptSourceFiles, err := BuildXMLPrompt(job, plan, workDir, contextFiles)
 	if err != nil {
-		job.Status = JobStatusFailed
-		job.EndTime = time.Now()
-		updateJobFile(job)
+		e.markFailed(job)
 		execErr = fmt.Errorf("building XML prompt: %w", err)
 		return execErr
 	}
+	promptHash, err := e.promptHash(job, plan, workDir)
+	if err != nil {
+		ulog.Warn("Could not hash prompt; prompt staleness won't be tracked").
+			Err(err).
+			Field("job_id", job.ID).
+			Log(ctx)
+	}
+	promptSourceFiles = append(promptSourceFiles, e.config.ContextFiles...)
+
+	// Enforce the prompt length limit using the job's overflow strategy
+	prompt, promptSourceFiles, contextFiles, budget, err := e.fitPromptToLimit(ctx, job, plan, workDir, prompt, promptSourceFiles, contextFiles)
+	if err != nil {
+		e.markFailed(job)
+		if !e.config.DryRun {
+			recordJobError(job, err)
+		}
+		execErr = fmt.Errorf("prompt too large: %w", err)
+		return execErr
+	}
 
 	// Log the prompt content for debugging
 	ulog.Debug("Built prompt for job").
@@ -214,7 +265,7 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 		Log(ctx)
 
 	// Write the briefing file for auditing (no turnID for oneshot jobs)
-	briefingFilePath, err := WriteBriefingFile(plan, job, prompt, "")
+	briefingFilePath, err := WriteBriefingFile(plan, job, prompt+briefingSizeComment(budget), "")
 	if err != nil {
 		ulog.Error("Failed to write briefing file").
 			Err(err).
@@ -260,6 +311,11 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 			Log(ctx)
 	}
 
+	if e.config.DryRun {
+		printDryRun(output, job, briefingFilePath, prompt, promptSourceFiles, contextFiles)
+		return nil
+	}
+
 	// Set environment for mock testing
 	os.Setenv("GROVE_CURRENT_JOB_PATH", job.FilePath)
 
@@ -274,33 +330,7 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 	}
 
 	// Determine the effective model to use with clear precedence
-	var effectiveModel string
-	var modelSource string
-
-	// 1. CLI flag (highest priority)
-	if e.config.ModelOverride != "" {
-		effectiveModel = e.config.ModelOverride
-		modelSource = "CLI override"
-	} else if job.Model != "" {
-		// 2. Job frontmatter model
-		effectiveModel = job.Model
-		modelSource = "job frontmatter"
-	} else if plan.Config != nil && plan.Config.Model != "" {
-		// 3. Plan config model
-		effectiveModel = plan.Config.Model
-		modelSource = "plan config"
-	} else if plan.Orchestration != nil && plan.Orchestration.OneshotModel != "" {
-		// 4. Global config model
-		effectiveModel = plan.Orchestration.OneshotModel
-		modelSource = "global config"
-	} else {
-		// 5. Hardcoded fallback - use Anthropic default
-		effectiveModel = anthropicmodels.DefaultModel
-		modelSource = "default fallback"
-	}
-
-	// Resolve model aliases (e.g., "claude-sonnet-4-5" -> "claude-sonnet-4-5-20250929")
-	effectiveModel = resolveModelAlias(effectiveModel)
+	effectiveModel, modelSource := e.resolveModel(job, plan)
 
 	logrus.WithFields(logrus.Fields{
 		"job_id":       job.ID,
@@ -308,83 +338,95 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 		"model_source": modelSource,
 	}).Debug("Resolved model for job execution")
 
-	// Call LLM based on model type
-	var response string
-	if effectiveModel == "mock" {
-		// Use mock response for testing
-		response = "This is a mock LLM response for testing purposes."
-		err = nil
-	} else if os.Getenv("GROVE_MOCK_LLM_RESPONSE_FILE") != "" {
-		// Check if mocking is enabled - if so, always use llmClient regardless of model
-		// Use traditional llm command which is mocked
-		llmOpts := LLMOptions{
-			Model:             effectiveModel,
-			WorkingDir:        workDir,
-			ContextFiles:      contextFiles,
-			IncludeFiles: promptSourceFiles,
+	// Snapshot the working tree before calling the LLM so that a commit output
+	// only picks up files changed during this job.
+	var preexistingChanges map[string]bool
+	if job.Output.Type == OutputTypeCommit {
+		if !isGitWorkTree(workDir) {
+			job.Status = JobStatusFailed
+			job.EndTime = time.Now()
+			updateJobFile(job)
+			execErr = fmt.Errorf("output type commit requires a git repository, but %s is not one", workDir)
+			return execErr
 		}
-		response, err = e.llmClient.Complete(ctx, job, plan, prompt, llmOpts, output)
-	} else if strings.HasPrefix(effectiveModel, "gemini") {
-		// Resolve API key here where we have the correct execution context
-		apiKey, geminiErr := geminiconfig.ResolveAPIKey()
-		if geminiErr != nil {
-			// Don't fail immediately, let the runner handle it for a more consistent error
-			apiKey = ""
+		preexistingChanges, err = gitChangedFiles(workDir)
+		if err != nil {
+			job.Status = JobStatusFailed
+			job.EndTime = time.Now()
+			updateJobFile(job)
+			execErr = fmt.Errorf("snapshotting git status: %w", err)
+			return execErr
 		}
-		// Use grove-gemini package for Gemini models
-		opts := gemini.RequestOptions{
-			Model:            effectiveModel,
-			Prompt:           prompt,            // Only template and prompt body
-			PromptFiles:      promptSourceFiles, // Pass resolved source file paths
-			WorkDir:          workDir,
-			SkipConfirmation: e.config.SkipInteractive, // Respect -y flag
-			APIKey:           apiKey, // Pass the resolved API key
-			// Pass context for better logging
-			Caller:   "grove-flow-oneshot",
-			JobID:    job.ID,
-			PlanName: plan.Name,
+	}
+
+	// Call LLM based on model type, bounded by the configured timeout
+	timeout := e.effectiveTimeout(job, plan)
+	llmCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		llmCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	// Stream the response into a .partial sidecar as it arrives. The Gemini
+	// runner only returns complete responses, so those jobs don't get one.
+	var partial *partialOutput
+	if usesGeminiRunner(plan, effectiveModel) {
+		ulog.Info("Gemini models don't stream; output is written when the response completes").
+			Field("job_id", job.ID).
+			Field("model", effectiveModel).
+			Log(ctx)
+	} else if partial, err = newPartialOutput(job.FilePath); err != nil {
+		ulog.Warn("Could not create partial output file").
+			Err(err).
+			Field("job_id", job.ID).
+			Log(ctx)
+	}
+	var stream io.Writer
+	if partial != nil {
+		stream = partial
+	}
+	response, err := e.completeWithRetry(llmCtx, job, e.effectiveRetryCount(job, plan), func(ctx context.Context) (string, error) {
+		if partial != nil {
+			partial.Reset()
 		}
-		response, err = e.geminiRunner.Run(ctx, opts)
-	} else if strings.HasPrefix(effectiveModel, "claude") {
-		// Resolve API key here where we have the correct execution context
-		apiKey, anthropicErr := anthropicconfig.ResolveAPIKey()
-		if anthropicErr != nil {
-			err = fmt.Errorf("resolving Anthropic API key: %w", anthropicErr)
-		} else {
-			// Use grove-anthropic package for Claude models
-			opts := anthropic.RequestOptions{
-				Model:        effectiveModel,
-				Prompt:       prompt,
-				ContextFiles: append(promptSourceFiles, contextFiles...),
-				WorkDir:      workDir,
-				APIKey:       apiKey,
-				MaxTokens:    64000,
-				Caller:       "grove-flow-oneshot",
-				JobID:        job.ID,
-				PlanName:     plan.Name,
-			}
-			if isTUIMode() {
-				fmt.Fprintf(output, "\n%s Calling Anthropic API with model: %s\n\n", theme.IconRobot, effectiveModel)
+		start := time.Now()
+		response, err := e.completeOneshot(ctx, job, plan, prompt, effectiveModel, workDir, promptSourceFiles, contextFiles, output, stream)
+		recordLLMAudit(ctx, job, plan, effectiveModel, prompt, start, response, err)
+		return response, err
+	})
+	if partial != nil {
+		if err != nil {
+			if partialPath := partial.Keep(); partialPath != "" {
+				ulog.Warn("Kept partial output from failed job").
+					Field("job_id", job.ID).
+					Field("partial_file", partialPath).
+					Pretty(theme.DefaultTheme.Warning.Render(fmt.Sprintf("%s Partial output kept at: %s", theme.IconWarning, partialPath))).
+					Log(ctx)
 			}
-			response, err = e.anthropicRunner.Run(ctx, opts)
-		}
-	} else {
-		// Use traditional llm command for other models
-		llmOpts := LLMOptions{
-			Model:             effectiveModel,
-			WorkingDir:        workDir,
-			ContextFiles:      contextFiles,
-			IncludeFiles: promptSourceFiles,
-		}
-		if isTUIMode() {
-			fmt.Fprintf(output, "\n󰚩 Calling Gemini API with model: %s\n\n", effectiveModel)
+		} else {
+			partial.Discard()
 		}
-		response, err = e.llmClient.Complete(ctx, job, plan, prompt, llmOpts, output)
+	}
+	if err != nil && ctx.Err() == nil && errors.Is(llmCtx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out after %s", timeout)
+		job.Status = JobStatusFailed
+		job.EndTime = time.Now()
+		updateJobFile(job)
+		recordJobError(job, err)
+		ulog.Error("LLM completion timed out").
+			Field("request_id", requestID).
+			Field("job_id", job.ID).
+			Field("timeout", timeout.String()).
+			Pretty(theme.DefaultTheme.Error.Render(fmt.Sprintf("%s LLM completion timed out after %s", theme.IconError, timeout))).
+			Log(ctx)
+		execErr = fmt.Errorf("LLM completion: %w", err)
+		return execErr
 	}
 	if err != nil {
 		job.Status = JobStatusFailed
 		job.EndTime = time.Now()
 		updateJobFile(job)
+		recordJobError(job, err)
 		ulog.Error("LLM completion failed").
 			Err(err).
 			Field("request_id", requestID).
@@ -395,12 +437,27 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 		return execErr
 	}
 
-	// Append output to job file
-	if err := e.appendToJobFile(response, job); err != nil {
+	if e.config.SaveRawOutput || job.SaveRawOutput {
+		if rawPath, err := saveRawResponse(plan, job, response); err != nil {
+			ulog.Warn("Failed to save raw LLM response").
+				Err(err).
+				Field("job_id", job.ID).
+				Log(ctx)
+		} else {
+			ulog.Info("Saved raw LLM response").
+				Field("job_id", job.ID).
+				Field("raw_file", rawPath).
+				Pretty("Raw response saved to: " + theme.DefaultTheme.Muted.Render(rawPath)).
+				Log(ctx)
+		}
+	}
+
+	// Process the response according to the job's output type
+	if err := e.processOutput(ctx, response, job, plan, workDir, preexistingChanges); err != nil {
 		job.Status = JobStatusFailed
 		job.EndTime = time.Now()
 		updateJobFile(job)
-		execErr = fmt.Errorf("appending output to job file: %w", err)
+		execErr = fmt.Errorf("processing output: %w", err)
 		return execErr
 	}
 
@@ -413,6 +470,32 @@ func (e *OneShotExecutor) Execute(ctx context.Context, job *Job, plan *Plan) err
 			Err(err).
 			Log(ctx)
 	}
+	if err := recordRunProvenance(job, effectiveModel, promptHash); err != nil {
+		ulog.Warn("Failed to record run model and prompt hash").
+			Err(err).
+			Field("job_id", job.ID).
+			Log(ctx)
+	}
+
+	if err := runOnCompleteHook(ctx, job, plan, workDir); err != nil {
+		if job.onCompleteHookRequired() {
+			job.Status = JobStatusFailed
+			job.EndTime = time.Now()
+			updateJobFile(job)
+			recordJobError(job, err)
+			ulog.Error("on_complete hook failed").
+				Err(err).
+				Field("job_id", job.ID).
+				Pretty(theme.DefaultTheme.Error.Render(fmt.Sprintf("%s %v", theme.IconError, err))).
+				Log(ctx)
+			execErr = err
+			return execErr
+		}
+		ulog.Warn("on_complete hook failed").
+			Err(err).
+			Field("job_id", job.ID).
+			Log(ctx)
+	}
 
 	return nil
 }
@@ -425,6 +508,10 @@ func (e *OneShotExecutor) buildPrompt(job *Job, plan *Plan, worktreePath string)
 	var contextFiles []string      // Context files (.grove/context, CLAUDE.md)
 	var finalPromptBody string
 
+	// Expand variables in the job's own text before dependencies are inlined
+	vars := newPromptVarLookup(job, plan, worktreePath)
+	promptBody := expandPromptVars(context.Background(), job.PromptBody, vars, job)
+
 	// Handle dependencies based on ShouldInline (supports both new inline field and legacy prepend_dependencies)
 	if job.ShouldInline(InlineDependencies) {
 		// Inline dependency content directly into the prompt body
@@ -449,14 +536,20 @@ func (e *OneShotExecutor) buildPrompt(job *Job, plan *Plan, worktreePath string)
 						return "", nil, nil, fmt.Errorf("reading dependency file %s: %w", dep.FilePath, err)
 					}
 					log.WithField("file", dep.Filename).Debug("Inlined dependency")
-					dependencyContentBuilder.WriteString(fmt.Sprintf("\n\n---\n## Context from %s\n\n", dep.Filename))
 					_, depBody, _ := ParseFrontmatter(depContent)
-					dependencyContentBuilder.Write(depBody)
+					body, attrs := limitDependencyBody(job, plan, dep, string(depBody))
+					// Note a summarized or truncated dependency, as BuildXMLPrompt does
+					heading := dep.Filename
+					if attrs != "" {
+						heading += " (" + strings.TrimSpace(attrs) + ")"
+					}
+					dependencyContentBuilder.WriteString(fmt.Sprintf("\n\n---\n## Context from %s\n\n", heading))
+					dependencyContentBuilder.WriteString(body)
 				}
 			}
 			dependencyContentBuilder.WriteString("\n\n---\n\n")
 		}
-		finalPromptBody = dependencyContentBuilder.String() + job.PromptBody
+		finalPromptBody = dependencyContentBuilder.String() + promptBody
 	} else {
 		// Upload dependencies as separate file attachments
 		if len(job.Dependencies) > 0 {
@@ -468,7 +561,7 @@ func (e *OneShotExecutor) buildPrompt(job *Job, plan *Plan, worktreePath string)
 				}
 			}
 		}
-		finalPromptBody = job.PromptBody
+		finalPromptBody = promptBody
 	}
 
 	// Handle source_block reference if present
@@ -497,7 +590,7 @@ func (e *OneShotExecutor) buildPrompt(job *Job, plan *Plan, worktreePath string)
 		}
 
 		// Start XML structure with system instructions
-		parts = append(parts, fmt.Sprintf("<prompt>\n<system_instructions template=\"%s\">\n%s\n</system_instructions>", job.Template, template.Prompt))
+		parts = append(parts, fmt.Sprintf("<prompt>\n<system_instructions template=\"%s\">\n%s\n</system_instructions>", job.Template, expandPromptVars(context.Background(), template.Prompt, vars, job)))
 
 		// If worktree is specified, add a note about the working directory
 		if worktreePath != "" {
@@ -540,14 +633,16 @@ func (e *OneShotExecutor) buildPrompt(job *Job, plan *Plan, worktreePath string)
 		// Add user's prompt/request last with clear marking
 		if strings.TrimSpace(finalPromptBody) != "" {
 			parts = append(parts, fmt.Sprintf("\n<user_request priority=\"high\">\n<instruction>Please focus on addressing the following user request:</instruction>\n<content>\n%s\n</content>\n</user_request>",
-				strings.TrimSpace(finalPromptBody)))
+				wrapUserRequest(plan, strings.TrimSpace(finalPromptBody))))
 		}
 
 		// Collect Grove context files (just paths)
 		// Scope to sub-project if job.Repository is set (for ecosystem worktrees)
 		contextDir := ScopeToSubProject(worktreePath, job)
 
-		if contextDir != "" {
+		if e.config.NoContext {
+			// Context intentionally omitted for this run (--no-context)
+		} else if contextDir != "" {
 			// When using a worktree/context dir, ONLY use context from that directory
 			contextPath := filepath.Join(contextDir, ".grove", "context")
 			if _, err := os.Stat(contextPath); err == nil {
@@ -572,8 +667,7 @@ func (e *OneShotExecutor) buildPrompt(job *Job, plan *Plan, worktreePath string)
 
 		prompt := strings.Join(parts, "\n")
 
-		// Check prompt length (without context files which will be passed separately)
-		// Prompt length check removed - no longer enforcing limits
+		// Prompt length is enforced on the final XML prompt by fitPromptToLimit
 
 		return prompt, promptSourceFiles, contextFiles, nil
 	} else {
@@ -615,14 +709,16 @@ func (e *OneShotExecutor) buildPrompt(job *Job, plan *Plan, worktreePath string)
 
 		// Add job prompt body with clear marking
 		if finalPromptBody != "" {
-			parts = append(parts, fmt.Sprintf("<user_request priority=\"high\">\n<instruction>Please focus on addressing the following user request:</instruction>\n<content>\n%s\n</content>\n</user_request>", finalPromptBody))
+			parts = append(parts, fmt.Sprintf("<user_request priority=\"high\">\n<instruction>Please focus on addressing the following user request:</instruction>\n<content>\n%s\n</content>\n</user_request>", wrapUserRequest(plan, finalPromptBody)))
 		}
 
 		// Collect Grove context files (just paths)
 		// Scope to sub-project if job.Repository is set (for ecosystem worktrees)
 		contextDir := ScopeToSubProject(worktreePath, job)
 
-		if contextDir != "" {
+		if e.config.NoContext {
+			// Context intentionally omitted for this run (--no-context)
+		} else if contextDir != "" {
 			// When using a worktree/context dir, ONLY use context from that directory
 			contextPath := filepath.Join(contextDir, ".grove", "context")
 			if _, err := os.Stat(contextPath); err == nil {
@@ -647,13 +743,524 @@ func (e *OneShotExecutor) buildPrompt(job *Job, plan *Plan, worktreePath string)
 
 		prompt := strings.Join(parts, "\n")
 
-		// Check prompt length (without context files which will be passed separately)
-		// Prompt length check removed - no longer enforcing limits
+		// Prompt length is enforced on the final XML prompt by fitPromptToLimit
 
 		return prompt, promptSourceFiles, contextFiles, nil
 	}
 }
 
+// completeOneshot sends a oneshot prompt to the provider that handles effectiveModel.
+// stream, if non-nil, receives response chunks as they arrive from providers that stream.
+func (e *OneShotExecutor) completeOneshot(ctx context.Context, job *Job, plan *Plan, prompt, effectiveModel, workDir string, promptSourceFiles, contextFiles []string, output, stream io.Writer) (string, error) {
+	var response string
+	var err error
+	attachments := attachmentTypes(job, promptSourceFiles)
+	if effectiveModel == "mock" {
+		// Use mock response for testing
+		response = "This is a mock LLM response for testing purposes."
+	} else if os.Getenv("GROVE_MOCK_LLM_RESPONSE_FILE") != "" {
+		// Check if mocking is enabled - if so, always use llmClient regardless of model
+		// Use traditional llm command which is mocked
+		llmOpts := LLMOptions{
+			Model:           effectiveModel,
+			WorkingDir:      workDir,
+			ContextFiles:    contextFiles,
+			IncludeFiles:    promptSourceFiles,
+			AttachmentTypes: attachments,
+			Stream:          stream,
+		}
+		response, err = e.llmClient.Complete(ctx, job, plan, prompt, llmOpts, output)
+	} else if openAICfg := openAIConfigForPlan(plan); openAICfg.MatchesModel(effectiveModel) {
+		llmOpts := LLMOptions{
+			Model:           effectiveModel,
+			WorkingDir:      workDir,
+			ContextFiles:    contextFiles,
+			IncludeFiles:    promptSourceFiles,
+			AttachmentTypes: attachments,
+			Stream:          stream,
+		}
+		if isTUIMode() {
+			fmt.Fprintf(output, "\n%s Calling OpenAI-compatible API with model: %s\n\n", theme.IconRobot, effectiveModel)
+		}
+		response, err = NewOpenAIClient(openAICfg).Complete(ctx, job, plan, prompt, llmOpts, output)
+	} else if strings.HasPrefix(effectiveModel, "gemini") {
+		// Resolve API key here where we have the correct execution context
+		apiKey, geminiErr := geminiconfig.ResolveAPIKey()
+		if geminiErr != nil {
+			// Don't fail immediately, let the runner handle it for a more consistent error
+			apiKey = ""
+		}
+		// The Gemini runner detects each file's MIME type from its extension,
+		// so typed include files are uploaded under a matching name
+		stagingDir, stageErr := os.MkdirTemp("", "grove-flow-attachments-")
+		if stageErr != nil {
+			return "", fmt.Errorf("creating attachment staging directory: %w", stageErr)
+		}
+		defer os.RemoveAll(stagingDir)
+		promptFiles, stageErr := stageTypedAttachments(promptSourceFiles, attachments, stagingDir)
+		if stageErr != nil {
+			return "", stageErr
+		}
+		// Use grove-gemini package for Gemini models
+		opts := gemini.RequestOptions{
+			Model:            effectiveModel,
+			Prompt:           prompt,      // Only template and prompt body
+			PromptFiles:      promptFiles, // Pass resolved source file paths
+			WorkDir:          workDir,
+			SkipConfirmation: e.config.SkipInteractive, // Respect -y flag
+			APIKey:           apiKey, // Pass the resolved API key
+			// Pass context for better logging
+			Caller:   "grove-flow-oneshot",
+			JobID:    job.ID,
+			PlanName: plan.Name,
+		}
+		response, err = e.geminiRunner.Run(ctx, opts)
+	} else if strings.HasPrefix(effectiveModel, "claude") {
+		llmOpts := LLMOptions{
+			Model:           effectiveModel,
+			WorkingDir:      workDir,
+			ContextFiles:    contextFiles,
+			IncludeFiles:    promptSourceFiles,
+			AttachmentTypes: attachments,
+			Stream:          stream,
+		}
+		if isTUIMode() {
+			fmt.Fprintf(output, "\n%s Calling Anthropic API with model: %s\n\n", theme.IconRobot, effectiveModel)
+		}
+		response, err = e.anthropicClient.Complete(ctx, job, plan, prompt, llmOpts, output)
+	} else {
+		// Use traditional llm command for other models
+		llmOpts := LLMOptions{
+			Model:           effectiveModel,
+			WorkingDir:      workDir,
+			ContextFiles:    contextFiles,
+			IncludeFiles:    promptSourceFiles,
+			AttachmentTypes: attachments,
+			Stream:          stream,
+		}
+		if isTUIMode() {
+			fmt.Fprintf(output, "\n󰚩 Calling Gemini API with model: %s\n\n", effectiveModel)
+		}
+		response, err = e.llmClient.Complete(ctx, job, plan, prompt, llmOpts, output)
+	}
+	return response, err
+}
+
+// usesGeminiRunner reports whether completeOneshot sends effectiveModel through
+// the grove-gemini runner, which does not support streaming.
+func usesGeminiRunner(plan *Plan, effectiveModel string) bool {
+	if effectiveModel == "mock" || os.Getenv("GROVE_MOCK_LLM_RESPONSE_FILE") != "" {
+		return false
+	}
+	if openAIConfigForPlan(plan).MatchesModel(effectiveModel) {
+		return false
+	}
+	return strings.HasPrefix(effectiveModel, "gemini")
+}
+
+// markFailed records a failed status on the job file. Dry runs never touch the job file.
+func (e *OneShotExecutor) markFailed(job *Job) {
+	if e.config.DryRun {
+		return
+	}
+	job.Status = JobStatusFailed
+	job.EndTime = time.Now()
+	updateJobFile(job)
+}
+
+// ModelSource is one level of the model precedence chain for a job.
+type ModelSource struct {
+	Name     string `json:"name"`
+	Value    string `json:"value,omitempty"`
+	Selected bool   `json:"selected"`
+}
+
+// ExplainModel lists every model precedence level for a job, in order: CLI
+// override, job frontmatter, plan config, global config, default. The first level
+// with a value is selected; the effective model is its value with aliases resolved.
+func ExplainModel(job *Job, plan *Plan, modelOverride string) (sources []ModelSource, effectiveModel string) {
+	sources = []ModelSource{
+		{Name: "CLI override", Value: modelOverride},
+		{Name: "job frontmatter", Value: job.Model},
+		{Name: "plan config"},
+		{Name: "global config"},
+		{Name: "default fallback", Value: anthropicmodels.DefaultModel},
+	}
+	if plan.Config != nil {
+		sources[2].Value = plan.Config.Model
+	}
+	if plan.Orchestration != nil {
+		sources[3].Value = plan.Orchestration.OneshotModel
+	}
+
+	for i := range sources {
+		if sources[i].Value != "" {
+			sources[i].Selected = true
+			// Resolve model aliases (e.g., "claude-sonnet-4-5" -> "claude-sonnet-4-5-20250929")
+			return sources, resolveModelAlias(sources[i].Value)
+		}
+	}
+	return sources, ""
+}
+
+// resolveModel determines the model for a job and where it came from, in order of
+// precedence: CLI override, job frontmatter, plan config, global config, default.
+func (e *OneShotExecutor) resolveModel(job *Job, plan *Plan) (effectiveModel string, modelSource string) {
+	sources, effectiveModel := ExplainModel(job, plan, e.config.ModelOverride)
+	for _, source := range sources {
+		if source.Selected {
+			modelSource = source.Name
+			break
+		}
+	}
+	return effectiveModel, modelSource
+}
+
+// effectiveTimeout returns the LLM timeout for a job, in order of precedence:
+// CLI override, job frontmatter, plan config, global config, executor default.
+// Zero means no timeout.
+func (e *OneShotExecutor) effectiveTimeout(job *Job, plan *Plan) time.Duration {
+	switch {
+	case e.config.TimeoutOverride > 0:
+		return e.config.TimeoutOverride
+	case job.Timeout > 0:
+		return job.Timeout
+	case plan != nil && plan.Config != nil && plan.Config.Timeout > 0:
+		return plan.Config.Timeout
+	case plan != nil && plan.Orchestration != nil && plan.Orchestration.Timeout > 0:
+		return plan.Orchestration.Timeout
+	}
+	return e.config.Timeout
+}
+
+// effectiveRetryCount returns the number of LLM retries for a job, using the same
+// precedence as effectiveTimeout. Negative values count as zero retries, so the
+// job still makes one attempt.
+func (e *OneShotExecutor) effectiveRetryCount(job *Job, plan *Plan) int {
+	count := e.config.RetryCount
+	switch {
+	case e.config.RetryOverride != nil:
+		count = *e.config.RetryOverride
+	case job.RetryCount != nil:
+		count = *job.RetryCount
+	case plan != nil && plan.Config != nil && plan.Config.RetryCount != nil:
+		count = *plan.Config.RetryCount
+	case plan != nil && plan.Orchestration != nil && plan.Orchestration.RetryCount != nil:
+		count = *plan.Orchestration.RetryCount
+	}
+	return max(count, 0)
+}
+
+// recordJobError stores the failure message in the job's last_error frontmatter field.
+func recordJobError(job *Job, jobErr error) {
+	job.LastError = jobErr.Error()
+	job.Metadata.LastError = job.LastError
+	if job.FilePath == "" {
+		return
+	}
+	content, err := os.ReadFile(job.FilePath)
+	if err != nil {
+		return
+	}
+	newContent, err := UpdateFrontmatter(content, map[string]interface{}{
+		"last_error": job.LastError,
+	})
+	if err != nil {
+		return
+	}
+	writeFileAtomic(job.FilePath, newContent)
+}
+
+// printDryRun writes the assembled prompt and the files that would be sent with it.
+func printDryRun(w io.Writer, job *Job, briefingFilePath, prompt string, promptSourceFiles, contextFiles []string) {
+	fmt.Fprintf(w, "\n=== Dry run: %s (%s) ===\n", job.Filename, job.Type)
+	if briefingFilePath != "" {
+		fmt.Fprintf(w, "Briefing file: %s\n", briefingFilePath)
+	}
+	fmt.Fprintln(w, "Prompt source files:")
+	if len(promptSourceFiles) == 0 {
+		fmt.Fprintln(w, "  (none)")
+	}
+	for _, f := range promptSourceFiles {
+		fmt.Fprintf(w, "  - %s\n", f)
+	}
+	fmt.Fprintln(w, "Context files:")
+	if len(contextFiles) == 0 {
+		fmt.Fprintln(w, "  (none)")
+	}
+	for _, f := range contextFiles {
+		fmt.Fprintf(w, "  - %s\n", f)
+	}
+	fmt.Fprintf(w, "--- Prompt (%d chars) ---\n%s\n", len(prompt), prompt)
+}
+
+// saveRawResponse writes the LLM response, before any output processing, to
+// <job-id>.raw.md in the job's log directory and returns the file's path.
+func saveRawResponse(plan *Plan, job *Job, response string) (string, error) {
+	logDir := ResolveLogDirectory(plan, job)
+	if err := os.MkdirAll(logDir, 0755); err != nil {
+		return "", fmt.Errorf("creating log directory: %w", err)
+	}
+	rawPath := filepath.Join(logDir, job.ID+".raw.md")
+	if err := os.WriteFile(rawPath, []byte(response), 0644); err != nil {
+		return "", fmt.Errorf("writing raw response: %w", err)
+	}
+	return rawPath, nil
+}
+
+// processOutput dispatches the LLM response to the handler for the job's output type.
+func (e *OneShotExecutor) processOutput(ctx context.Context, response string, job *Job, plan *Plan, workDir string, preexistingChanges map[string]bool) error {
+	switch job.Output.Type {
+	case OutputTypeCommit:
+		return e.processCommitOutput(ctx, response, job, plan, workDir, preexistingChanges)
+	case OutputTypeAppendTo:
+		return e.processAppendToOutput(ctx, response, job, plan)
+	default:
+		return e.processFileOutput(response, job, plan)
+	}
+}
+
+// processFileOutput appends the response to the job file, unwrapping a
+// surrounding code fence first when output.unwrap_code_fence is set. When
+// output.path is set, the response is also written to the file it renders to.
+func (e *OneShotExecutor) processFileOutput(response string, job *Job, plan *Plan) error {
+	if job.Output.UnwrapCodeFence {
+		response = unwrapCodeFence(response)
+	}
+	if err := writeOutputPath(response, job, plan); err != nil {
+		return err
+	}
+	if err := e.appendToJobFile(response, job); err != nil {
+		return fmt.Errorf("appending output to job file: %w", err)
+	}
+	return nil
+}
+
+// unwrapCodeFence returns the contents of a single fenced code block that wraps
+// the entire response. Responses with text outside the fence, or with more
+// than one fenced block, are returned unchanged.
+func unwrapCodeFence(response string) string {
+	lines := strings.Split(strings.TrimSpace(response), "\n")
+	if len(lines) < 2 {
+		return response
+	}
+	open := strings.TrimSpace(lines[0])
+	fence := open[:len(open)-len(strings.TrimLeft(open, "`"))]
+	if len(fence) < 3 || strings.TrimRight(strings.TrimSpace(lines[len(lines)-1]), "`") != "" ||
+		len(strings.TrimSpace(lines[len(lines)-1])) < len(fence) {
+		return response
+	}
+	body := lines[1 : len(lines)-1]
+	for _, line := range body {
+		if strings.HasPrefix(strings.TrimSpace(line), fence) {
+			return response
+		}
+	}
+	return strings.Join(body, "\n") + "\n"
+}
+
+// appendTargetLocks serializes appends to the same output target by jobs
+// running concurrently in this process.
+type appendTargetLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+var appendTargets = &appendTargetLocks{locks: make(map[string]*sync.Mutex)}
+
+// lock acquires the lock for the target at path and returns its release func.
+func (l *appendTargetLocks) lock(path string) func() {
+	l.mu.Lock()
+	targetLock, ok := l.locks[path]
+	if !ok {
+		targetLock = &sync.Mutex{}
+		l.locks[path] = targetLock
+	}
+	l.mu.Unlock()
+
+	targetLock.Lock()
+	return targetLock.Unlock
+}
+
+// processAppendToOutput appends the response to another job's file in the same plan,
+// under a heading naming the source job and the time it completed. The target is
+// re-read from disk under its lock so a job that started running since the plan
+// was loaded is still refused.
+func (e *OneShotExecutor) processAppendToOutput(ctx context.Context, response string, job *Job, plan *Plan) error {
+	targetRef := job.Output.Target
+	if targetRef == "" {
+		return fmt.Errorf("output type %q requires output.target", OutputTypeAppendTo)
+	}
+
+	target, found := plan.GetJobByID(targetRef)
+	if !found {
+		target, found = plan.GetJobByFilename(targetRef)
+	}
+	if !found {
+		return fmt.Errorf("output target %q not found in plan %s", targetRef, plan.Name)
+	}
+	if target.FilePath == job.FilePath {
+		return fmt.Errorf("output target %q is the job itself; use output type %q instead", targetRef, OutputTypeFile)
+	}
+
+	// Hold the target's lock so a concurrent status update can't overwrite the
+	// appended section. The file lock treats a lock held by this process as
+	// acquired, so jobs in the same run also take an in-process lock first.
+	unlock := appendTargets.lock(target.FilePath)
+	defer unlock()
+	lock, err := NewStatePersister().lockFile(target.FilePath)
+	if err != nil {
+		return fmt.Errorf("locking output target %s: %w", target.Filename, err)
+	}
+	defer lock.Unlock()
+
+	current, err := LoadJob(target.FilePath)
+	if err != nil {
+		return fmt.Errorf("loading output target %s: %w", target.Filename, err)
+	}
+	if current.Status == JobStatusRunning {
+		return fmt.Errorf("output target %s is running; refusing to append while it may be rewritten", target.Filename)
+	}
+
+	content, err := os.ReadFile(target.FilePath)
+	if err != nil {
+		return fmt.Errorf("reading output target: %w", err)
+	}
+	section := fmt.Sprintf("\n\n---\n\n## Output from %s (%s)\n\n%s", job.Filename, time.Now().Format(time.RFC3339), response)
+	if err := writeFileAtomic(target.FilePath, append(content, section...)); err != nil {
+		return fmt.Errorf("writing output target: %w", err)
+	}
+
+	ulog.Success("Appended job output to target").
+		Field("job_id", job.ID).
+		Field("target", target.Filename).
+		Pretty(fmt.Sprintf("%s Appended output to %s", theme.IconSuccess, target.Filename)).
+		Log(ctx)
+	return nil
+}
+
+// processCommitOutput appends the response to the job file, then stages and commits
+// every file that changed in workDir while the job was running. The resulting
+// commit SHA is recorded in the job's frontmatter.
+func (e *OneShotExecutor) processCommitOutput(ctx context.Context, response string, job *Job, plan *Plan, workDir string, preexistingChanges map[string]bool) error {
+	if err := e.processFileOutput(response, job, plan); err != nil {
+		return err
+	}
+
+	changed, err := gitChangedFiles(workDir)
+	if err != nil {
+		return fmt.Errorf("reading git status: %w", err)
+	}
+
+	var files []string
+	for file := range changed {
+		if !preexistingChanges[file] {
+			files = append(files, file)
+		}
+	}
+	if len(files) == 0 {
+		ulog.Info("No files changed during job, skipping commit").
+			Field("job_id", job.ID).
+			Field("workdir", workDir).
+			Log(ctx)
+		return nil
+	}
+	sort.Strings(files)
+
+	message := job.Output.Message
+	if message == "" {
+		message = job.Title
+	}
+
+	// Porcelain paths are relative to the repository root, so stage and commit
+	// from there even when workDir is a subdirectory
+	topCmd := exec.CommandContext(ctx, "git", "rev-parse", "--show-toplevel")
+	topCmd.Dir = workDir
+	topOut, err := topCmd.Output()
+	if err != nil {
+		return fmt.Errorf("resolving repository root: %w", err)
+	}
+
+	repoRoot := strings.TrimSpace(string(topOut))
+
+	addCmd := exec.CommandContext(ctx, "git", append([]string{"add", "--"}, files...)...)
+	addCmd.Dir = repoRoot
+	if out, err := addCmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git add: %s: %w", strings.TrimSpace(string(out)), err)
+	}
+
+	commitCmd := exec.CommandContext(ctx, "git", append([]string{"commit", "-m", message, "--"}, files...)...)
+	commitCmd.Dir = repoRoot
+	if out, err := commitCmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git commit: %s: %w", strings.TrimSpace(string(out)), err)
+	}
+
+	revCmd := exec.CommandContext(ctx, "git", "rev-parse", "HEAD")
+	revCmd.Dir = workDir
+	out, err := revCmd.Output()
+	if err != nil {
+		return fmt.Errorf("reading commit SHA: %w", err)
+	}
+	job.CommitSHA = strings.TrimSpace(string(out))
+
+	content, err := os.ReadFile(job.FilePath)
+	if err != nil {
+		return fmt.Errorf("reading job file: %w", err)
+	}
+	newContent, err := UpdateFrontmatter(content, map[string]interface{}{
+		"commit_sha": job.CommitSHA,
+	})
+	if err != nil {
+		return fmt.Errorf("updating frontmatter: %w", err)
+	}
+	if err := writeFileAtomic(job.FilePath, newContent); err != nil {
+		return fmt.Errorf("writing job file: %w", err)
+	}
+
+	ulog.Success("Committed job output").
+		Field("job_id", job.ID).
+		Field("commit_sha", job.CommitSHA).
+		Field("files", len(files)).
+		Pretty(fmt.Sprintf("%s Committed %d file(s): %s", theme.IconSuccess, len(files), job.CommitSHA)).
+		Log(ctx)
+
+	return nil
+}
+
+// isGitWorkTree reports whether dir is inside a git working tree.
+func isGitWorkTree(dir string) bool {
+	cmd := exec.Command("git", "rev-parse", "--is-inside-work-tree")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	return err == nil && strings.TrimSpace(string(out)) == "true"
+}
+
+// gitChangedFiles returns the set of modified, staged, and untracked paths in dir,
+// relative to the repository root as reported by `git status --porcelain`.
+func gitChangedFiles(dir string) (map[string]bool, error) {
+	cmd := exec.Command("git", "status", "--porcelain", "-z", "--untracked-files=all")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]bool)
+	entries := strings.Split(string(out), "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if len(entry) < 4 {
+			continue
+		}
+		files[entry[3:]] = true
+		// Renames and copies are followed by the original path
+		if entry[0] == 'R' || entry[0] == 'C' {
+			i++
+		}
+	}
+	return files, nil
+}
+
 // appendToJobFile appends output to the job file.
 func (e *OneShotExecutor) appendToJobFile(output string, job *Job) error {
 	// Read current content
@@ -663,11 +1270,10 @@ func (e *OneShotExecutor) appendToJobFile(output string, job *Job) error {
 	}
 
 	// Append output section
-	separator := "\n\n---\n\n## Output\n\n"
-	newContent := string(content) + separator + output
+	newContent := string(content) + outputSectionSeparator + output
 
 	// Write back
-	if err := os.WriteFile(job.FilePath, []byte(newContent), 0o644); err != nil {
+	if err := writeFileAtomic(job.FilePath, []byte(newContent)); err != nil {
 		return fmt.Errorf("writing job file: %w", err)
 	}
 
@@ -693,7 +1299,7 @@ func updateJobFile(job *Job) error {
 	}
 
 	// Write back
-	if err := os.WriteFile(job.FilePath, newContent, 0o644); err != nil {
+	if err := writeFileAtomic(job.FilePath, newContent); err != nil {
 		return fmt.Errorf("writing job file: %w", err)
 	}
 
@@ -732,6 +1338,29 @@ func (m *MockLLMClient) Complete(ctx context.Context, job *Job, plan *Plan, prom
 	return string(content), nil
 }
 
+// dryRunWorktreePath returns job's worktree path if the worktree already
+// exists, or the project root otherwise, so a dry run never creates a worktree
+// or branch.
+func dryRunWorktreePath(ctx context.Context, job *Job, plan *Plan) string {
+	gitRoot, err := GetProjectGitRoot(plan.Directory)
+	if err != nil {
+		return plan.Directory
+	}
+	realGitRoot := gitRoot
+	if idx := strings.Index(gitRoot, "/.grove-worktrees/"); idx != -1 {
+		realGitRoot = gitRoot[:idx]
+	}
+	worktreePath := filepath.Join(realGitRoot, ".grove-worktrees", job.Worktree)
+	if isDir(worktreePath) {
+		return worktreePath
+	}
+	ulog.Info("Dry run: worktree does not exist yet, reading from the project root instead").
+		Field("worktree", job.Worktree).
+		Field("workdir", gitRoot).
+		Log(ctx)
+	return gitRoot
+}
+
 // prepareWorktree ensures the worktree exists and is ready.
 func (e *OneShotExecutor) prepareWorktree(ctx context.Context, job *Job, plan *Plan) (string, error) {
 	if job.Worktree == "" {
@@ -760,11 +1389,18 @@ func (e *OneShotExecutor) prepareWorktree(ctx context.Context, job *Job, plan *P
 		realGitRoot = gitRoot[:idx]
 	}
 
-	// Use the shared method to get or prepare the worktree at the git root
+	state, err := inspectWorktree(ctx, realGitRoot, job.Worktree, "")
+	if err != nil {
+		return "", err
+	}
+
+	// Use the shared method to get or prepare the worktree at the git root.
+	// It reuses an existing worktree or branch left behind by an earlier run.
 	worktreePath, _, err := e.worktreeManager.GetOrPrepareWorktree(ctx, realGitRoot, job.Worktree, "")
 	if err != nil {
 		return "", err
 	}
+	logWorktreePrepared(ctx, state, job.Worktree, worktreePath)
 
 	// Automatically initialize state within the new worktree for a better UX.
 	groveDir := filepath.Join(worktreePath, ".grove")
@@ -792,6 +1428,18 @@ func (e *OneShotExecutor) prepareWorktree(ctx context.Context, job *Job, plan *P
 
 // regenerateContextInWorktree regenerates the context within a worktree.
 func (e *OneShotExecutor) regenerateContextInWorktree(ctx context.Context, worktreePath string, jobType string, job *Job, plan *Plan) error {
+	if e.config.NoContext {
+		ulog.Info("Skipping context generation; context intentionally omitted (--no-context)").
+			Field("job_type", jobType).
+			Log(ctx)
+		return nil
+	}
+	if e.config.DryRun {
+		ulog.Info("Dry run: using the existing context without regenerating it").
+			Field("job_type", jobType).
+			Log(ctx)
+		return e.displayContextInfo(ctx, ScopeToSubProject(worktreePath, job))
+	}
 	writer := grovelogging.GetWriter(ctx)
 	ulog.Info("Checking context in worktree").
 		Field("job_type", jobType).
@@ -810,6 +1458,16 @@ func (e *OneShotExecutor) regenerateContextInWorktree(ctx context.Context, workt
 
 	// Create context manager for the worktree (or sub-project)
 	ctxMgr := grovecontext.NewManager(contextDir)
+	if job != nil {
+		job.ContextStats = nil
+	}
+
+	// Limit context to the files changed since a base commit, if requested
+	if job != nil && job.ContextSince != "" {
+		if handled, err := e.generateContextSince(ctx, contextDir, ctxMgr, job); handled {
+			return err
+		}
+	}
 
 	// Check if job has a custom rules file specified
 	if job != nil && job.RulesFile != "" {
@@ -869,12 +1527,33 @@ func (e *OneShotExecutor) regenerateContextInWorktree(ctx context.Context, workt
 		log.WithField("rules_file", rulesFilePath).Info("Using job-specific context")
 		fmt.Fprintf(writer, "Using job-specific context from: %s\n", rulesFilePath)
 
+		cacheKey, cacheable := newContextCacheKey(worktreePath, contextDir, rulesFilePath)
+		if cacheable && !e.config.ForceContext && generatedContexts.upToDate(contextDir, cacheKey) {
+			ulog.Info("Context unchanged since last job, skipping regeneration").
+				Field("context_dir", contextDir).
+				Field("rules_file", rulesFilePath).
+				Log(ctx)
+			job.ContextStats = generatedContexts.statsFor(contextDir)
+			return e.displayContextInfo(ctx, contextDir)
+		}
+
 		// Generate context using the custom rules file
 		if err := ctxMgr.GenerateContextFromRulesFile(rulesFilePath, true); err != nil {
 			return fmt.Errorf("failed to generate job-specific context: %w", err)
 		}
+		if cacheable {
+			generatedContexts.store(contextDir, cacheKey)
+		}
 
-		return e.displayContextInfo(ctx, contextDir)
+		// Keep the stats for the briefing file, and for later jobs that reuse this context
+		if summary := e.summarizeContext(ctx, ctxMgr, job); summary != nil {
+			job.ContextStats = summary
+			if cacheable {
+				generatedContexts.storeStats(contextDir, summary)
+			}
+		}
+
+		return nil
 	}
 
 	// Check if .grove/rules exists for default context generation
@@ -1018,6 +1697,18 @@ func (e *OneShotExecutor) regenerateContextInWorktree(ctx context.Context, workt
 		Icon(theme.IconChecklist).
 		Log(ctx)
 
+	cacheKey, cacheable := newContextCacheKey(worktreePath, contextDir, rulesPath)
+	if cacheable && !e.config.ForceContext && generatedContexts.upToDate(contextDir, cacheKey) {
+		ulog.Info("Context unchanged since last job, skipping regeneration").
+			Field("context_dir", contextDir).
+			Field("rules_file", absRulesPath).
+			Log(ctx)
+		if job != nil {
+			job.ContextStats = generatedContexts.statsFor(contextDir)
+		}
+		return e.displayContextInfo(ctx, contextDir)
+	}
+
 	// Update context from rules
 	if err := ctxMgr.UpdateFromRules(); err != nil {
 		return fmt.Errorf("update context from rules: %w", err)
@@ -1027,64 +1718,86 @@ func (e *OneShotExecutor) regenerateContextInWorktree(ctx context.Context, workt
 	if err := ctxMgr.GenerateContext(true); err != nil {
 		return fmt.Errorf("generate context: %w", err)
 	}
+	if cacheable {
+		generatedContexts.store(contextDir, cacheKey)
+	}
+
+	// Keep the stats for the briefing file, and for later jobs that reuse this context
+	if summary := e.summarizeContext(ctx, ctxMgr, job); summary != nil {
+		if job != nil {
+			job.ContextStats = summary
+		}
+		if cacheable {
+			generatedContexts.storeStats(contextDir, summary)
+		}
+	}
 
-	// Get and display context statistics
+	return nil
+}
+
+// summarizeContext logs statistics about the context just generated by ctxMgr
+// and returns them, or nil if they can't be read.
+func (e *OneShotExecutor) summarizeContext(ctx context.Context, ctxMgr *grovecontext.Manager, job *Job) *ContextStats {
 	// Read the files list that was just generated
 	files, _ := ctxMgr.ReadFilesList(grovecontext.FilesListFile)
 	stats, err := ctxMgr.GetStats("oneshot", files, 10) // Show top 10 files
 	if err != nil {
 		ulog.Warn("Failed to get context stats").Err(err).Log(ctx)
-	} else {
-		// Display summary statistics
-		requestID, _ := ctx.Value("request_id").(string)
-		ulog.Info("Context summary generated").
-			Field("request_id", requestID).
-			Field("job_id", job.ID).
-			Field("total_files", stats.TotalFiles).
-			Field("total_tokens", stats.TotalTokens).
-			Field("total_size", stats.TotalSize).
-			Pretty(fmt.Sprintf("%s Context Summary: %d files, %s tokens, %s",
-				theme.IconFileTree,
-				stats.TotalFiles,
-				grovecontext.FormatTokenCount(stats.TotalTokens),
-				grovecontext.FormatBytes(int(stats.TotalSize)))).
-			Log(ctx)
+		return nil
+	}
 
-		// Token limit check removed - no longer enforcing limits
+	// Display summary statistics
+	requestID, _ := ctx.Value("request_id").(string)
+	ulog.Info("Context summary generated").
+		Field("request_id", requestID).
+		Field("job_id", job.ID).
+		Field("total_files", stats.TotalFiles).
+		Field("total_tokens", stats.TotalTokens).
+		Field("total_size", stats.TotalSize).
+		Pretty(fmt.Sprintf("%s Context Summary: %d files, %s tokens, %s",
+			theme.IconFileTree,
+			stats.TotalFiles,
+			grovecontext.FormatTokenCount(stats.TotalTokens),
+			grovecontext.FormatBytes(int(stats.TotalSize)))).
+		Log(ctx)
 
-		// Show language distribution if there are files
-		if stats.TotalFiles > 0 {
-			// Sort languages by token count
-			var languages []grovecontext.LanguageStats
-			for _, lang := range stats.Languages {
-				languages = append(languages, *lang)
-			}
-			sort.Slice(languages, func(i, j int) bool {
-				return languages[i].TotalTokens > languages[j].TotalTokens
-			})
+	// Token limit check removed - no longer enforcing limits
 
-			// Build language distribution string for pretty output
-			var langDistParts []string
-			shown := 0
-			for _, lang := range languages {
-				if shown >= 5 {
-					break
-				}
-				langDistParts = append(langDistParts,
-					fmt.Sprintf("%s: %.1f%%", lang.Name, lang.Percentage))
-				shown++
-			}
+	summary := &ContextStats{Files: int(stats.TotalFiles), Tokens: int(stats.TotalTokens)}
 
-			ulog.Info("Language distribution").
-				Field("languages", langDistParts).
-				Pretty(fmt.Sprintf("%s Language Distribution: %s",
-					theme.IconProject,
-					strings.Join(langDistParts, ", "))).
-				Log(ctx)
+	// Show language distribution if there are files
+	if stats.TotalFiles > 0 {
+		// Sort languages by token count
+		var languages []grovecontext.LanguageStats
+		for _, lang := range stats.Languages {
+			languages = append(languages, *lang)
+		}
+		sort.Slice(languages, func(i, j int) bool {
+			return languages[i].TotalTokens > languages[j].TotalTokens
+		})
+
+		// Build language distribution string for pretty output
+		var langDistParts []string
+		shown := 0
+		for _, lang := range languages {
+			if shown >= 5 {
+				break
+			}
+			langDistParts = append(langDistParts,
+				fmt.Sprintf("%s: %.1f%%", lang.Name, lang.Percentage))
+			shown++
 		}
+
+		ulog.Info("Language distribution").
+			Field("languages", langDistParts).
+			Pretty(fmt.Sprintf("%s Language Distribution: %s",
+				theme.IconProject,
+				strings.Join(langDistParts, ", "))).
+			Log(ctx)
+		summary.Languages = langDistParts
 	}
 
-	return nil
+	return summary
 }
 
 // displayContextInfo displays information about available context files
@@ -1125,8 +1838,77 @@ func (e *OneShotExecutor) displayContextInfo(ctx context.Context, worktreePath s
 	return nil
 }
 
-// executeChatJob handles the conversational logic for chat-type jobs
+// autoContinuePrompt is the user turn added between turns of an auto-run chat.
+const autoContinuePrompt = "Continue."
+
+// executeChatJob runs the pending turn of a chat job. If the chat allows more
+// than one turn (max_turns or --max-turns), it keeps adding a continuation
+// prompt and running further turns unattended until the limit is reached, a
+// turn fails, or the model ends the chat with a complete directive. Each turn
+// goes through executeChatTurn, so SkipInteractive applies to all of them.
 func (e *OneShotExecutor) executeChatJob(ctx context.Context, job *Job, plan *Plan, output io.Writer) error {
+	maxTurns := job.MaxTurns
+	if e.config.MaxTurns > 0 {
+		maxTurns = e.config.MaxTurns
+	}
+
+	for turn := 1; ; turn++ {
+		if err := e.executeChatTurn(ctx, job, plan, output); err != nil {
+			return err
+		}
+		if turn >= maxTurns || e.config.DryRun || job.Status != JobStatusPendingUser {
+			return nil
+		}
+
+		content, err := os.ReadFile(job.FilePath)
+		if err != nil {
+			return fmt.Errorf("reading chat file: %w", err)
+		}
+		if lastResponseCompletesChat(content) {
+			ulog.Info("Chat ended by complete directive").
+				Field("job", job.Title).
+				Field("turns", turn).
+				Log(ctx)
+			job.Status = JobStatusCompleted
+			job.EndTime = time.Now()
+			return updateJobFile(job)
+		}
+
+		ulog.Info("Auto-running next chat turn").
+			Field("job", job.Title).
+			Field("turn", turn+1).
+			Field("max_turns", maxTurns).
+			Log(ctx)
+		content = append(bytes.TrimRight(content, "\n"), []byte("\n"+autoContinuePrompt+"\n")...)
+		if err := writeFileAtomic(job.FilePath, content); err != nil {
+			return fmt.Errorf("adding continuation prompt: %w", err)
+		}
+	}
+}
+
+// lastResponseCompletesChat reports whether the most recent LLM response in a
+// chat file contains a <!-- grove: {"action": "complete"} --> directive.
+func lastResponseCompletesChat(content []byte) bool {
+	// Directives with no content are dropped by ParseChatFile, so scan them
+	// directly: walk back from the end to the directive that opened the response.
+	matches := groveDirectiveRegex.FindAllSubmatch(content, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		var directive ChatDirective
+		if err := json.Unmarshal(matches[i][1], &directive); err != nil {
+			continue
+		}
+		if directive.Action == "complete" {
+			return true
+		}
+		if directive.ID != "" {
+			return false // Reached the start of the last response
+		}
+	}
+	return false
+}
+
+// executeChatTurn runs the LLM for the last user turn of a chat job.
+func (e *OneShotExecutor) executeChatTurn(ctx context.Context, job *Job, plan *Plan, output io.Writer) error {
 	// Generate a unique request ID for tracing this turn
 	requestID := "req-" + uuid.New().String()[:8]
 	ctx = context.WithValue(ctx, "request_id", requestID)
@@ -1172,24 +1954,29 @@ func (e *OneShotExecutor) executeChatJob(ctx context.Context, job *Job, plan *Pl
 
 	// --- Execution ---
 	// Pre-flight check passed, proceed with execution.
-	// Create lock file with the current process's PID.
-	if err := CreateLockFile(job.FilePath, os.Getpid()); err != nil {
-		return fmt.Errorf("failed to create lock file: %w", err)
-	}
-	// Ensure lock file is removed when execution finishes.
-	defer RemoveLockFile(job.FilePath)
+	if !e.config.DryRun {
+		// Create lock file with the current process's PID.
+		if err := CreateLockFile(job.FilePath, os.Getpid()); err != nil {
+			return fmt.Errorf("failed to create lock file: %w", err)
+		}
+		// Ensure lock file is removed when execution finishes.
+		defer RemoveLockFile(job.FilePath)
 
-	// Update job status to running
-	job.Status = JobStatusRunning
-	job.StartTime = time.Now()
-	if err := updateJobFile(job); err != nil {
-		return fmt.Errorf("updating job status: %w", err)
+		// Update job status to running
+		job.Status = JobStatusRunning
+		job.StartTime = time.Now()
+		if err := updateJobFile(job); err != nil {
+			return fmt.Errorf("updating job status: %w", err)
+		}
 	}
 
 	var execErr error
 
 	// Check if job has a template, if not, add template: chat to frontmatter
-	if job.Template == "" {
+	if job.Template == "" && e.config.DryRun {
+		// Don't persist the default template during a dry run
+		job.Template = "chat"
+	} else if job.Template == "" {
 		// Add template: chat to the frontmatter
 		updates := map[string]interface{}{
 			"template": "chat",
@@ -1201,7 +1988,7 @@ func (e *OneShotExecutor) executeChatJob(ctx context.Context, job *Job, plan *Pl
 		}
 
 		// Write the updated content back to the file
-		if err := os.WriteFile(job.FilePath, newContent, 0o644); err != nil {
+		if err := writeFileAtomic(job.FilePath, newContent); err != nil {
 			execErr = fmt.Errorf("writing updated chat file: %w", err)
 			return execErr
 		}
@@ -1254,12 +2041,16 @@ func (e *OneShotExecutor) executeChatJob(ctx context.Context, job *Job, plan *Pl
 	var worktreePath string
 	if job.Worktree != "" {
 		// Prepare git worktree only if explicitly specified
-		path, err := e.prepareWorktree(ctx, job, plan)
-		if err != nil {
-			execErr = fmt.Errorf("prepare worktree: %w", err)
-			return execErr
+		if e.config.DryRun {
+			worktreePath = dryRunWorktreePath(ctx, job, plan)
+		} else {
+			path, err := e.prepareWorktree(ctx, job, plan)
+			if err != nil {
+				execErr = fmt.Errorf("prepare worktree: %w", err)
+				return execErr
+			}
+			worktreePath = path
 		}
-		worktreePath = path
 
 		// Regenerate context in the worktree to ensure chat has latest view
 		if err := e.regenerateContextInWorktree(ctx, worktreePath, "chat", job, plan); err != nil {
@@ -1312,11 +2103,15 @@ func (e *OneShotExecutor) executeChatJob(ctx context.Context, job *Job, plan *Pl
 	var dependencyFilePaths []string
 	var prependedDependencies []struct {
 		Filename string
+		Attrs    string
 		Content  string
 	}
 	if job.ShouldInline(InlineDependencies) && len(job.Dependencies) > 0 {
 		// Inline mode: read dependency content for embedding in prompt
 		log.Debug("inline: [dependencies] enabled - inlining dependency content into prompt")
+		if !e.config.DryRun {
+			summarizeLargeDependencies(ctx, job, plan)
+		}
 		// Sort dependencies by filename for consistent order
 		sortedDeps := make([]*Job, len(job.Dependencies))
 		copy(sortedDeps, job.Dependencies)
@@ -1337,10 +2132,12 @@ func (e *OneShotExecutor) executeChatJob(ctx context.Context, job *Job, plan *Pl
 				}
 				log.WithField("file", dep.Filename).Debug("Inlined dependency")
 				_, depBody, _ := ParseFrontmatter(depContent)
+				body, attrs := limitDependencyBody(job, plan, dep, string(depBody))
 				prependedDependencies = append(prependedDependencies, struct {
 					Filename string
+					Attrs    string
 					Content  string
-				}{dep.Filename, string(depBody)})
+				}{dep.Filename, attrs, body})
 			}
 		}
 	} else if len(job.Dependencies) > 0 {
@@ -1367,16 +2164,16 @@ func (e *OneShotExecutor) executeChatJob(ctx context.Context, job *Job, plan *Pl
 			log.WithField("file", source).Debug("Uploading include file as attachment")
 		}
 	}
+	includeFilePaths = append(includeFilePaths, e.config.ContextFiles...)
 
-	// Load the template using TemplateManager
-	templateManager := NewTemplateManager()
-	template, err := templateManager.FindTemplate(directive.Template)
+	// Load the turn's template: a one-off template_file, or a named template
+	template, err := resolveDirectiveTemplate(directive, job, plan)
 	if err != nil {
-		execErr = fmt.Errorf("resolving template %s: %w", directive.Template, err)
+		execErr = err
 		return execErr
 	}
 
-	templateContent := []byte(template.Prompt)
+	templateContent := []byte(expandPromptVars(ctx, template.Prompt, newPromptVarLookup(job, plan, worktreePath), job))
 
 	// Add Grove context files
 	var contextPaths []string
@@ -1386,7 +2183,9 @@ func (e *OneShotExecutor) executeChatJob(ctx context.Context, job *Job, plan *Pl
 	// Scope to sub-project if job.Repository is set (for ecosystem worktrees)
 	contextDir := ScopeToSubProject(worktreePath, job)
 
-	if contextDir != "" {
+	if e.config.NoContext {
+		ulog.Info("Context intentionally omitted (--no-context)").Log(ctx)
+	} else if contextDir != "" {
 		// When using a worktree/context dir, ONLY use context from that directory
 		// Check for .grove/context
 		contextPath := filepath.Join(contextDir, ".grove", "context")
@@ -1457,7 +2256,7 @@ interpret and continue through YOUR current system instructions.
 
 		// Add prepended dependencies (inlined content from upstream jobs)
 		for _, dep := range prependedDependencies {
-			promptBuilder.WriteString(fmt.Sprintf("    <prepended_dependency file=\"%s\">\n", dep.Filename))
+			promptBuilder.WriteString(fmt.Sprintf("    <prepended_dependency file=\"%s\"%s>\n", dep.Filename, dep.Attrs))
 			promptBuilder.WriteString(dep.Content)
 			promptBuilder.WriteString("\n    </prepended_dependency>\n")
 		}
@@ -1482,7 +2281,7 @@ interpret and continue through YOUR current system instructions.
 
 	// Add the structured conversation XML
 	promptBuilder.WriteString("\n")
-	promptBuilder.WriteString(formattedConversation)
+	promptBuilder.WriteString(wrapUserRequest(plan, formattedConversation))
 	promptBuilder.WriteString("\n")
 	promptBuilder.WriteString("</prompt>\n")
 
@@ -1500,7 +2299,8 @@ interpret and continue through YOUR current system instructions.
 	}).Debug("Built prompt for chat turn")
 
 	// Write the full prompt to a briefing file for observability using the turn UUID
-	if briefingFilePath, err := WriteBriefingFile(plan, job, fullPrompt, turnID); err != nil {
+	briefingFilePath, err := WriteBriefingFile(plan, job, fullPrompt, turnID)
+	if err != nil {
 		ulog.Warn("Failed to write chat briefing file").
 			Err(err).
 			Log(ctx)
@@ -1515,6 +2315,11 @@ interpret and continue through YOUR current system instructions.
 			Log(ctx)
 	}
 
+	if e.config.DryRun {
+		printDryRun(output, job, briefingFilePath, fullPrompt, append(dependencyFilePaths, includeFilePaths...), validContextPaths)
+		return nil
+	}
+
 	if len(validContextPaths) > 0 {
 		log.WithField("count", len(validContextPaths)).Info("Including context files as attachments")
 	} else {
@@ -1581,7 +2386,9 @@ interpret and continue through YOUR current system instructions.
 	// Only run cx generate if we don't have a custom rules file
 	// Custom rules files have already generated the correct context via regenerateContextInWorktree
 	// Running cx generate would overwrite it with the wrong rules
-	if job.RulesFile == "" {
+	if e.config.NoContext {
+		ulog.Info("Skipping cx generate (--no-context)").Log(ctx)
+	} else if job.RulesFile == "" {
 		// For jobs without custom rules, cx generate ensures we have the latest context
 		ulog.Info("Running cx generate before submission").Log(ctx)
 
@@ -1601,6 +2408,7 @@ interpret and continue through YOUR current system instructions.
 		// Try grove cx generate first
 		cxCmd := delegation.CommandContext(ctx, "cx", "generate")
 		cxCmd.Dir = contextDir
+		cxCmd.Env = jobEnvironment(plan, job)
 		if logFile != nil {
 			cxCmd.Stdout = logFile
 			cxCmd.Stderr = logFile
@@ -1633,12 +2441,27 @@ interpret and continue through YOUR current system instructions.
 
 	// Call LLM based on model type
 	log.WithField("model", effectiveModel).Debug("Calling LLM")
+	llmStart := time.Now()
 	var response string
 	var apiKey string
 	var geminiErr error
 	if os.Getenv("GROVE_MOCK_LLM_RESPONSE_FILE") != "" {
 		// Check if mocking is enabled - if so, always use llmClient regardless of model
 		response, err = e.llmClient.Complete(ctx, job, plan, fullPrompt, llmOpts, output)
+	} else if openAICfg := openAIConfigForPlan(plan); openAICfg.MatchesModel(effectiveModel) {
+		if isTUIMode() {
+			fmt.Fprintf(output, "\n%s Calling OpenAI-compatible API with model: %s\n\n", theme.IconRobot, effectiveModel)
+		}
+		response, err = NewOpenAIClient(openAICfg).Complete(ctx, job, plan, fullPrompt, llmOpts, output)
+		if err != nil {
+			recordLLMAudit(ctx, job, plan, effectiveModel, fullPrompt, llmStart, "", err)
+			ulog.Error("OpenAI-compatible API call failed").
+				Err(err).
+				Pretty(theme.DefaultTheme.Error.Render(fmt.Sprintf("%s OpenAI-compatible API call failed: %v", theme.IconError, err))).
+				Log(ctx)
+			execErr = fmt.Errorf("OpenAI completion: %w", err)
+			return execErr
+		}
 	} else if strings.HasPrefix(effectiveModel, "gemini") {
 		// Resolve API key here where we have the correct execution context
 		apiKey, geminiErr = geminiconfig.ResolveAPIKey()
@@ -1665,6 +2488,7 @@ interpret and continue through YOUR current system instructions.
 		}
 		response, err = e.geminiRunner.Run(ctx, opts)
 		if err != nil {
+			recordLLMAudit(ctx, job, plan, effectiveModel, fullPrompt, llmStart, "", err)
 			ulog.Error("Gemini API call failed").
 				Err(err).
 				Pretty(theme.DefaultTheme.Error.Render(fmt.Sprintf("%s Gemini API call failed: %v", theme.IconError, err))).
@@ -1673,29 +2497,12 @@ interpret and continue through YOUR current system instructions.
 			return execErr
 		}
 	} else if strings.HasPrefix(effectiveModel, "claude") {
-		// Resolve API key here where we have the correct execution context
-		apiKey, anthropicErr := anthropicconfig.ResolveAPIKey()
-		if anthropicErr != nil {
-			err = fmt.Errorf("resolving Anthropic API key: %w", anthropicErr)
-		} else {
-			// Use grove-anthropic package for Claude models
-			opts := anthropic.RequestOptions{
-				Model:        effectiveModel,
-				Prompt:       fullPrompt,
-				ContextFiles: append(allIncludeFiles, validContextPaths...),
-				WorkDir:      contextDir,
-				APIKey:       apiKey,
-				MaxTokens:    64000,
-				Caller:       "grove-flow-chat",
-				JobID:        job.ID,
-				PlanName:     plan.Name,
-			}
-			if isTUIMode() {
-				fmt.Fprintf(output, "\n%s Calling Anthropic API with model: %s\n\n", theme.IconRobot, effectiveModel)
-			}
-			response, err = e.anthropicRunner.Run(ctx, opts)
+		if isTUIMode() {
+			fmt.Fprintf(output, "\n%s Calling Anthropic API with model: %s\n\n", theme.IconRobot, effectiveModel)
 		}
+		response, err = e.anthropicClient.Complete(ctx, job, plan, fullPrompt, llmOpts, output)
 		if err != nil {
+			recordLLMAudit(ctx, job, plan, effectiveModel, fullPrompt, llmStart, "", err)
 			ulog.Error("Anthropic API call failed").
 				Err(err).
 				Pretty(theme.DefaultTheme.Error.Render(fmt.Sprintf("%s Anthropic API call failed: %v", theme.IconError, err))).
@@ -1710,6 +2517,7 @@ interpret and continue through YOUR current system instructions.
 		// Use traditional llm command
 		response, err = e.llmClient.Complete(ctx, job, plan, fullPrompt, llmOpts, output)
 		if err != nil {
+			recordLLMAudit(ctx, job, plan, effectiveModel, fullPrompt, llmStart, "", err)
 			ulog.Error("LLM API call failed").
 				Err(err).
 				Pretty(theme.DefaultTheme.Error.Render(fmt.Sprintf("%s LLM API call failed: %v", theme.IconError, err))).
@@ -1718,6 +2526,7 @@ interpret and continue through YOUR current system instructions.
 			return execErr
 		}
 	}
+	recordLLMAudit(ctx, job, plan, effectiveModel, fullPrompt, llmStart, response, err)
 	log.WithField("response_length_bytes", len(response)).Debug("LLM call succeeded")
 
 	// Use the same turnID that was generated earlier for the briefing file
@@ -1730,7 +2539,7 @@ interpret and continue through YOUR current system instructions.
 	newCell := fmt.Sprintf("\n<!-- grove: {\"id\": \"%s\"} -->\n## LLM Response (%s)\n\n%s\n\n<!-- grove: {\"template\": \"%s\"} -->\n", turnID, timestamp, response, directive.Template)
 
 	// Append atomically
-	if err := os.WriteFile(job.FilePath, append(content, []byte(newCell)...), 0o644); err != nil {
+	if err := writeFileAtomic(job.FilePath, append(content, []byte(newCell)...)); err != nil {
 		execErr = fmt.Errorf("appending LLM response: %w", err)
 		return execErr
 	}
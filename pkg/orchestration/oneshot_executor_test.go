@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -121,6 +123,34 @@ func TestOneShotExecutor_BuildPrompt(t *testing.T) {
 	}
 }
 
+func TestOneShotExecutor_BuildPrompt_NoContext(t *testing.T) {
+	tmpDir := t.TempDir()
+	os.MkdirAll(filepath.Join(tmpDir, ".grove"), 0755)
+	os.WriteFile(filepath.Join(tmpDir, ".grove", "context"), []byte("generated context"), 0644)
+	os.WriteFile(filepath.Join(tmpDir, "CLAUDE.md"), []byte("project notes"), 0644)
+
+	plan := &Plan{Directory: tmpDir}
+	job := &Job{PromptBody: "Do something"}
+
+	executor := NewOneShotExecutor(NewMockLLMClient(), nil)
+	_, _, contextFiles, err := executor.buildPrompt(job, plan, tmpDir)
+	if err != nil {
+		t.Fatalf("buildPrompt() error = %v", err)
+	}
+	if len(contextFiles) != 2 {
+		t.Fatalf("expected 2 context files by default, got %v", contextFiles)
+	}
+
+	executor = NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{NoContext: true})
+	_, _, contextFiles, err = executor.buildPrompt(job, plan, tmpDir)
+	if err != nil {
+		t.Fatalf("buildPrompt() error = %v", err)
+	}
+	if len(contextFiles) != 0 {
+		t.Errorf("expected no context files with NoContext, got %v", contextFiles)
+	}
+}
+
 func TestOneShotExecutor_BuildPrompt_ReferenceBasedPrompts(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -577,4 +607,439 @@ Body`
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestOneShotExecutor_DryRunLeavesWorktreeUntouched(t *testing.T) {
+	repoDir := t.TempDir()
+	runGit := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+		return string(out)
+	}
+	runGit("init", "-q")
+	runGit("config", "user.email", "test@example.com")
+	runGit("config", "user.name", "Test")
+	os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("readme"), 0644)
+	runGit("add", "README.md")
+	runGit("commit", "-q", "-m", "initial")
+
+	planDir := filepath.Join(repoDir, "plans", "feature")
+	if err := os.MkdirAll(planDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	jobPath := filepath.Join(planDir, "01-job.md")
+	os.WriteFile(jobPath, []byte("---\nid: dry-job\ntitle: Dry Job\nstatus: pending\ntype: oneshot\nworktree: feature\n---\nDo the thing."), 0644)
+	job, err := LoadJob(jobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	job.Filename = "01-job.md"
+	plan := &Plan{Directory: planDir, Jobs: []*Job{job}, JobsByID: map[string]*Job{job.ID: job}}
+
+	executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{DryRun: true})
+	if err := executor.Execute(context.Background(), job, plan); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(repoDir, ".grove-worktrees", "feature")); !os.IsNotExist(err) {
+		t.Errorf("dry run created the worktree (stat error %v)", err)
+	}
+	if branches := runGit("branch", "--list", "feature"); strings.TrimSpace(branches) != "" {
+		t.Errorf("dry run created the branch: %q", branches)
+	}
+	if _, err := os.Stat(filepath.Join(repoDir, ".grove", "context")); !os.IsNotExist(err) {
+		t.Errorf("dry run generated context (stat error %v)", err)
+	}
+	if job.Status != JobStatusPending {
+		t.Errorf("job status = %s, want pending", job.Status)
+	}
+}
+
+func TestOneShotExecutor_ProcessCommitOutput(t *testing.T) {
+	repoDir := t.TempDir()
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	runGit("init", "-q")
+	runGit("config", "user.email", "test@example.com")
+	runGit("config", "user.name", "Test")
+	os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("readme"), 0644)
+	runGit("add", "README.md")
+	runGit("commit", "-q", "-m", "initial")
+
+	// A file that was already dirty before the job must not be committed
+	os.WriteFile(filepath.Join(repoDir, "unrelated.txt"), []byte("wip"), 0644)
+
+	jobPath := filepath.Join(repoDir, "01-job.md")
+	os.WriteFile(jobPath, []byte("---\nid: commit-job\ntitle: Commit Job\nstatus: running\ntype: oneshot\n---\nBody"), 0644)
+
+	before, err := gitChangedFiles(repoDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(before, "01-job.md") // Treat the job file as created by the job
+	os.WriteFile(filepath.Join(repoDir, "generated.go"), []byte("package main"), 0644)
+
+	job := &Job{
+		ID:       "commit-job",
+		Title:    "Commit Job",
+		FilePath: jobPath,
+		Output:   OutputConfig{Type: OutputTypeCommit, Message: "Add generated code"},
+	}
+	executor := NewOneShotExecutor(NewMockLLMClient(), nil)
+	if err := executor.processCommitOutput(context.Background(), "response", job, &Plan{Directory: repoDir}, repoDir, before); err != nil {
+		t.Fatalf("processCommitOutput() error = %v", err)
+	}
+
+	if job.CommitSHA == "" {
+		t.Fatal("expected commit SHA to be recorded on the job")
+	}
+	content, _ := os.ReadFile(jobPath)
+	if !strings.Contains(string(content), "commit_sha: "+job.CommitSHA) {
+		t.Errorf("job file missing commit_sha, got:\n%s", content)
+	}
+
+	cmd := exec.Command("git", "show", "--name-only", "--format=%s", "HEAD")
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	show := string(out)
+	if !strings.HasPrefix(show, "Add generated code") {
+		t.Errorf("unexpected commit message: %s", show)
+	}
+	if !strings.Contains(show, "generated.go") {
+		t.Errorf("expected generated.go in commit, got: %s", show)
+	}
+	if strings.Contains(show, "unrelated.txt") {
+		t.Errorf("pre-existing change should not be committed, got: %s", show)
+	}
+}
+
+func TestOneShotExecutor_ProcessCommitOutput_Subdirectory(t *testing.T) {
+	repoDir := t.TempDir()
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	runGit("init", "-q")
+	runGit("config", "user.email", "test@example.com")
+	runGit("config", "user.name", "Test")
+	os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("readme"), 0644)
+	runGit("add", "README.md")
+	runGit("commit", "-q", "-m", "initial")
+
+	// The job runs in a sub-project, as with work_dir or repository scoping
+	workDir := filepath.Join(repoDir, "services", "api")
+	if err := os.MkdirAll(workDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	planDir := t.TempDir()
+	jobPath := filepath.Join(planDir, "01-job.md")
+	os.WriteFile(jobPath, []byte("---\nid: commit-job\ntitle: Commit Job\nstatus: running\ntype: oneshot\n---\nBody"), 0644)
+
+	before, err := gitChangedFiles(workDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(workDir, "handler.go"), []byte("package api"), 0644)
+
+	job := &Job{ID: "commit-job", Title: "Commit Job", FilePath: jobPath, Output: OutputConfig{Type: OutputTypeCommit}}
+	executor := NewOneShotExecutor(NewMockLLMClient(), nil)
+	if err := executor.processCommitOutput(context.Background(), "response", job, &Plan{Directory: planDir}, workDir, before); err != nil {
+		t.Fatalf("processCommitOutput() error = %v", err)
+	}
+
+	cmd := exec.Command("git", "show", "--name-only", "--format=%s", "HEAD")
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "services/api/handler.go") {
+		t.Errorf("expected services/api/handler.go in commit, got: %s", out)
+	}
+}
+
+func TestOneShotExecutor_ProcessAppendToOutput(t *testing.T) {
+	dir := t.TempDir()
+	writeJob := func(name, status string) *Job {
+		path := filepath.Join(dir, name)
+		content := fmt.Sprintf("---\nid: %s\ntitle: %s\nstatus: %s\ntype: oneshot\n---\n\nBody\n", strings.TrimSuffix(name, ".md"), name, status)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return &Job{ID: strings.TrimSuffix(name, ".md"), Filename: name, FilePath: path, Status: JobStatus(status)}
+	}
+	notes := writeJob("01-notes.md", "completed")
+	busy := writeJob("02-busy.md", "running")
+	source := writeJob("03-source.md", "running")
+	plan := &Plan{
+		Directory: dir,
+		Jobs:      []*Job{notes, busy, source},
+		JobsByID:  map[string]*Job{notes.ID: notes, busy.ID: busy, source.ID: source},
+	}
+	executor := NewOneShotExecutor(NewMockLLMClient(), nil)
+
+	source.Output = OutputConfig{Type: OutputTypeAppendTo, Target: "01-notes"}
+	if err := executor.processOutput(context.Background(), "new findings", source, plan, dir, nil); err != nil {
+		t.Fatalf("processOutput() error = %v", err)
+	}
+	content, err := os.ReadFile(notes.FilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "## Output from 03-source.md") || !strings.HasSuffix(string(content), "new findings") {
+		t.Errorf("target content missing appended section:\n%s", content)
+	}
+	own, _ := os.ReadFile(source.FilePath)
+	if strings.Contains(string(own), "new findings") {
+		t.Error("source job file should not receive the output")
+	}
+
+	if _, err := os.Stat(notes.FilePath + ".lock"); !os.IsNotExist(err) {
+		t.Error("target lock should be released after appending")
+	}
+
+	// Another process holding the target's lock blocks the append
+	if err := os.WriteFile(notes.FilePath+".lock", []byte("1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := executor.processOutput(context.Background(), "x", source, plan, dir, nil); err == nil {
+		t.Error("expected an error when the target is locked")
+	}
+	os.Remove(notes.FilePath + ".lock")
+
+	source.Output.Target = "02-busy.md"
+	if err := executor.processOutput(context.Background(), "x", source, plan, dir, nil); err == nil {
+		t.Error("expected an error when appending to a running job")
+	}
+
+	source.Output.Target = "99-missing"
+	if err := executor.processOutput(context.Background(), "x", source, plan, dir, nil); err == nil {
+		t.Error("expected an error for an unknown target")
+	}
+}
+
+func TestOneShotExecutor_ConcurrentAppendsToSameTarget(t *testing.T) {
+	dir := t.TempDir()
+	targetPath := filepath.Join(dir, "01-notes.md")
+	if err := os.WriteFile(targetPath, []byte("---\nid: notes\ntitle: Notes\nstatus: completed\ntype: oneshot\n---\n\nBody\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// A lock held by this process counts as acquired, as it does when the
+	// target is itself a job run by this process
+	if err := os.WriteFile(targetPath+".lock", []byte(fmt.Sprintf("%d\n", os.Getpid())), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	target := &Job{ID: "notes", Filename: "01-notes.md", FilePath: targetPath, Status: JobStatusCompleted}
+	plan := &Plan{Directory: dir, Jobs: []*Job{target}, JobsByID: map[string]*Job{target.ID: target}}
+	executor := NewOneShotExecutor(NewMockLLMClient(), nil)
+
+	const jobs = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, jobs)
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			source := &Job{
+				ID:       fmt.Sprintf("source-%d", i),
+				Filename: fmt.Sprintf("source-%d.md", i),
+				FilePath: filepath.Join(dir, fmt.Sprintf("source-%d.md", i)),
+				Output:   OutputConfig{Type: OutputTypeAppendTo, Target: "notes"},
+			}
+			errs <- executor.processOutput(context.Background(), fmt.Sprintf("finding %d", i), source, plan, dir, nil)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("processOutput() error = %v", err)
+		}
+	}
+
+	content, err := os.ReadFile(targetPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < jobs; i++ {
+		if !strings.Contains(string(content), fmt.Sprintf("finding %d", i)) {
+			t.Errorf("append from source-%d was lost:\n%s", i, content)
+		}
+	}
+}
+
+func TestOneShotExecutor_EffectiveTimeout(t *testing.T) {
+	executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{Timeout: 5 * time.Minute})
+	plan := &Plan{
+		Config:        &PlanConfig{Timeout: 2 * time.Minute},
+		Orchestration: &Config{Timeout: 3 * time.Minute},
+	}
+
+	if got := executor.effectiveTimeout(&Job{}, &Plan{}); got != 5*time.Minute {
+		t.Errorf("effectiveTimeout() = %s, want executor default 5m0s", got)
+	}
+	if got := executor.effectiveTimeout(&Job{}, &Plan{Orchestration: plan.Orchestration}); got != 3*time.Minute {
+		t.Errorf("effectiveTimeout() = %s, want global config 3m0s", got)
+	}
+	if got := executor.effectiveTimeout(&Job{}, plan); got != 2*time.Minute {
+		t.Errorf("effectiveTimeout() = %s, want plan config 2m0s", got)
+	}
+	if got := executor.effectiveTimeout(&Job{Timeout: 30 * time.Second}, plan); got != 30*time.Second {
+		t.Errorf("effectiveTimeout() = %s, want job override 30s", got)
+	}
+
+	executor.config.TimeoutOverride = 10 * time.Second
+	if got := executor.effectiveTimeout(&Job{Timeout: 30 * time.Second}, plan); got != 10*time.Second {
+		t.Errorf("effectiveTimeout() = %s, want CLI override 10s", got)
+	}
+}
+
+func TestOneShotExecutor_EffectiveRetryCount(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+	executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{RetryCount: 2})
+	plan := &Plan{Config: &PlanConfig{RetryCount: intPtr(0)}}
+
+	if got := executor.effectiveRetryCount(&Job{}, &Plan{}); got != 2 {
+		t.Errorf("effectiveRetryCount() = %d, want executor default 2", got)
+	}
+	if got := executor.effectiveRetryCount(&Job{}, plan); got != 0 {
+		t.Errorf("effectiveRetryCount() = %d, want plan config 0", got)
+	}
+	if got := executor.effectiveRetryCount(&Job{RetryCount: intPtr(4)}, plan); got != 4 {
+		t.Errorf("effectiveRetryCount() = %d, want job override 4", got)
+	}
+	if got := executor.effectiveRetryCount(&Job{RetryCount: intPtr(-1)}, plan); got != 0 {
+		t.Errorf("effectiveRetryCount() = %d, want negative job value clamped to 0", got)
+	}
+}
+
+func TestExplainModel(t *testing.T) {
+	plan := &Plan{
+		Config:        &PlanConfig{Model: "plan-model"},
+		Orchestration: &Config{OneshotModel: "global-model"},
+	}
+
+	sources, model := ExplainModel(&Job{}, plan, "")
+	if model != "plan-model" {
+		t.Errorf("ExplainModel() model = %s, want plan-model", model)
+	}
+	if len(sources) != 5 || !sources[2].Selected || sources[3].Selected || sources[3].Value != "global-model" {
+		t.Errorf("unexpected sources: %+v", sources)
+	}
+
+	sources, model = ExplainModel(&Job{Model: "job-model"}, plan, "cli-model")
+	if model != "cli-model" || !sources[0].Selected || sources[1].Selected {
+		t.Errorf("CLI override should win, got %s with %+v", model, sources)
+	}
+
+	executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{})
+	if _, source := executor.resolveModel(&Job{}, &Plan{}); source != "default fallback" {
+		t.Errorf("resolveModel() source = %s, want default fallback", source)
+	}
+}
+
+func TestRunCommandWithContext_KillsOnDeadline(t *testing.T) {
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("sleep not available")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := runCommandWithContext(ctx, exec.Command("sleep", "10"))
+	if err == nil {
+		t.Fatal("expected an error when the deadline fires")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("command was not killed promptly, ran for %s", elapsed)
+	}
+}
+
+func TestPartialOutput(t *testing.T) {
+	jobPath := filepath.Join(t.TempDir(), "01-job.md")
+
+	partial, err := newPartialOutput(jobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprint(partial, "first attempt")
+	if err := partial.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprint(partial, "streamed chunk")
+
+	kept := partial.Keep()
+	if kept != partialFileName(jobPath) {
+		t.Fatalf("Keep() = %q, want %q", kept, partialFileName(jobPath))
+	}
+	content, err := os.ReadFile(kept)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "streamed chunk" {
+		t.Errorf("partial content = %q, want only the chunk written after Reset", content)
+	}
+
+	partial, err = newPartialOutput(jobPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprint(partial, "done")
+	if err := partial.Discard(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(partialFileName(jobPath)); !os.IsNotExist(err) {
+		t.Error("Discard() should remove the partial file")
+	}
+}
+
+func TestUnwrapCodeFence(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     string
+	}{
+		{"single fenced block", "```go\npackage main\n```\n", "package main\n"},
+		{"surrounding whitespace", "\n```\nline 1\nline 2\n```  \n\n", "line 1\nline 2\n"},
+		{"longer fence keeps inner fences", "````md\n```go\nx\n```\n````", "```go\nx\n```\n"},
+		{"unfenced", "just text", "just text"},
+		{"text outside fence", "Here you go:\n```go\nx\n```", "Here you go:\n```go\nx\n```"},
+		{"multiple blocks", "```go\na\n```\nand\n```go\nb\n```", "```go\na\n```\nand\n```go\nb\n```"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unwrapCodeFence(tt.response); got != tt.want {
+				t.Errorf("unwrapCodeFence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsesGeminiRunner(t *testing.T) {
+	t.Setenv("GROVE_MOCK_LLM_RESPONSE_FILE", "")
+	plan := &Plan{}
+	if !usesGeminiRunner(plan, "gemini-2.5-pro") {
+		t.Error("gemini models should use the non-streaming Gemini runner")
+	}
+	for _, model := range []string{"claude-sonnet-4", "gpt-4o", "mock"} {
+		if usesGeminiRunner(plan, model) {
+			t.Errorf("%s should not use the Gemini runner", model)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package orchestration
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	defaultOpenAIBaseURL     = "https://api.openai.com/v1"
+	defaultOpenAIAPIKeyEnv   = "OPENAI_API_KEY"
+	defaultOpenAIModelPrefix = "openai:"
+)
+
+// OpenAIConfig configures the client used for models served by an
+// OpenAI-compatible chat-completions endpoint.
+type OpenAIConfig struct {
+	BaseURL     string `yaml:"base_url,omitempty"`     // Defaults to https://api.openai.com/v1
+	APIKey      string `yaml:"api_key,omitempty"`      // Takes precedence over APIKeyEnv
+	APIKeyEnv   string `yaml:"api_key_env,omitempty"`  // Defaults to OPENAI_API_KEY
+	ModelPrefix string `yaml:"model_prefix,omitempty"` // Defaults to "openai:"
+}
+
+func (c *OpenAIConfig) baseURL() string {
+	if c != nil && c.BaseURL != "" {
+		return strings.TrimSuffix(c.BaseURL, "/")
+	}
+	return defaultOpenAIBaseURL
+}
+
+func (c *OpenAIConfig) modelPrefix() string {
+	if c != nil && c.ModelPrefix != "" {
+		return c.ModelPrefix
+	}
+	return defaultOpenAIModelPrefix
+}
+
+// resolveAPIKey returns the configured key, falling back to the environment.
+func (c *OpenAIConfig) resolveAPIKey() string {
+	if c != nil && c.APIKey != "" {
+		return c.APIKey
+	}
+	envVar := defaultOpenAIAPIKeyEnv
+	if c != nil && c.APIKeyEnv != "" {
+		envVar = c.APIKeyEnv
+	}
+	return os.Getenv(envVar)
+}
+
+// MatchesModel reports whether model should be routed to the OpenAI client.
+func (c *OpenAIConfig) MatchesModel(model string) bool {
+	return strings.HasPrefix(model, c.modelPrefix())
+}
+
+// openAIConfigForPlan returns the plan's OpenAI settings, or nil for defaults.
+func openAIConfigForPlan(plan *Plan) *OpenAIConfig {
+	if plan == nil || plan.Orchestration == nil {
+		return nil
+	}
+	return plan.Orchestration.OpenAI
+}
+
+// OpenAIClient implements LLMClient against an OpenAI-compatible
+// chat-completions HTTP API.
+type OpenAIClient struct {
+	config     *OpenAIConfig
+	httpClient *http.Client
+}
+
+// NewOpenAIClient creates a client for the given config. A nil config uses
+// the public OpenAI endpoint and the OPENAI_API_KEY environment variable.
+func NewOpenAIClient(config *OpenAIConfig) *OpenAIClient {
+	return &OpenAIClient{
+		config:     config,
+		httpClient: &http.Client{},
+	}
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+	// Parts, when set, is sent as the content instead of Content, for
+	// messages that carry images or files.
+	Parts []openAIContentPart `json:"-"`
+}
+
+// MarshalJSON sends Parts as the message content when there are any.
+func (m openAIMessage) MarshalJSON() ([]byte, error) {
+	if len(m.Parts) == 0 {
+		type plain openAIMessage
+		return json.Marshal(plain(m))
+	}
+	return json.Marshal(struct {
+		Role    string              `json:"role"`
+		Content []openAIContentPart `json:"content"`
+	}{m.Role, m.Parts})
+}
+
+type openAIContentPart struct {
+	Type     string          `json:"type"`
+	Text     string          `json:"text,omitempty"`
+	ImageURL *openAIImageURL `json:"image_url,omitempty"`
+	File     *openAIFile     `json:"file,omitempty"`
+}
+
+type openAIImageURL struct {
+	URL string `json:"url"`
+}
+
+type openAIFile struct {
+	Filename string `json:"filename"`
+	FileData string `json:"file_data"`
+}
+
+type openAIChatRequest struct {
+	Model    string          `json:"model"`
+	Messages []openAIMessage `json:"messages"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// Complete sends the prompt, with text include and context files inlined and
+// images or PDFs attached, as a single user message and returns the first
+// choice's content.
+func (c *OpenAIClient) Complete(ctx context.Context, job *Job, plan *Plan, prompt string, opts LLMOptions, output io.Writer) (string, error) {
+	model := strings.TrimPrefix(opts.Model, c.config.modelPrefix())
+	if model == "" {
+		return "", fmt.Errorf("no model specified for OpenAI-compatible endpoint")
+	}
+
+	includeFiles, binaryFiles := splitBinaryAttachments(opts.IncludeFiles, opts.AttachmentTypes)
+	fullPrompt, err := inlinePromptFiles(prompt, includeFiles, opts.ContextFiles)
+	if err != nil {
+		return "", err
+	}
+	message := openAIMessage{Role: "user", Content: fullPrompt}
+	if len(binaryFiles) > 0 {
+		message.Parts, err = openAIContentParts(fullPrompt, binaryFiles, opts.AttachmentTypes)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	body, err := json.Marshal(openAIChatRequest{
+		Model:    model,
+		Messages: []openAIMessage{message},
+	})
+	if err != nil {
+		return "", fmt.Errorf("encoding OpenAI request: %w", err)
+	}
+
+	url := c.config.baseURL() + "/chat/completions"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("creating OpenAI request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey := c.config.resolveAPIKey(); apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	jobID := ""
+	if job != nil {
+		jobID = job.ID
+	}
+	ulog.Info("Starting OpenAI-compatible request").
+		Field("job_id", jobID).
+		Field("model", model).
+		Field("url", url).
+		Field("prompt_length", len(fullPrompt)).
+		Log(ctx)
+	startTime := time.Now()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("calling OpenAI-compatible endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading OpenAI response: %w", err)
+	}
+
+	var parsed openAIChatResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("OpenAI-compatible endpoint returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+		}
+		return "", fmt.Errorf("decoding OpenAI response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if parsed.Error != nil && parsed.Error.Message != "" {
+			return "", fmt.Errorf("OpenAI-compatible endpoint returned %s: %s", resp.Status, parsed.Error.Message)
+		}
+		return "", fmt.Errorf("OpenAI-compatible endpoint returned %s", resp.Status)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("OpenAI-compatible endpoint returned no choices")
+	}
+
+	response := parsed.Choices[0].Message.Content
+	ulog.Info("OpenAI-compatible request completed").
+		Field("job_id", jobID).
+		Field("duration_ms", time.Since(startTime).Milliseconds()).
+		Field("response_length", len(response)).
+		Field("model", model).
+		Log(ctx)
+
+	if opts.Stream != nil {
+		io.WriteString(opts.Stream, response)
+	}
+	return response, nil
+}
+
+// openAIContentParts builds the content of a message with text followed by
+// the given files, attached as images or PDFs according to their MIME types.
+func openAIContentParts(text string, files []string, types map[string]string) ([]openAIContentPart, error) {
+	parts := []openAIContentPart{{Type: "text", Text: text}}
+	for _, path := range files {
+		mimeType := types[path]
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading include file %s: %w", path, err)
+		}
+		dataURL := "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(content)
+		switch {
+		case strings.HasPrefix(mimeType, "image/"):
+			parts = append(parts, openAIContentPart{Type: "image_url", ImageURL: &openAIImageURL{URL: dataURL}})
+		case mimeType == "application/pdf":
+			parts = append(parts, openAIContentPart{Type: "file", File: &openAIFile{Filename: filepath.Base(path), FileData: dataURL}})
+		default:
+			return nil, fmt.Errorf("include %s is typed %s; OpenAI-compatible models accept image and PDF attachments only", filepath.Base(path), mimeType)
+		}
+	}
+	return parts, nil
+}
+
+// inlinePromptFiles prepends include and context file contents to prompt,
+// using the same section markers as CommandLLMClient.
+func inlinePromptFiles(prompt string, includeFiles, contextFiles []string) (string, error) {
+	var b strings.Builder
+	for _, path := range includeFiles {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("reading include file %s: %w", path, err)
+		}
+		fmt.Fprintf(&b, "=== Include: %s ===\n", filepath.Base(path))
+		b.Write(content)
+		b.WriteString("\n\n")
+	}
+	for _, path := range contextFiles {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("reading context file %s: %w", path, err)
+		}
+		fmt.Fprintf(&b, "=== Context from %s ===\n", filepath.Base(path))
+		b.Write(content)
+		b.WriteString("\n\n")
+	}
+	if b.Len() > 0 {
+		b.WriteString("=== User Request ===\n\n")
+	}
+	b.WriteString(prompt)
+	return b.String(), nil
+}
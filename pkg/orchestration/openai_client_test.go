@@ -0,0 +1,160 @@
+package orchestration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOpenAIConfigMatchesModel(t *testing.T) {
+	var defaults *OpenAIConfig
+	if !defaults.MatchesModel("openai:gpt-4o") {
+		t.Error("expected default prefix to match openai:gpt-4o")
+	}
+	if defaults.MatchesModel("gpt-4o") || defaults.MatchesModel("claude-sonnet-4-5") {
+		t.Error("expected unprefixed models not to match")
+	}
+
+	custom := &OpenAIConfig{ModelPrefix: "local/"}
+	if !custom.MatchesModel("local/llama3") {
+		t.Error("expected custom prefix to match local/llama3")
+	}
+	if custom.MatchesModel("openai:gpt-4o") {
+		t.Error("expected default prefix not to match when a custom prefix is set")
+	}
+}
+
+func TestOpenAIClientComplete(t *testing.T) {
+	var gotReq openAIChatRequest
+	var gotAuth, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &gotReq); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"choices":[{"message":{"role":"assistant","content":"hello back"}}]}`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	includePath := filepath.Join(dir, "spec.md")
+	contextPath := filepath.Join(dir, "CLAUDE.md")
+	if err := os.WriteFile(includePath, []byte("include body"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(contextPath, []byte("context body"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("TEST_OPENAI_KEY", "sk-test")
+	client := NewOpenAIClient(&OpenAIConfig{BaseURL: server.URL + "/v1/", APIKeyEnv: "TEST_OPENAI_KEY"})
+	opts := LLMOptions{
+		Model:        "openai:gpt-4o",
+		IncludeFiles: []string{includePath},
+		ContextFiles: []string{contextPath},
+	}
+	response, err := client.Complete(context.Background(), &Job{ID: "job-1"}, nil, "say hello", opts, io.Discard)
+	if err != nil {
+		t.Fatalf("Complete returned error: %v", err)
+	}
+
+	if response != "hello back" {
+		t.Errorf("response = %q, want %q", response, "hello back")
+	}
+	if gotPath != "/v1/chat/completions" {
+		t.Errorf("path = %q, want /v1/chat/completions", gotPath)
+	}
+	if gotAuth != "Bearer sk-test" {
+		t.Errorf("Authorization = %q, want Bearer sk-test", gotAuth)
+	}
+	if gotReq.Model != "gpt-4o" {
+		t.Errorf("model = %q, want prefix stripped to gpt-4o", gotReq.Model)
+	}
+	if len(gotReq.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(gotReq.Messages))
+	}
+	content := gotReq.Messages[0].Content
+	for _, want := range []string{"=== Include: spec.md ===\ninclude body", "=== Context from CLAUDE.md ===\ncontext body", "=== User Request ===\n\nsay hello"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("message content missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestOpenAIClientCompleteError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `{"error":{"message":"invalid api key"}}`)
+	}))
+	defer server.Close()
+
+	client := NewOpenAIClient(&OpenAIConfig{BaseURL: server.URL, APIKey: "bad"})
+	_, err := client.Complete(context.Background(), &Job{ID: "job-1"}, nil, "hi", LLMOptions{Model: "openai:gpt-4o"}, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "invalid api key") {
+		t.Fatalf("expected error mentioning invalid api key, got %v", err)
+	}
+}
+
+func TestOpenAIClientCompleteAttachments(t *testing.T) {
+	var gotReq struct {
+		Messages []struct {
+			Content []openAIContentPart `json:"content"`
+		} `json:"messages"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &gotReq); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		io.WriteString(w, `{"choices":[{"message":{"role":"assistant","content":"a diagram"}}]}`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	diagram := filepath.Join(dir, "diagram.png")
+	spec := filepath.Join(dir, "spec.pdf")
+	notes := filepath.Join(dir, "notes.md")
+	for path, content := range map[string]string{diagram: "png", spec: "pdf", notes: "notes body"} {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client := NewOpenAIClient(&OpenAIConfig{BaseURL: server.URL})
+	opts := LLMOptions{
+		Model:           "openai:gpt-4o",
+		IncludeFiles:    []string{diagram, notes, spec},
+		AttachmentTypes: map[string]string{diagram: "image/png", spec: "application/pdf"},
+	}
+	if _, err := client.Complete(context.Background(), &Job{ID: "job-1"}, nil, "describe", opts, io.Discard); err != nil {
+		t.Fatalf("Complete returned error: %v", err)
+	}
+
+	if len(gotReq.Messages) != 1 || len(gotReq.Messages[0].Content) != 3 {
+		t.Fatalf("expected one message with 3 content parts, got %+v", gotReq.Messages)
+	}
+	parts := gotReq.Messages[0].Content
+	if parts[0].Type != "text" || !strings.Contains(parts[0].Text, "notes body") || strings.Contains(parts[0].Text, "diagram.png") {
+		t.Errorf("text part = %+v, want only notes.md inlined", parts[0])
+	}
+	if parts[1].Type != "image_url" || parts[1].ImageURL == nil || parts[1].ImageURL.URL != "data:image/png;base64,cG5n" {
+		t.Errorf("image part = %+v", parts[1])
+	}
+	if parts[2].Type != "file" || parts[2].File == nil || parts[2].File.Filename != "spec.pdf" || parts[2].File.FileData != "data:application/pdf;base64,cGRm" {
+		t.Errorf("file part = %+v", parts[2])
+	}
+
+	opts.AttachmentTypes[diagram] = "audio/mpeg"
+	if _, err := client.Complete(context.Background(), &Job{ID: "job-1"}, nil, "describe", opts, io.Discard); err == nil {
+		t.Error("expected an error for an audio attachment")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,10 +30,20 @@ type OrchestratorConfig struct {
 	CheckInterval       time.Duration
 	StateFile           string
 	ModelOverride       string           // Override model for all jobs
+	TimeoutOverride     time.Duration    // Override LLM timeout for all jobs
+	RetryOverride       *int             // Override LLM retry count for all jobs
 	MaxConsecutiveSteps int              // Maximum consecutive steps before halting
 	SkipInteractive     bool             // Skip interactive agent jobs
 	SummaryConfig       *SummaryConfig   // Configuration for job summarization
 	CommandExecutor     command.Executor // For dependency injection
+	DryRun              bool             // Assemble prompts without calling the LLM or changing job status
+	Restart             bool             // Ignore the run checkpoint and start RunAll from scratch
+	ForceContext        bool             // Regenerate context for every job instead of reusing it
+	MaxTurns            int              // Override max_turns for chat jobs
+	ContextFiles        []string         // Extra files attached to every job's prompt
+	NoContext           bool             // Skip context generation and context files entirely
+	SaveRawOutput       bool             // Save each oneshot job's verbatim LLM response
+	ContinueOnFailure   bool             // Keep running independent jobs after a failure instead of stopping
 }
 
 // Orchestrator coordinates job execution and manages state.
@@ -42,6 +54,7 @@ type Orchestrator struct {
 	config          *OrchestratorConfig
 	logger          Logger
 	stateManager    *StateManager
+	checkpoint      *RunCheckpoint // Progress of the current RunAll, persisted as jobs complete
 	mu              sync.Mutex
 }
 
@@ -53,6 +66,7 @@ type PlanStatus struct {
 	Completed  int
 	Failed     int
 	Blocked    int
+	Skipped    int
 	Progress   float64
 }
 
@@ -115,7 +129,15 @@ func (o *Orchestrator) registerExecutors() {
 		RetryCount:      2,
 		Model:           "default",
 		ModelOverride:   o.config.ModelOverride,
+		TimeoutOverride: o.config.TimeoutOverride,
+		RetryOverride:   o.config.RetryOverride,
 		SkipInteractive: o.config.SkipInteractive,
+		DryRun:          o.config.DryRun,
+		ForceContext:    o.config.ForceContext,
+		MaxTurns:        o.config.MaxTurns,
+		ContextFiles:    o.config.ContextFiles,
+		NoContext:       o.config.NoContext,
+		SaveRawOutput:   o.config.SaveRawOutput,
 	}
 
 	// Create shared LLM clients for executors
@@ -187,93 +209,379 @@ func (o *Orchestrator) RunJob(ctx context.Context, jobFile string) error {
 	}
 
 	// Execute job
-	return o.executeJob(ctx, job)
+	err := o.executeJob(ctx, job)
+	o.runOnFailHookIfFailed(ctx, []*Job{job})
+	return err
 }
 
 // RunNext executes all currently runnable jobs.
 func (o *Orchestrator) RunNext(ctx context.Context) error {
+	o.syncSkippedJobs()
+
 	// Get all runnable jobs
-	runnable := o.dependencyGraph.GetRunnableJobs()
+	runnable := o.runnableJobs()
 	if len(runnable) == 0 {
 		return fmt.Errorf("no runnable jobs found")
 	}
 
+	if o.config.DryRun {
+		return o.runDryRun(ctx, runnable)
+	}
+
 	// Limit to max parallel jobs
-	if len(runnable) > o.config.MaxParallelJobs {
-		runnable = runnable[:o.config.MaxParallelJobs]
+	if len(runnable) > o.maxParallelJobs() {
+		runnable = runnable[:o.maxParallelJobs()]
 	}
 
 	// Run jobs concurrently
-	return o.runJobsConcurrently(ctx, runnable)
+	err := o.runJobsConcurrently(ctx, runnable)
+	o.syncBlockedJobs()
+	o.syncSkippedJobs()
+	o.runOnFailHookIfFailed(ctx, runnable)
+	return err
+}
+
+// RunReady executes every job that is runnable now, up to the parallel limit
+// at a time, and returns once they finish. Jobs they unblock are left for the
+// next call, so repeated calls advance the plan one layer of the DAG at a time.
+func (o *Orchestrator) RunReady(ctx context.Context) error {
+	o.syncSkippedJobs()
+	runnable := o.runnableJobs()
+	if len(runnable) == 0 {
+		return fmt.Errorf("no runnable jobs found")
+	}
+
+	if o.config.DryRun {
+		return o.runDryRun(ctx, runnable)
+	}
+	err := o.runJobsConcurrently(ctx, runnable)
+	o.syncBlockedJobs()
+	o.syncSkippedJobs()
+	o.runOnFailHookIfFailed(ctx, runnable)
+	return err
 }
 
 // RunAll executes all jobs in the plan.
 func (o *Orchestrator) RunAll(ctx context.Context) error {
 	o.logger.Info("Starting orchestration", "plan", o.Plan.Name)
 
-	stepCount := 0
+	// Job statuses never change during a dry run, so preview every pending job once.
+	if o.config.DryRun {
+		var pending []*Job
+		for _, job := range o.Plan.GetJobsSortedByFilename() {
+			if job.Status == JobStatusPending || (job.Type == JobTypeChat && job.Status == JobStatusPendingUser) {
+				pending = append(pending, job)
+			}
+		}
+		return o.runDryRun(ctx, pending)
+	}
+
+	if err := o.resumeFromCheckpoint(); err != nil {
+		return fmt.Errorf("resuming from checkpoint: %w", err)
+	}
+
 	limit := o.config.MaxConsecutiveSteps
 	if limit <= 0 {
 		limit = 20 // Default if not configured
 	}
+	maxParallel := o.maxParallelJobs()
+	// The step limit used to count batches of up to maxParallel jobs; keep the same budget.
+	maxLaunches := limit * maxParallel
+
+	type jobResult struct {
+		job *Job
+		run *Job // the copy the executor ran on
+		err error
+	}
+	results := make(chan jobResult)
+	inFlight := make(map[string]bool)
+	busyGroups := make(map[string]bool) // concurrency groups with a job in flight
+	exclusive := false                  // a job that needs the terminal is running
+	launched := 0
+	var errs []error
+	// Unless ContinueOnFailure is set, no new jobs start once one has failed;
+	// jobs already running are allowed to finish.
+	var firstFailure *Job
+	// The on_fail hook only fires for, and only reports, failures produced by
+	// this run, not jobs that were already failed when it started.
+	var failedThisRun []*Job
 
 	for {
-		// Check if we're done
-		status := o.GetStatus()
-		if status.Pending == 0 && status.Running == 0 {
-			if status.Failed > 0 {
-				return fmt.Errorf("orchestration completed with %d failed jobs", status.Failed)
+		if ctx.Err() == nil && !exclusive && firstFailure == nil && len(inFlight) < maxParallel {
+			// Reload job statuses from disk to detect external changes
+			// This allows 'flow plan complete' to work while orchestrator is running
+			if err := o.reloadJobStatusesFromDisk(); err != nil {
+				o.logger.Error("Failed to reload job statuses", "error", err)
 			}
-			o.logger.Info("Orchestration completed successfully", 
-				"total", status.Total,
-				"completed", status.Completed)
-			return nil
-		}
+			o.syncBlockedJobs()
+			o.syncSkippedJobs()
+
+			for _, job := range o.runnableJobs() {
+				if inFlight[job.ID] || len(inFlight) >= maxParallel {
+					continue
+				}
+				// Jobs that need the terminal run alone, once the pool has drained.
+				if requiresTerminal(job) && len(inFlight) > 0 {
+					continue
+				}
+				// Jobs sharing a concurrency group wait for the running one to finish.
+				if job.ConcurrencyGroup != "" && busyGroups[job.ConcurrencyGroup] {
+					continue
+				}
+				if launched >= maxLaunches {
+					break
+				}
 
-		// Reload job statuses from disk to detect external changes
-		// This allows 'flow plan complete' to work while orchestrator is running
-		if err := o.reloadJobStatusesFromDisk(); err != nil {
-			o.logger.Error("Failed to reload job statuses", "error", err)
+				inFlight[job.ID] = true
+				if job.ConcurrencyGroup != "" {
+					busyGroups[job.ConcurrencyGroup] = true
+				}
+				launched++
+				// Executors write the job's status without holding o.mu, so each
+				// one runs on a copy; the scheduler, which reloads and blocks jobs
+				// under the lock, applies the result once the job finishes.
+				run := *job
+				go func(j, run *Job) {
+					results <- jobResult{job: j, run: run, err: o.executeJob(ctx, run)}
+				}(job, &run)
+
+				if requiresTerminal(job) {
+					exclusive = true
+					break
+				}
+			}
 		}
-		
-		// Get runnable jobs
-		runnable := o.dependencyGraph.GetRunnableJobs()
-		
-		if len(runnable) == 0 {
+
+		if len(inFlight) == 0 {
+			if ctx.Err() != nil {
+				return errors.Join(append(errs, ctx.Err())...)
+			}
+
+			if firstFailure != nil {
+				o.syncBlockedJobs()
+				if status := o.GetStatus(); status.Pending > 0 {
+					o.runOnFailHook(ctx, failedThisRun)
+					return errors.Join(append([]error{fmt.Errorf("stopped after job %s failed; %d pending job(s) were not run", firstFailure.ID, status.Pending)}, errs...)...)
+				}
+			}
+
+			status := o.GetStatus()
+			if status.Pending == 0 && status.Running == 0 {
+				if status.Failed > 0 {
+					o.runOnFailHook(ctx, failedThisRun)
+					return errors.Join(append([]error{fmt.Errorf("orchestration completed with %d failed jobs: %s", status.Failed, strings.Join(o.failedJobIDs(), ", "))}, errs...)...)
+				}
+				o.logger.Info("Orchestration completed successfully",
+					"total", status.Total,
+					"completed", status.Completed)
+				if err := RemoveRunCheckpoint(o.Plan.Directory); err != nil {
+					o.logger.Error("Failed to remove run checkpoint", "error", err)
+				}
+				return nil
+			}
+
+			if launched >= maxLaunches {
+				return fmt.Errorf("execution halted: maximum consecutive step limit (%d) reached. This is a safeguard against potential infinite loops", limit)
+			}
+
 			if status.Running > 0 {
-				// Wait for running jobs to complete
+				// Jobs started outside this orchestrator are still running
 				o.logger.Debug("No runnable jobs, waiting for running jobs to complete",
 					"running", status.Running)
 				time.Sleep(o.config.CheckInterval)
 				continue
-			} else {
-				// No running jobs and no runnable jobs - we're blocked
-				return fmt.Errorf("no runnable jobs and no jobs running - possible circular dependency or all remaining jobs depend on failed jobs")
 			}
+
+			// No running jobs and no runnable jobs - we're blocked
+			o.runOnFailHook(ctx, failedThisRun)
+			return errors.Join(append([]error{fmt.Errorf("no runnable jobs and no jobs running - possible circular dependency or all remaining jobs depend on failed jobs")}, errs...)...)
 		}
 
-		// Limit to max parallel jobs
-		if len(runnable) > o.config.MaxParallelJobs {
-			runnable = runnable[:o.config.MaxParallelJobs]
+		// Wait for any in-flight job to finish, then schedule newly ready jobs
+		res := <-results
+		o.mu.Lock()
+		*res.job = *res.run
+		o.mu.Unlock()
+		delete(inFlight, res.job.ID)
+		delete(busyGroups, res.job.ConcurrencyGroup)
+		if requiresTerminal(res.job) {
+			exclusive = false
+		}
+		if res.err != nil {
+			o.logger.Error("Error running job", "job", res.job.ID, "error", res.err)
+			errs = append(errs, fmt.Errorf("job %s: %w", res.job.ID, res.err))
+		} else if res.job.Status == JobStatusCompleted {
+			o.recordCompleted(res.job)
+		}
+		if res.err != nil || res.job.Status == JobStatusFailed {
+			failedThisRun = append(failedThisRun, res.job)
+		}
+		if (res.err != nil || res.job.Status == JobStatusFailed) && !o.config.ContinueOnFailure && firstFailure == nil {
+			firstFailure = res.job
+			if len(inFlight) > 0 {
+				o.logger.Info("Job failed; waiting for running jobs before stopping", "job", res.job.ID, "running", len(inFlight))
+			}
+		}
+	}
+}
+
+// failedJobIDs returns the IDs of the plan's failed jobs in filename order.
+func (o *Orchestrator) failedJobIDs() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var ids []string
+	for _, job := range o.Plan.GetJobsSortedByFilename() {
+		if job.Status == JobStatusFailed {
+			ids = append(ids, job.ID)
+		}
+	}
+	return ids
+}
+
+// syncBlockedJobs marks pending jobs whose dependencies failed as blocked,
+// recording the failed job as the reason, and returns jobs it blocked earlier
+// to pending once that failure has been cleared (e.g. the job was reset).
+// Jobs blocked by hand, without a reason, are left alone.
+func (o *Orchestrator) syncBlockedJobs() {
+	type change struct {
+		job    *Job
+		status JobStatus
+		reason string
+	}
+	var changes []change
+
+	o.mu.Lock()
+	// Sorted so a blocked dependency is seen before the jobs that depend on it
+	for _, job := range o.Plan.GetJobsSortedByFilename() {
+		failed := job.FailedDependency()
+		switch {
+		case job.Status == JobStatusPending && failed != nil:
+			changes = append(changes, change{job, JobStatusBlocked, fmt.Sprintf("dependency %s failed", failed.ID)})
+		case job.Status == JobStatusBlocked && job.BlockedReason != "" && failed == nil:
+			changes = append(changes, change{job, JobStatusPending, ""})
 		}
+	}
+	o.mu.Unlock()
 
-		// Run jobs
-		if err := o.runJobsConcurrently(ctx, runnable); err != nil {
-			o.logger.Error("Error running jobs", "error", err)
-			// Continue to allow other jobs to run
+	for _, c := range changes {
+		c.job.BlockedReason = c.reason
+		if err := o.UpdateJobStatus(c.job, c.status); err != nil {
+			o.logger.Error("Failed to update blocked job", "job", c.job.ID, "error", err)
+			continue
 		}
+		o.dependencyGraph.UpdateJobStatus(c.job.ID, c.status)
+	}
+}
 
-		// Increment step counter and check limit
-		stepCount++
-		if stepCount >= limit {
-			return fmt.Errorf("execution halted: maximum consecutive step limit (%d) reached. This is a safeguard against potential infinite loops", limit)
+// syncSkippedJobs skips pending jobs that depend on a skipped job, so a false
+// run_if condition skips everything downstream of it instead of leaving those
+// jobs pending forever. Jobs with allow_skipped_deps run instead.
+func (o *Orchestrator) syncSkippedJobs() {
+	type change struct {
+		job    *Job
+		reason string
+	}
+	var changes []change
+
+	o.mu.Lock()
+	skipped := make(map[string]bool)
+	for _, job := range o.Plan.Jobs {
+		if job.Status == JobStatusSkipped {
+			skipped[job.ID] = true
 		}
+	}
+	// Repeat until stable so chains are skipped regardless of file order
+	for progress := true; progress; {
+		progress = false
+		for _, job := range o.Plan.GetJobsSortedByFilename() {
+			if job.Status != JobStatusPending || job.AllowSkippedDeps || skipped[job.ID] {
+				continue
+			}
+			for _, dep := range job.Dependencies {
+				if dep != nil && skipped[dep.ID] {
+					skipped[job.ID] = true
+					changes = append(changes, change{job, fmt.Sprintf("dependency %s skipped", dep.ID)})
+					progress = true
+					break
+				}
+			}
+		}
+	}
+	o.mu.Unlock()
 
-		// Small delay before next iteration
-		time.Sleep(1 * time.Second)
+	for _, c := range changes {
+		c.job.SkipReason = c.reason
+		if err := o.UpdateJobStatus(c.job, JobStatusSkipped); err != nil {
+			o.logger.Error("Failed to update skipped job", "job", c.job.ID, "error", err)
+			continue
+		}
+		o.dependencyGraph.UpdateJobStatus(c.job.ID, JobStatusSkipped)
 	}
 }
 
+// runOnFailHook runs the plan's on_fail hook for the jobs that failed in the
+// current run, logging rather than returning hook errors so they don't mask the
+// failed jobs.
+func (o *Orchestrator) runOnFailHook(ctx context.Context, failed []*Job) {
+	if len(failed) == 0 {
+		return
+	}
+	if err := runOnFailHook(ctx, o.Plan, failed); err != nil {
+		o.logger.Error("on_fail hook failed", "error", err)
+	} else if o.Plan.Config != nil && o.Plan.Config.Hooks["on_fail"] != "" {
+		o.logger.Info("Ran on_fail hook", "plan", o.Plan.Name)
+	}
+}
+
+// runOnFailHookIfFailed runs the on_fail hook for those of jobs, which the
+// current call just ran, that ended up failed. Failures left by earlier runs
+// neither fire it nor appear in it.
+func (o *Orchestrator) runOnFailHookIfFailed(ctx context.Context, jobs []*Job) {
+	var failed []*Job
+	for _, job := range jobs {
+		if job.Status == JobStatusFailed {
+			failed = append(failed, job)
+		}
+	}
+	o.runOnFailHook(ctx, failed)
+}
+
+// MaxParallelJobs returns how many jobs the orchestrator runs at once.
+func (o *Orchestrator) MaxParallelJobs() int {
+	return o.maxParallelJobs()
+}
+
+// maxParallelJobs returns the configured worker pool size, never less than one.
+func (o *Orchestrator) maxParallelJobs() int {
+	if o.config.MaxParallelJobs < 1 {
+		return 1
+	}
+	return o.config.MaxParallelJobs
+}
+
+// runnableJobs returns the jobs whose dependencies are satisfied, ordered by filename.
+func (o *Orchestrator) runnableJobs() []*Job {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	runnable := o.dependencyGraph.GetRunnableJobs()
+	sort.Slice(runnable, func(i, j int) bool {
+		return runnable[i].Filename < runnable[j].Filename
+	})
+	return runnable
+}
+
+// requiresTerminal reports whether a job needs exclusive use of the terminal
+// and therefore must not run alongside other jobs.
+func requiresTerminal(job *Job) bool {
+	switch job.Type {
+	case JobTypeChat, JobTypeInteractiveAgent, JobTypeAgent:
+		return true
+	}
+	return false
+}
+
 // reloadJobStatusesFromDisk reloads job statuses from their files
 // This allows the orchestrator to detect external changes (e.g., from 'flow plan complete')
 func (o *Orchestrator) reloadJobStatusesFromDisk() error {
@@ -299,6 +607,8 @@ func (o *Orchestrator) reloadJobStatusesFromDisk() error {
 			job.Status = diskJob.Status
 			job.StartTime = diskJob.StartTime
 			job.EndTime = diskJob.EndTime
+			job.BlockedReason = diskJob.BlockedReason
+			job.SkipReason = diskJob.SkipReason
 			
 			// Update dependency graph
 			o.dependencyGraph.UpdateJobStatus(job.ID, job.Status)
@@ -312,12 +622,27 @@ func (o *Orchestrator) reloadJobStatusesFromDisk() error {
 func (o *Orchestrator) GetStatus() *PlanStatus {
 	o.mu.Lock()
 	defer o.mu.Unlock()
+	return countPlanStatus(o.Plan, o.dependencyGraph)
+}
+
+// GetPlanStatus counts plan's jobs by status as Orchestrator.GetStatus does,
+// for callers that have no orchestrator.
+func GetPlanStatus(plan *Plan) (*PlanStatus, error) {
+	graph, err := BuildDependencyGraph(plan)
+	if err != nil {
+		return nil, err
+	}
+	return countPlanStatus(plan, graph), nil
+}
 
+// countPlanStatus counts plan's jobs by status, using graph to tell runnable
+// pending jobs from blocked ones.
+func countPlanStatus(plan *Plan, graph *DependencyGraph) *PlanStatus {
 	status := &PlanStatus{
-		Total: len(o.Plan.Jobs),
+		Total: len(plan.Jobs),
 	}
 
-	for _, job := range o.Plan.Jobs {
+	for _, job := range plan.Jobs {
 		switch job.Status {
 		case JobStatusPending, JobStatusPendingUser, JobStatusPendingLLM:
 			status.Pending++
@@ -327,11 +652,13 @@ func (o *Orchestrator) GetStatus() *PlanStatus {
 			status.Completed++
 		case JobStatusFailed:
 			status.Failed++
+		case JobStatusSkipped:
+			status.Skipped++
 		}
 	}
 
 	// Calculate blocked jobs (pending but not runnable)
-	runnable := o.dependencyGraph.GetRunnableJobs()
+	runnable := graph.GetRunnableJobs()
 	status.Blocked = status.Pending - len(runnable)
 
 	// Calculate progress
@@ -342,28 +669,66 @@ func (o *Orchestrator) GetStatus() *PlanStatus {
 	return status
 }
 
-// runJobsConcurrently executes multiple jobs in parallel.
+// runJobsConcurrently executes multiple jobs using a worker pool of up to
+// MaxParallelJobs workers. Jobs in the same concurrency group take turns, and
+// jobs that need the terminal run serially afterwards.
 func (o *Orchestrator) runJobsConcurrently(ctx context.Context, jobs []*Job) error {
+	var parallel, serial []*Job
+	for _, job := range jobs {
+		if requiresTerminal(job) {
+			serial = append(serial, job)
+		} else {
+			parallel = append(parallel, job)
+		}
+	}
+
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(jobs))
 
-	sem := make(chan struct{}, o.config.MaxParallelJobs)
+	sem := make(chan struct{}, o.maxParallelJobs())
 
-	for _, job := range jobs {
+	// Each ungrouped job gets its own lane; jobs sharing a concurrency group
+	// share one, so they run one after another in the order given.
+	var lanes [][]*Job
+	groupLane := make(map[string]int)
+	for _, job := range parallel {
+		if job.ConcurrencyGroup == "" {
+			lanes = append(lanes, []*Job{job})
+			continue
+		}
+		if i, ok := groupLane[job.ConcurrencyGroup]; ok {
+			lanes[i] = append(lanes[i], job)
+			continue
+		}
+		groupLane[job.ConcurrencyGroup] = len(lanes)
+		lanes = append(lanes, []*Job{job})
+	}
+
+	for _, lane := range lanes {
 		wg.Add(1)
-		go func(j *Job) {
+		go func(lane []*Job) {
 			defer wg.Done()
 
-			sem <- struct{}{}
-			defer func() { <-sem }()
-
-			if err := o.executeJob(ctx, j); err != nil {
-				errChan <- fmt.Errorf("job %s: %w", j.ID, err)
+			for _, j := range lane {
+				sem <- struct{}{}
+				if err := o.executeJob(ctx, j); err != nil {
+					errChan <- fmt.Errorf("job %s: %w", j.ID, err)
+				}
+				<-sem
 			}
-		}(job)
+		}(lane)
 	}
 
 	wg.Wait()
+
+	for _, job := range serial {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := o.executeJob(ctx, job); err != nil {
+			errChan <- fmt.Errorf("job %s: %w", job.ID, err)
+		}
+	}
 	close(errChan)
 
 	// Collect errors
@@ -379,6 +744,17 @@ func (o *Orchestrator) runJobsConcurrently(ctx context.Context, jobs []*Job) err
 	return nil
 }
 
+// runDryRun assembles prompts for the given jobs one at a time so their output doesn't interleave.
+func (o *Orchestrator) runDryRun(ctx context.Context, jobs []*Job) error {
+	var errs []error
+	for _, job := range jobs {
+		if err := o.executeJob(ctx, job); err != nil {
+			errs = append(errs, fmt.Errorf("job %s: %w", job.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // logFieldsToKeyVals converts a map to alternating key-value pairs for structured logging
 func logFieldsToKeyVals(fields map[string]interface{}) []interface{} {
 	result := make([]interface{}, 0, len(fields)*2)
@@ -447,17 +823,46 @@ func (o *Orchestrator) ExecuteJobWithWriter(ctx context.Context, job *Job, outpu
 		o.logger.Info("Executing job", logFieldsToKeyVals(logFields)...)
 	}
 
-	// Update status to running
-	if err := o.UpdateJobStatus(job, JobStatusRunning); err != nil {
-		return fmt.Errorf("update status to running: %w", err)
-	}
-
 	// Get executor
 	executor, ok := o.executors[job.Type]
 	if !ok {
 		return fmt.Errorf("no executor for job type: %s", job.Type)
 	}
 
+	// Only LLM prompt assembly can be previewed; everything else would have side effects.
+	if o.config.DryRun {
+		if job.Type != JobTypeOneshot && job.Type != JobTypeChat {
+			fmt.Fprintf(output, "\n=== Dry run: %s (%s) ===\nSkipped: dry run only supports oneshot and chat jobs\n", job.Filename, job.Type)
+			return nil
+		}
+		return executor.Execute(ctx, job, o.Plan)
+	}
+
+	// A false run_if condition skips the job rather than running it
+	if job.RunIf != nil {
+		shouldRun, err := evaluateRunIf(job, o.Plan)
+		if err != nil {
+			o.logger.Error("Failed to evaluate run_if", "request_id", requestID, "id", job.ID, "error", err)
+			if updateErr := o.UpdateJobStatus(job, JobStatusFailed); updateErr != nil {
+				return fmt.Errorf("update final status: %w", updateErr)
+			}
+			return fmt.Errorf("evaluating run_if: %w", err)
+		}
+		if !shouldRun {
+			job.SkipReason = "run_if not met: " + job.RunIf.String()
+			o.logger.Info("Skipping job", "request_id", requestID, "id", job.ID, "reason", job.SkipReason)
+			if err := o.UpdateJobStatus(job, JobStatusSkipped); err != nil {
+				return fmt.Errorf("update status to skipped: %w", err)
+			}
+			return nil
+		}
+	}
+
+	// Update status to running
+	if err := o.UpdateJobStatus(job, JobStatusRunning); err != nil {
+		return fmt.Errorf("update status to running: %w", err)
+	}
+
 	// Execute job. The writer is already attached to the context.
 	execErr := executor.Execute(ctx, job, o.Plan)
 
@@ -3,6 +3,12 @@ package orchestration
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -28,6 +34,23 @@ func (m *mockExecutor) Name() string {
 	return m.name
 }
 
+// loadTestPlan writes the given job files to a temporary plan directory and
+// loads it, so jobs have real files for status updates to persist to.
+func loadTestPlan(t *testing.T, files map[string]string) *Plan {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	plan, err := LoadPlan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return plan
+}
+
 func TestNewOrchestrator(t *testing.T) {
 	plan := &Plan{
 		Name:      "test-plan",
@@ -259,6 +282,136 @@ func TestOrchestrator_RunAll(t *testing.T) {
 	}
 }
 
+func TestOrchestrator_RunAll_Parallel(t *testing.T) {
+	plan := loadTestPlan(t, map[string]string{
+		"01-job1.md": "---\nid: job1\ntitle: Job 1\nstatus: pending\ntype: oneshot\n---\nFirst.\n",
+		"02-job2.md": "---\nid: job2\ntitle: Job 2\nstatus: pending\ntype: oneshot\n---\nSecond.\n",
+		"03-job3.md": "---\nid: job3\ntitle: Job 3\nstatus: pending\ntype: oneshot\n---\nThird.\n",
+		"04-chat.md": "---\nid: chat\ntitle: Chat\nstatus: pending\ntype: chat\n---\nFourth.\n",
+	})
+
+	config := &OrchestratorConfig{
+		MaxParallelJobs: 3,
+		CheckInterval:   10 * time.Millisecond,
+	}
+
+	orch, err := NewOrchestrator(plan, config)
+	if err != nil {
+		t.Fatalf("Failed to create orchestrator: %v", err)
+	}
+
+	var mu sync.Mutex
+	running, peak := 0, 0
+	chatOverlapped := false
+	track := func(job *Job) func() {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		if job.Type == JobTypeChat && running > 1 {
+			chatOverlapped = true
+		}
+		mu.Unlock()
+		return func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}
+	}
+
+	oneshotExec := &mockExecutor{
+		executeFunc: func(ctx context.Context, job *Job, plan *Plan) error {
+			defer track(job)()
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		},
+	}
+	chatExec := &mockExecutor{
+		executeFunc: func(ctx context.Context, job *Job, plan *Plan) error {
+			defer track(job)()
+			// Chat jobs persist their own status, like the real executor
+			job.Status = JobStatusCompleted
+			return updateJobFile(job)
+		},
+	}
+	orch.executors[JobTypeOneshot] = oneshotExec
+	orch.executors[JobTypeChat] = chatExec
+
+	if err := orch.RunAll(context.Background()); err != nil {
+		t.Errorf("RunAll failed: %v", err)
+	}
+
+	if peak < 2 {
+		t.Errorf("Expected independent oneshot jobs to run concurrently, peak concurrency was %d", peak)
+	}
+	if chatOverlapped {
+		t.Error("Chat job ran alongside other jobs")
+	}
+	for _, job := range plan.Jobs {
+		if job.Status != JobStatusCompleted {
+			t.Errorf("Job %s should be completed, got %s", job.ID, job.Status)
+		}
+	}
+}
+
+func TestOrchestrator_RunAll_ConcurrencyGroups(t *testing.T) {
+	plan := loadTestPlan(t, map[string]string{
+		"01-migrate.md": "---\nid: migrate\ntitle: Migrate\nstatus: pending\ntype: oneshot\nconcurrency_group: db\n---\nFirst.\n",
+		"02-seed.md":    "---\nid: seed\ntitle: Seed\nstatus: pending\ntype: oneshot\nconcurrency_group: db\n---\nSecond.\n",
+		"03-docs.md":    "---\nid: docs\ntitle: Docs\nstatus: pending\ntype: oneshot\n---\nThird.\n",
+	})
+
+	orch, err := NewOrchestrator(plan, &OrchestratorConfig{
+		MaxParallelJobs: 3,
+		CheckInterval:   10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create orchestrator: %v", err)
+	}
+
+	var mu sync.Mutex
+	running, peak, dbRunning, dbPeak := 0, 0, 0, 0
+	orch.executors[JobTypeOneshot] = &mockExecutor{
+		executeFunc: func(ctx context.Context, job *Job, plan *Plan) error {
+			mu.Lock()
+			running++
+			peak = max(peak, running)
+			if job.ConcurrencyGroup == "db" {
+				dbRunning++
+				dbPeak = max(dbPeak, dbRunning)
+			}
+			mu.Unlock()
+
+			time.Sleep(50 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			if job.ConcurrencyGroup == "db" {
+				dbRunning--
+			}
+			mu.Unlock()
+			job.Status = JobStatusCompleted
+			return nil
+		},
+	}
+
+	if err := orch.RunAll(context.Background()); err != nil {
+		t.Errorf("RunAll failed: %v", err)
+	}
+	if dbPeak != 1 {
+		t.Errorf("Jobs in the same concurrency group overlapped (peak %d)", dbPeak)
+	}
+	if peak < 2 {
+		t.Errorf("Ungrouped job should run alongside the group, peak concurrency was %d", peak)
+	}
+	for _, job := range plan.Jobs {
+		if job.Status != JobStatusCompleted {
+			t.Errorf("Job %s should be completed, got %s", job.ID, job.Status)
+		}
+	}
+}
+
 func TestOrchestrator_UpdateJobStatus(t *testing.T) {
 	plan := &Plan{
 		Name: "test-plan",
@@ -306,13 +459,11 @@ func TestOrchestrator_UpdateJobStatus(t *testing.T) {
 }
 
 func TestOrchestrator_HandleFailures(t *testing.T) {
-	plan := &Plan{
-		Name: "test-plan",
-		Jobs: []*Job{
-			{ID: "job1", Type: JobTypeOneshot, Status: JobStatusPending},
-			{ID: "job2", Type: JobTypeOneshot, Status: JobStatusPending, DependsOn: []string{"job1"}},
-		},
-	}
+	plan := loadTestPlan(t, map[string]string{
+		"01-job1.md": "---\nid: job1\ntitle: Job 1\nstatus: pending\ntype: oneshot\n---\nFirst.\n",
+		"02-job2.md": "---\nid: job2\ntitle: Job 2\nstatus: pending\ntype: oneshot\ndepends_on:\n  - job1\n---\nSecond.\n",
+		"03-job3.md": "---\nid: job3\ntitle: Job 3\nstatus: pending\ntype: oneshot\ndepends_on:\n  - job2\n---\nThird.\n",
+	})
 
 	orch, err := NewOrchestrator(plan, nil)
 	if err != nil {
@@ -335,13 +486,309 @@ func TestOrchestrator_HandleFailures(t *testing.T) {
 		t.Errorf("Expected error due to failed jobs")
 	}
 
-	// Verify job1 is marked as failed
-	if plan.Jobs[0].Status != JobStatusFailed {
+	job1, _ := plan.GetJobByID("job1")
+	job2, _ := plan.GetJobByID("job2")
+	job3, _ := plan.GetJobByID("job3")
+	if job1.Status != JobStatusFailed {
 		t.Errorf("Job1 should be marked as failed")
 	}
 
-	// Verify job2 is still pending (blocked by failed dependency)
-	if plan.Jobs[1].Status != JobStatusPending {
-		t.Errorf("Job2 should still be pending")
+	// Dependents are blocked, pointing at the failed job as the root cause
+	for _, job := range []*Job{job2, job3} {
+		if job.Status != JobStatusBlocked || job.BlockedReason != "dependency job1 failed" {
+			t.Errorf("%s = %s (%q), want blocked (dependency job1 failed)", job.ID, job.Status, job.BlockedReason)
+		}
+		onDisk, err := LoadJob(job.FilePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if onDisk.Status != JobStatusBlocked || onDisk.BlockedReason != "dependency job1 failed" {
+			t.Errorf("%s on disk = %s (%q), want blocked with reason", job.ID, onDisk.Status, onDisk.BlockedReason)
+		}
+	}
+
+	// Clearing the failure returns blocked dependents to pending
+	if err := orch.UpdateJobStatus(job1, JobStatusPending); err != nil {
+		t.Fatal(err)
+	}
+	orch.syncBlockedJobs()
+	for _, job := range []*Job{job2, job3} {
+		if job.Status != JobStatusPending || job.BlockedReason != "" {
+			t.Errorf("%s = %s (%q), want pending with no reason", job.ID, job.Status, job.BlockedReason)
+		}
 	}
-}
\ No newline at end of file
+}
+
+func TestOrchestrator_RunNextAndReadyBlockDependents(t *testing.T) {
+	for _, name := range []string{"RunNext", "RunReady"} {
+		t.Run(name, func(t *testing.T) {
+			plan := loadTestPlan(t, map[string]string{
+				"01-broken.md":    "---\nid: broken\ntitle: Broken\nstatus: pending\ntype: oneshot\n---\nFirst.\n",
+				"02-dependent.md": "---\nid: dependent\ntitle: Dependent\nstatus: pending\ntype: oneshot\ndepends_on:\n  - broken\n---\nSecond.\n",
+			})
+			orch, err := NewOrchestrator(plan, &OrchestratorConfig{MaxParallelJobs: 1})
+			if err != nil {
+				t.Fatalf("Failed to create orchestrator: %v", err)
+			}
+			orch.executors[JobTypeOneshot] = &mockExecutor{
+				executeFunc: func(ctx context.Context, job *Job, plan *Plan) error {
+					return fmt.Errorf("simulated failure")
+				},
+			}
+
+			run := orch.RunNext
+			if name == "RunReady" {
+				run = orch.RunReady
+			}
+			if err := run(context.Background()); err == nil {
+				t.Fatal("Expected an error due to the failed job")
+			}
+
+			dependent, _ := plan.GetJobByID("dependent")
+			if dependent.Status != JobStatusBlocked || dependent.BlockedReason != "dependency broken failed" {
+				t.Errorf("dependent = %s (%q), want blocked (dependency broken failed)", dependent.Status, dependent.BlockedReason)
+			}
+		})
+	}
+}
+
+func TestOrchestrator_FailFast(t *testing.T) {
+	for _, continueOnFailure := range []bool{false, true} {
+		t.Run(fmt.Sprintf("continue=%v", continueOnFailure), func(t *testing.T) {
+			plan := loadTestPlan(t, map[string]string{
+				"01-broken.md":      "---\nid: broken\ntitle: Broken\nstatus: pending\ntype: oneshot\n---\nFirst.\n",
+				"02-independent.md": "---\nid: independent\ntitle: Independent\nstatus: pending\ntype: oneshot\n---\nSecond.\n",
+				"03-dependent.md":   "---\nid: dependent\ntitle: Dependent\nstatus: pending\ntype: oneshot\ndepends_on:\n  - broken\n---\nThird.\n",
+			})
+
+			orch, err := NewOrchestrator(plan, &OrchestratorConfig{
+				MaxParallelJobs:   1,
+				CheckInterval:     10 * time.Millisecond,
+				ContinueOnFailure: continueOnFailure,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create orchestrator: %v", err)
+			}
+			orch.executors[JobTypeOneshot] = &mockExecutor{
+				executeFunc: func(ctx context.Context, job *Job, plan *Plan) error {
+					if job.ID == "broken" {
+						return fmt.Errorf("simulated failure")
+					}
+					job.Status = JobStatusCompleted
+					return nil
+				},
+			}
+
+			err = orch.RunAll(context.Background())
+			if err == nil {
+				t.Fatal("Expected an error due to the failed job")
+			}
+
+			independent, _ := plan.GetJobByID("independent")
+			dependent, _ := plan.GetJobByID("dependent")
+			if dependent.Status != JobStatusBlocked {
+				t.Errorf("dependent = %s, want blocked", dependent.Status)
+			}
+			if continueOnFailure {
+				if independent.Status != JobStatusCompleted {
+					t.Errorf("independent = %s, want completed", independent.Status)
+				}
+				if !strings.Contains(err.Error(), "failed jobs: broken") {
+					t.Errorf("error should list the failed jobs: %v", err)
+				}
+			} else {
+				if independent.Status != JobStatusPending {
+					t.Errorf("independent = %s, want pending (not started after the failure)", independent.Status)
+				}
+				if !strings.Contains(err.Error(), "stopped after job broken failed") {
+					t.Errorf("error should say the run stopped: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestOrchestrator_ResumeFromCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	writeJob := func(name, status string) string {
+		path := filepath.Join(dir, name)
+		content := fmt.Sprintf("---\nid: %s\ntitle: %s\nstatus: %s\ntype: shell\n---\necho hi\n", strings.TrimSuffix(name, ".md"), name, status)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	finished := writeJob("01-finished.md", "running")
+	killed := writeJob("02-killed.md", "running")
+	writeJob("03-next.md", "pending")
+
+	// A PID that is guaranteed to be dead
+	exited := exec.Command("true")
+	if err := exited.Run(); err != nil {
+		t.Skip("cannot start a helper process")
+	}
+	for _, path := range []string{finished, killed} {
+		if err := CreateLockFile(path, exited.Process.Pid); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	checkpoint := newRunCheckpoint()
+	checkpoint.Completed["01-finished"] = time.Now()
+	if err := checkpoint.save(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := LoadPlan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orch, err := NewOrchestrator(plan, &OrchestratorConfig{MaxParallelJobs: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := orch.resumeFromCheckpoint(); err != nil {
+		t.Fatalf("resumeFromCheckpoint() error = %v", err)
+	}
+
+	want := map[string]JobStatus{
+		"01-finished": JobStatusCompleted,
+		"02-killed":   JobStatusPending,
+		"03-next":     JobStatusPending,
+	}
+	for id, status := range want {
+		job, _ := plan.GetJobByID(id)
+		if job.Status != status {
+			t.Errorf("%s status = %s, want %s", id, job.Status, status)
+		}
+	}
+	if _, err := ReadLockFile(killed); !os.IsNotExist(err) {
+		t.Error("stale lock file should have been removed")
+	}
+
+	// Without a checkpoint, a job left running by a dead process is still reset
+	if err := RemoveRunCheckpoint(dir); err != nil {
+		t.Fatal(err)
+	}
+	orphan := writeJob("04-orphan.md", "running")
+	if err := CreateLockFile(orphan, exited.Process.Pid); err != nil {
+		t.Fatal(err)
+	}
+	plan, err = LoadPlan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh, err := NewOrchestrator(plan, &OrchestratorConfig{MaxParallelJobs: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fresh.resumeFromCheckpoint(); err != nil {
+		t.Fatal(err)
+	}
+	if job, _ := plan.GetJobByID("04-orphan"); job.Status != JobStatusPending {
+		t.Errorf("04-orphan status = %s without a checkpoint, want pending", job.Status)
+	}
+
+	restart, err := NewOrchestrator(plan, &OrchestratorConfig{Restart: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := restart.resumeFromCheckpoint(); err != nil {
+		t.Fatal(err)
+	}
+	if cp, _ := LoadRunCheckpoint(dir); cp != nil {
+		t.Error("--restart should discard the checkpoint")
+	}
+}
+
+func TestOrchestrator_RunReady(t *testing.T) {
+	plan := loadTestPlan(t, map[string]string{
+		"01-spec.md":   "---\nid: spec\ntitle: Spec\nstatus: pending\ntype: oneshot\n---\nFirst.\n",
+		"02-docs.md":   "---\nid: docs\ntitle: Docs\nstatus: pending\ntype: oneshot\n---\nSecond.\n",
+		"03-impl.md":   "---\nid: impl\ntitle: Impl\nstatus: pending\ntype: oneshot\ndepends_on:\n  - spec\n---\nThird.\n",
+		"04-review.md": "---\nid: review\ntitle: Review\nstatus: pending\ntype: oneshot\ndepends_on:\n  - impl\n---\nFourth.\n",
+	})
+
+	orch, err := NewOrchestrator(plan, &OrchestratorConfig{
+		MaxParallelJobs: 1,
+		CheckInterval:   10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create orchestrator: %v", err)
+	}
+	var ran []string
+	orch.executors[JobTypeOneshot] = &mockExecutor{
+		executeFunc: func(ctx context.Context, job *Job, plan *Plan) error {
+			ran = append(ran, job.ID)
+			job.Status = JobStatusCompleted
+			return nil
+		},
+	}
+
+	// Each call runs the whole ready frontier, even past the parallel limit,
+	// and nothing it unblocks.
+	for _, want := range []string{"docs,spec", "impl", "review"} {
+		ran = nil
+		if err := orch.RunReady(context.Background()); err != nil {
+			t.Fatalf("RunReady: %v", err)
+		}
+		sort.Strings(ran)
+		if got := strings.Join(ran, ","); got != want {
+			t.Errorf("jobs run = %s, want %s", got, want)
+		}
+	}
+	if err := orch.RunReady(context.Background()); err == nil {
+		t.Error("expected an error once no jobs are runnable")
+	}
+}
+
+func TestOrchestrator_OnFailHookOnlyForThisRun(t *testing.T) {
+	plan := loadTestPlan(t, map[string]string{
+		"01-old.md":  "---\nid: old\ntitle: Old\nstatus: failed\ntype: oneshot\n---\nFirst.\n",
+		"02-next.md": "---\nid: next\ntitle: Next\nstatus: pending\ntype: oneshot\n---\nSecond.\n",
+	})
+	plan.Config = &PlanConfig{Hooks: map[string]string{"on_fail": "echo failed: $FLOW_FAILED_JOB_IDS"}}
+
+	orch, err := NewOrchestrator(plan, &OrchestratorConfig{
+		MaxParallelJobs: 1,
+		CheckInterval:   10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create orchestrator: %v", err)
+	}
+	fail := false
+	orch.executors[JobTypeOneshot] = &mockExecutor{
+		executeFunc: func(ctx context.Context, job *Job, plan *Plan) error {
+			if fail {
+				return fmt.Errorf("simulated failure")
+			}
+			job.Status = JobStatusCompleted
+			return nil
+		},
+	}
+
+	logPath := filepath.Join(plan.Directory, ".artifacts", "on_fail.log")
+	// The only failure predates the run, so the hook stays quiet.
+	if err := orch.RunAll(context.Background()); err == nil {
+		t.Fatal("Expected an error for the previously failed job")
+	}
+	if _, err := os.Stat(logPath); !os.IsNotExist(err) {
+		t.Error("on_fail should not run for failures left by an earlier run")
+	}
+
+	next, _ := plan.GetJobByID("next")
+	if err := orch.UpdateJobStatus(next, JobStatusPending); err != nil {
+		t.Fatal(err)
+	}
+	fail = true
+	if err := orch.RunJob(context.Background(), next.FilePath); err == nil {
+		t.Fatal("Expected an error from the failing job")
+	}
+	logContent, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("on_fail should run when RunJob leaves the job failed: %v", err)
+	}
+	if !strings.Contains(string(logContent), "failed: next\n") {
+		t.Errorf("on_fail should only report the job that failed in this run:\n%s", logContent)
+	}
+}
@@ -0,0 +1,93 @@
+package orchestration
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// OutputPathData is the data available to an output.path template, e.g.
+// "reports/{{.ID}}-{{.Date}}.md".
+type OutputPathData struct {
+	ID    string
+	Title string
+	Date  string // Day the job started, as YYYY-MM-DD
+	Plan  string // Plan name
+}
+
+// unsafePathChars matches characters that are not allowed, or are awkward, in
+// file names on common filesystems.
+var unsafePathChars = regexp.MustCompile(`[<>:"|?*\\\x00-\x1f]+`)
+
+// renderOutputPath renders job's output.path template and resolves it relative
+// to the plan directory. Each path segment is sanitized, and a path that
+// renders empty or would leave the plan directory is an error.
+func renderOutputPath(job *Job, plan *Plan) (string, error) {
+	tmpl, err := template.New("output.path").Option("missingkey=error").Parse(job.Output.Path)
+	if err != nil {
+		return "", fmt.Errorf("parsing output.path template: %w", err)
+	}
+
+	date := job.StartTime
+	if date.IsZero() {
+		date = time.Now()
+	}
+	data := OutputPathData{
+		ID:    job.ID,
+		Title: job.Title,
+		Date:  date.Format("2006-01-02"),
+	}
+	if plan != nil {
+		data.Plan = plan.Name
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", fmt.Errorf("rendering output.path template: %w", err)
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(rendered.String(), "/") {
+		segment = strings.TrimSpace(unsafePathChars.ReplaceAllString(segment, "-"))
+		if segment != "" && segment != "." {
+			segments = append(segments, segment)
+		}
+	}
+	relPath := filepath.Join(segments...)
+	if relPath == "" {
+		return "", fmt.Errorf("output.path %q renders to an empty path", job.Output.Path)
+	}
+	if strings.HasPrefix(rendered.String(), "/") || !filepath.IsLocal(relPath) {
+		return "", fmt.Errorf("output.path %q renders to %q, which is outside the plan directory", job.Output.Path, rendered.String())
+	}
+
+	dir := ""
+	if plan != nil {
+		dir = plan.Directory
+	}
+	return filepath.Join(dir, relPath), nil
+}
+
+// writeOutputPath writes response to the file named by job's output.path, if
+// set, creating its parent directories.
+func writeOutputPath(response string, job *Job, plan *Plan) error {
+	if job.Output.Path == "" {
+		return nil
+	}
+	path, err := renderOutputPath(job, plan)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+	if err := writeFileAtomic(path, []byte(response)); err != nil {
+		return fmt.Errorf("writing output file: %w", err)
+	}
+	return nil
+}
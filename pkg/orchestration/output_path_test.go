@@ -0,0 +1,66 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderOutputPath(t *testing.T) {
+	plan := &Plan{Name: "auth", Directory: "/plans/auth"}
+	started := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr string
+	}{
+		{name: "fixed", path: "out.md", want: "/plans/auth/out.md"},
+		{name: "job fields", path: "reports/{{.ID}}-{{.Date}}.md", want: "/plans/auth/reports/review-1-2026-03-14.md"},
+		{name: "unsafe characters", path: "{{.Title}}.md", want: "/plans/auth/Review- auth-.md"},
+		{name: "plan name", path: "./{{.Plan}}//{{.ID}}.md", want: "/plans/auth/auth/review-1.md"},
+		{name: "empty", path: "{{if false}}x{{end}}", wantErr: "empty path"},
+		{name: "traversal", path: "../{{.ID}}.md", wantErr: "outside the plan directory"},
+		{name: "absolute", path: "/tmp/{{.ID}}.md", wantErr: "outside the plan directory"},
+		{name: "unknown field", path: "{{.Model}}.md", wantErr: "rendering output.path template"},
+		{name: "bad template", path: "{{.ID", wantErr: "parsing output.path template"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &Job{ID: "review-1", Title: `Review: auth?`, StartTime: started, Output: OutputConfig{Path: tt.path}}
+			got, err := renderOutputPath(job, plan)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("renderOutputPath() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("renderOutputPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteOutputPath(t *testing.T) {
+	dir := t.TempDir()
+	plan := &Plan{Name: "auth", Directory: dir}
+	job := &Job{ID: "review-1", StartTime: time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC), Output: OutputConfig{Path: "reports/{{.ID}}-{{.Date}}.md"}}
+
+	if err := writeOutputPath("the response", job, plan); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "reports", "review-1-2026-03-14.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "the response" {
+		t.Errorf("output file = %q", data)
+	}
+}
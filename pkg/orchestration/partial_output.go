@@ -0,0 +1,85 @@
+package orchestration
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// partialFileName returns the path of the sidecar file that collects a job's
+// response while it is still streaming.
+func partialFileName(jobFilePath string) string {
+	return jobFilePath + ".partial"
+}
+
+// partialOutput writes streamed response chunks to a job's .partial sidecar file
+// as they arrive, so a long generation is visible (and survives a failure)
+// before the final output is appended to the job file.
+type partialOutput struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// newPartialOutput creates (or truncates) the .partial sidecar for a job.
+func newPartialOutput(jobFilePath string) (*partialOutput, error) {
+	path := partialFileName(jobFilePath)
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating partial output file: %w", err)
+	}
+	return &partialOutput{path: path, file: file}, nil
+}
+
+// Write appends a chunk and flushes it to disk immediately.
+func (p *partialOutput) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n, err := p.file.Write(b)
+	if err != nil {
+		return n, err
+	}
+	return n, p.file.Sync()
+}
+
+// Reset discards anything written so far, e.g. before a retry.
+func (p *partialOutput) Reset() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.file.Truncate(0); err != nil {
+		return err
+	}
+	_, err := p.file.Seek(0, 0)
+	return err
+}
+
+// Len returns the number of bytes streamed so far.
+func (p *partialOutput) Len() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	info, err := p.file.Stat()
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// Discard closes and removes the sidecar once the output has been consolidated.
+func (p *partialOutput) Discard() error {
+	p.file.Close()
+	if err := os.Remove(p.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Keep closes the sidecar, leaving it on disk for inspection. Empty sidecars are removed.
+func (p *partialOutput) Keep() string {
+	size := p.Len()
+	p.file.Close()
+	if size == 0 {
+		os.Remove(p.path)
+		return ""
+	}
+	return p.path
+}
@@ -1,9 +1,12 @@
 package orchestration
 
+import "time"
+
 // PlanConfig holds plan-specific default settings from .grove-plan.yml.
 type PlanConfig struct {
 	Model                string            `yaml:"model,omitempty"`
 	Worktree             string            `yaml:"worktree,omitempty"`
+	Worktrees            []string          `yaml:"worktrees,omitempty"` // Run the plan once per worktree (see --worktree-matrix)
 	TargetAgentContainer string            `yaml:"target_agent_container,omitempty"`
 	Status               string            `yaml:"status,omitempty"`
 	Repos                []string          `yaml:"repos,omitempty"`                // List of repos to include in ecosystem worktree
@@ -11,7 +14,22 @@ type PlanConfig struct {
 	Inline               InlineConfig      `yaml:"inline,omitempty"`               // New field: controls which file types are inlined by default
 	PrependDependencies  bool              `yaml:"prepend_dependencies,omitempty"` // Deprecated: use inline instead
 	Hooks                map[string]string `yaml:"hooks,omitempty"`
-	Recipe               string            `yaml:"recipe,omitempty"` // Recipe used to create this plan
+	Recipe               string            `yaml:"recipe,omitempty"`               // Recipe used to create this plan
+	Timeout              time.Duration     `yaml:"timeout,omitempty"`              // Default LLM timeout for jobs in this plan (e.g. "10m")
+	RetryCount           *int              `yaml:"retry_count,omitempty"`          // Default LLM retry count for jobs in this plan
+	DefaultJobType       JobType           `yaml:"default_job_type,omitempty"`     // Job type for `plan add` when --type isn't given
+	MaxParallel          int               `yaml:"max_parallel,omitempty"`         // Default for `plan run --parallel`
+	Env                  map[string]string `yaml:"env,omitempty"`                  // Environment variables for job subprocesses (LLM, cx, hooks)
+	DependencyMaxBytes   int               `yaml:"dependency_max_bytes,omitempty"` // Default size limit for inlined dependency outputs
+}
+
+// NewJobType returns the type given to jobs added without an explicit type:
+// the plan's default_job_type, or interactive_agent.
+func (pc *PlanConfig) NewJobType() JobType {
+	if pc == nil || pc.DefaultJobType == "" {
+		return JobTypeInteractiveAgent
+	}
+	return pc.DefaultJobType
 }
 
 // ShouldInline checks if a specific category should be inlined by default for jobs in this plan.
@@ -44,4 +62,3 @@ type Plan struct {
 	Context       *ExecutionContext // Execution context for the plan
 	Config        *PlanConfig       // Plan-specific configuration from .grove-plan.yml
 }
-
@@ -0,0 +1,137 @@
+package orchestration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// appendedOutputMarker starts an output section appended by an append-to job.
+const appendedOutputMarker = "\n\n---\n\n## Output from "
+
+// ClonePlan copies src's job files and .grove-plan.yml into destDir as a fresh
+// plan. Each job gets a new unique ID, with depends_on and append-to output
+// references to old IDs remapped; statuses are reset to pending and appended output sections are
+// stripped. If worktree is non-empty, the plan and any job that named a worktree
+// are pointed at it. It returns the filenames written.
+func ClonePlan(src *Plan, destDir, worktree string) ([]string, error) {
+	if entries, err := os.ReadDir(destDir); err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("destination %s already exists and is not empty", destDir)
+	}
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating plan directory: %w", err)
+	}
+
+	if err := clonePlanConfig(src.Directory, destDir, worktree); err != nil {
+		return nil, err
+	}
+
+	// First pass: assign new IDs so dependencies can be remapped in the second
+	dest := &Plan{Directory: destDir, JobsByID: make(map[string]*Job)}
+	newIDs := make(map[string]string)
+	jobs := src.GetJobsSortedByFilename()
+	for _, job := range jobs {
+		newID := GenerateUniqueJobID(dest, job.Title)
+		newIDs[job.ID] = newID
+		clone := &Job{ID: newID}
+		dest.Jobs = append(dest.Jobs, clone)
+		dest.JobsByID[newID] = clone
+	}
+
+	// Second pass: rewrite each job file
+	var written []string
+	for _, job := range jobs {
+		content, err := os.ReadFile(job.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading job file %s: %w", job.Filename, err)
+		}
+		frontmatter, body, err := ParseFrontmatter(content)
+		if err != nil {
+			return nil, fmt.Errorf("parsing frontmatter for %s: %w", job.Filename, err)
+		}
+
+		frontmatter["id"] = newIDs[job.ID]
+		frontmatter["status"] = string(JobStatusPending)
+		for _, key := range runStateFields {
+			delete(frontmatter, key)
+		}
+		if len(job.DependsOn) > 0 {
+			deps := make([]string, len(job.DependsOn))
+			for i, dep := range job.DependsOn {
+				if newID, ok := newIDs[dep]; ok {
+					dep = newID
+				}
+				deps[i] = dep
+			}
+			frontmatter["depends_on"] = deps
+		}
+		if job.Output.Type == OutputTypeAppendTo {
+			if newID, ok := newIDs[job.Output.Target]; ok {
+				if output, ok := frontmatter["output"].(map[string]interface{}); ok {
+					output["target"] = newID
+				}
+			}
+		}
+		if worktree != "" && job.Worktree != "" {
+			frontmatter["worktree"] = worktree
+		}
+
+		newContent, err := RebuildMarkdownWithFrontmatter(frontmatter, stripAppendedOutput(body))
+		if err != nil {
+			return nil, fmt.Errorf("rebuilding %s: %w", job.Filename, err)
+		}
+		if err := os.WriteFile(filepath.Join(destDir, job.Filename), newContent, 0o644); err != nil {
+			return nil, fmt.Errorf("writing job file %s: %w", job.Filename, err)
+		}
+		written = append(written, job.Filename)
+	}
+
+	return written, nil
+}
+
+// stripAppendedOutput removes output sections appended after a job's prompt.
+func stripAppendedOutput(body []byte) []byte {
+	text := string(body)
+	for _, marker := range []string{outputSectionSeparator, appendedOutputMarker} {
+		if idx := strings.Index(text, marker); idx != -1 {
+			text = text[:idx]
+		}
+	}
+	return []byte(text)
+}
+
+// clonePlanConfig copies .grove-plan.yml, dropping the lifecycle status and
+// optionally replacing the worktree.
+func clonePlanConfig(srcDir, destDir, worktree string) error {
+	data, err := os.ReadFile(filepath.Join(srcDir, ".grove-plan.yml"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading plan config: %w", err)
+	}
+
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("parsing plan config: %w", err)
+	}
+	if config == nil {
+		config = make(map[string]interface{})
+	}
+	delete(config, "status")
+	if worktree != "" {
+		config["worktree"] = worktree
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("marshaling plan config: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(destDir, ".grove-plan.yml"), out, 0o644); err != nil {
+		return fmt.Errorf("writing plan config: %w", err)
+	}
+	return nil
+}
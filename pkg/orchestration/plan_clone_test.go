@@ -0,0 +1,65 @@
+package orchestration
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClonePlan(t *testing.T) {
+	src := loadTestPlan(t, map[string]string{
+		".grove-plan.yml": "model: gemini-2.5-pro\nworktree: old-tree\nstatus: review\n",
+		"01-design.md":    "---\nid: design-1234\ntitle: Design\nstatus: completed\ntype: oneshot\nworktree: old-tree\ncompleted_at: 2024-01-01T10:05:00Z\n---\nDesign it.\n\n---\n\n## Output\n\nThe design.\n",
+		"02-build.md":     "---\nid: build-5678\ntitle: Build\nstatus: failed\ntype: oneshot\nlast_error: boom\ndepends_on:\n  - design-1234\noutput:\n  type: append-to\n  target: design-1234\n---\nBuild it.\n",
+	})
+
+	destDir := filepath.Join(filepath.Dir(src.Directory), "dest")
+	written, err := ClonePlan(src, destDir, "new-tree")
+	if err != nil {
+		t.Fatalf("ClonePlan() error = %v", err)
+	}
+	if len(written) != 2 {
+		t.Fatalf("wrote %d files, want 2", len(written))
+	}
+
+	dest, err := LoadPlan(destDir)
+	if err != nil {
+		t.Fatalf("loading cloned plan: %v", err)
+	}
+	if dest.Config.Worktree != "new-tree" || dest.Config.Status != "" || dest.Config.Model != "gemini-2.5-pro" {
+		t.Errorf("plan config not cloned as expected: %+v", dest.Config)
+	}
+
+	design, _ := dest.GetJobByFilename("01-design.md")
+	build, _ := dest.GetJobByFilename("02-build.md")
+	if design.ID == "design-1234" || build.ID == "build-5678" {
+		t.Error("cloned jobs should get new IDs")
+	}
+	if len(build.DependsOn) != 1 || build.DependsOn[0] != design.ID {
+		t.Errorf("build depends_on = %v, want [%s]", build.DependsOn, design.ID)
+	}
+	if build.Output.Type != OutputTypeAppendTo || build.Output.Target != design.ID {
+		t.Errorf("build output = %+v, want append-to %s", build.Output, design.ID)
+	}
+	if build.Dependencies[0] != design {
+		t.Error("remapped dependency should resolve to the cloned design job")
+	}
+	for _, job := range dest.Jobs {
+		if job.Status != JobStatusPending {
+			t.Errorf("%s status = %s, want pending", job.Filename, job.Status)
+		}
+	}
+	if design.Worktree != "new-tree" {
+		t.Errorf("design worktree = %q, want new-tree", design.Worktree)
+	}
+	if strings.Contains(design.PromptBody, "The design.") {
+		t.Errorf("appended output should be stripped, got body %q", design.PromptBody)
+	}
+	if build.LastError != "" {
+		t.Error("last_error should be cleared")
+	}
+
+	if _, err := ClonePlan(src, destDir, ""); err == nil {
+		t.Error("cloning into a non-empty directory should fail")
+	}
+}
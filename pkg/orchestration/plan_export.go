@@ -0,0 +1,259 @@
+package orchestration
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// portablePathFields are job frontmatter fields holding a single file path.
+var portablePathFields = []string{"source_file", "rules_file"}
+
+// ExportPlan writes plan as a gzipped tarball to w. The archive holds a single
+// directory named after the plan with its .grove-plan.yml and job files, and,
+// if includeBriefings is set, the briefing files under .artifacts. Absolute
+// paths in job frontmatter are made relative to the plan directory or the
+// current git root where possible. It returns the archived paths.
+func ExportPlan(plan *Plan, w io.Writer, includeBriefings bool) ([]string, error) {
+	var roots []string
+	if plan.Directory != "" {
+		roots = append(roots, plan.Directory)
+	}
+	if gitRoot, err := GetGitRootSafe("."); err == nil && gitRoot != "" {
+		roots = append(roots, gitRoot)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	var written []string
+	add := func(rel string, data []byte) error {
+		hdr := &tar.Header{
+			Name:    path.Join(plan.Name, filepath.ToSlash(rel)),
+			Mode:    0o644,
+			Size:    int64(len(data)),
+			ModTime: time.Now(),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("writing archive header for %s: %w", rel, err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("writing %s to archive: %w", rel, err)
+		}
+		written = append(written, rel)
+		return nil
+	}
+
+	config, err := os.ReadFile(filepath.Join(plan.Directory, ".grove-plan.yml"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading plan config: %w", err)
+	}
+	if err == nil {
+		if err := add(".grove-plan.yml", config); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, job := range plan.GetJobsSortedByFilename() {
+		content, err := os.ReadFile(job.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading job file %s: %w", job.Filename, err)
+		}
+		content, err = portableJobContent(content, roots)
+		if err != nil {
+			return nil, fmt.Errorf("preparing %s for export: %w", job.Filename, err)
+		}
+		if err := add(job.Filename, content); err != nil {
+			return nil, err
+		}
+
+		if !includeBriefings {
+			continue
+		}
+		briefings, err := BriefingFiles(plan, job)
+		if err != nil {
+			return nil, err
+		}
+		for _, briefing := range briefings {
+			data, err := os.ReadFile(briefing)
+			if err != nil {
+				return nil, fmt.Errorf("reading briefing file: %w", err)
+			}
+			if err := add(filepath.Join(".artifacts", job.ID, filepath.Base(briefing)), data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("closing archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("closing archive: %w", err)
+	}
+	return written, nil
+}
+
+// portableJobContent rewrites absolute paths in a job's include, source_file,
+// rules_file and output.path frontmatter relative to the first of roots that
+// contains them. Content is returned unchanged if nothing was rewritten.
+func portableJobContent(content []byte, roots []string) ([]byte, error) {
+	frontmatter, body, err := ParseFrontmatter(content)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	relativize := func(p string) string {
+		if rel, ok := portablePath(p, roots); ok {
+			changed = true
+			return rel
+		}
+		return p
+	}
+
+	if includes, ok := frontmatter["include"].([]interface{}); ok {
+		for i, include := range includes {
+			if s, ok := include.(string); ok {
+				includes[i] = relativize(s)
+			} else if entry, ok := include.(map[string]interface{}); ok {
+				if s, ok := entry["path"].(string); ok {
+					entry["path"] = relativize(s)
+				}
+			}
+		}
+	}
+	for _, key := range portablePathFields {
+		if s, ok := frontmatter[key].(string); ok {
+			frontmatter[key] = relativize(s)
+		}
+	}
+	if output, ok := frontmatter["output"].(map[string]interface{}); ok {
+		if s, ok := output["path"].(string); ok {
+			output["path"] = relativize(s)
+		}
+	}
+
+	if !changed {
+		return content, nil
+	}
+	return RebuildMarkdownWithFrontmatter(frontmatter, body)
+}
+
+// portablePath returns p relative to the first of roots containing it, if p is
+// absolute and inside one of them.
+func portablePath(p string, roots []string) (string, bool) {
+	if !filepath.IsAbs(p) {
+		return "", false
+	}
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, p)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return rel, true
+		}
+	}
+	return "", false
+}
+
+// ExtractPlanArchive unpacks an archive written by ExportPlan into destDir and
+// returns the path of the extracted plan directory. Entries outside the
+// archive's single top-level directory are rejected.
+func ExtractPlanArchive(r io.Reader, destDir string) (string, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return "", fmt.Errorf("reading archive: %w", err)
+	}
+	defer gz.Close()
+
+	var planName string
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("reading archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(hdr.Name)
+		top, _, _ := strings.Cut(name, "/")
+		if path.IsAbs(name) || top == ".." || top == name {
+			return "", fmt.Errorf("invalid archive entry %q", hdr.Name)
+		}
+		if planName == "" {
+			planName = top
+		} else if top != planName {
+			return "", fmt.Errorf("archive contains more than one plan (%s and %s)", planName, top)
+		}
+
+		target := filepath.Join(destDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return "", fmt.Errorf("creating directory for %s: %w", name, err)
+		}
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return "", fmt.Errorf("extracting %s: %w", name, err)
+		}
+		_, err = io.Copy(f, tr)
+		f.Close()
+		if err != nil {
+			return "", fmt.Errorf("extracting %s: %w", name, err)
+		}
+	}
+
+	if planName == "" {
+		return "", fmt.Errorf("archive does not contain a plan")
+	}
+	return filepath.Join(destDir, planName), nil
+}
+
+// ImportPlan copies an extracted plan into destDir the way ClonePlan does,
+// giving every job a fresh ID, and copies any briefing files along with their
+// jobs. It returns the job filenames written.
+func ImportPlan(src *Plan, destDir string) ([]string, error) {
+	written, err := ClonePlan(src, destDir, "")
+	if err != nil {
+		return nil, err
+	}
+
+	dest, err := LoadPlan(destDir)
+	if err != nil {
+		return nil, fmt.Errorf("loading imported plan: %w", err)
+	}
+	for _, job := range src.Jobs {
+		imported, ok := dest.GetJobByFilename(job.Filename)
+		if !ok {
+			continue
+		}
+		briefings, err := BriefingFiles(src, job)
+		if err != nil {
+			return nil, err
+		}
+		if len(briefings) == 0 {
+			continue
+		}
+		newDir := filepath.Join(destDir, ".artifacts", imported.ID)
+		if err := os.MkdirAll(newDir, 0o755); err != nil {
+			return nil, fmt.Errorf("creating job artifact directory: %w", err)
+		}
+		for _, briefing := range briefings {
+			data, err := os.ReadFile(briefing)
+			if err != nil {
+				return nil, fmt.Errorf("reading briefing file: %w", err)
+			}
+			if err := os.WriteFile(filepath.Join(newDir, filepath.Base(briefing)), data, 0o644); err != nil {
+				return nil, fmt.Errorf("writing briefing file: %w", err)
+			}
+		}
+	}
+	return written, nil
+}
@@ -0,0 +1,101 @@
+package orchestration
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExportImportPlan(t *testing.T) {
+	root := t.TempDir()
+	srcDir := filepath.Join(root, "auth-plan")
+	if err := os.MkdirAll(srcDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		".grove-plan.yml": "model: gemini-2.5-pro\n",
+		"01-design.md":    "---\nid: design-1234\ntitle: Design\nstatus: completed\ntype: oneshot\ninclude:\n  - " + filepath.Join(srcDir, "notes.md") + "\n  - /elsewhere/spec.md\n---\nDesign it.\n",
+		"02-build.md":     "---\nid: build-5678\ntitle: Build\nstatus: pending\ntype: oneshot\ndepends_on:\n  - design-1234\n---\nBuild it.\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(srcDir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	src, err := LoadPlan(srcDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	design, _ := src.GetJobByFilename("01-design.md")
+	if _, err := WriteBriefingFile(src, design, "<prompt>design</prompt>\n", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	var archive bytes.Buffer
+	written, err := ExportPlan(src, &archive, true)
+	if err != nil {
+		t.Fatalf("ExportPlan() error = %v", err)
+	}
+	if len(written) != 4 {
+		t.Errorf("archived %v, want the config, two jobs and one briefing", written)
+	}
+
+	extracted, err := ExtractPlanArchive(bytes.NewReader(archive.Bytes()), filepath.Join(root, "extract"))
+	if err != nil {
+		t.Fatalf("ExtractPlanArchive() error = %v", err)
+	}
+	if filepath.Base(extracted) != "auth-plan" {
+		t.Errorf("extracted plan dir = %s, want auth-plan", extracted)
+	}
+	content, err := os.ReadFile(filepath.Join(extracted, "01-design.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), srcDir) {
+		t.Errorf("exported job still contains the plan's absolute path:\n%s", content)
+	}
+	if !strings.Contains(string(content), "notes.md") || !strings.Contains(string(content), "/elsewhere/spec.md") {
+		t.Errorf("exported include paths not kept:\n%s", content)
+	}
+
+	extractedPlan, err := LoadPlan(extracted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	destDir := filepath.Join(root, "imported")
+	if _, err := ImportPlan(extractedPlan, destDir); err != nil {
+		t.Fatalf("ImportPlan() error = %v", err)
+	}
+	dest, err := LoadPlan(destDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	importedDesign, _ := dest.GetJobByFilename("01-design.md")
+	importedBuild, _ := dest.GetJobByFilename("02-build.md")
+	if importedDesign.ID == "design-1234" {
+		t.Error("imported jobs should get new IDs")
+	}
+	if len(importedBuild.DependsOn) != 1 || importedBuild.DependsOn[0] != importedDesign.ID {
+		t.Errorf("build depends_on = %v, want [%s]", importedBuild.DependsOn, importedDesign.ID)
+	}
+	briefings, err := BriefingFiles(dest, importedDesign)
+	if err != nil || len(briefings) != 1 {
+		t.Errorf("imported briefings = %v, %v; want one", briefings, err)
+	}
+}
+
+func TestExtractPlanArchive_RejectsTraversal(t *testing.T) {
+	src := &Plan{Name: "..", Directory: t.TempDir()}
+	if err := os.WriteFile(filepath.Join(src.Directory, ".grove-plan.yml"), []byte("model: x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var archive bytes.Buffer
+	if _, err := ExportPlan(src, &archive, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ExtractPlanArchive(&archive, t.TempDir()); err == nil {
+		t.Error("expected an error for an entry outside the plan directory")
+	}
+}
@@ -0,0 +1,127 @@
+package orchestration
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// JobIDFix records a job given a new ID by FixDuplicateJobIDs.
+type JobIDFix struct {
+	Filename     string
+	OldID        string
+	NewID        string
+	ReferencedBy []string // Jobs whose depends_on was pointed at NewID
+}
+
+// FixDuplicateJobIDs gives every job file in dir that reuses the ID of an
+// earlier file (in filename order) a new unique ID. Each depends_on reference
+// to a duplicated ID is pointed at the closest job with that ID before the
+// referencing job in filename order; if no such job comes before it, the
+// reference is ambiguous and nothing is changed. Jobs without an ID are left
+// alone. It works on the raw job files, so it succeeds where LoadPlan fails.
+func FixDuplicateJobIDs(dir string) ([]JobIDFix, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading plan directory: %w", err)
+	}
+
+	var jobs []*Job
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+		job, err := LoadJob(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			var notAJob ErrNotAJob
+			if errors.As(err, &notAJob) {
+				continue
+			}
+			return nil, fmt.Errorf("loading job %s: %w", entry.Name(), err)
+		}
+		job.Filename = entry.Name()
+		job.FilePath = filepath.Join(dir, entry.Name())
+		jobs = append(jobs, job)
+	}
+
+	// First pass: keep each ID on its first file and assign new IDs to the rest
+	assigned := &Plan{Jobs: jobs}
+	seen := make(map[string]bool)
+	duplicated := make(map[string]bool)
+	fixIndex := make(map[string]int) // filename -> index into fixes
+	var fixes []JobIDFix
+	for _, job := range jobs {
+		if job.ID == "" {
+			continue
+		}
+		if !seen[job.ID] {
+			seen[job.ID] = true
+			continue
+		}
+		duplicated[job.ID] = true
+		newID := GenerateUniqueJobID(assigned, job.Title)
+		assigned.Jobs = append(assigned.Jobs, &Job{ID: newID})
+		fixIndex[job.Filename] = len(fixes)
+		fixes = append(fixes, JobIDFix{Filename: job.Filename, OldID: job.ID, NewID: newID})
+	}
+	if len(fixes) == 0 {
+		return nil, nil
+	}
+
+	// Second pass: remap references to duplicated IDs before writing anything
+	updates := make(map[string]map[string]interface{})
+	for i := range fixes {
+		updates[fixes[i].Filename] = map[string]interface{}{"id": fixes[i].NewID}
+	}
+	for i, job := range jobs {
+		deps := append([]string(nil), job.DependsOn...)
+		changed := false
+		for d, dep := range deps {
+			if !duplicated[dep] {
+				continue
+			}
+			target := ""
+			for k := i - 1; k >= 0; k-- {
+				if jobs[k].ID == dep {
+					target = jobs[k].Filename
+					break
+				}
+			}
+			if target == "" {
+				return nil, fmt.Errorf("%s depends on %s, but every job with that ID comes after it; edit its depends_on before fixing IDs", job.Filename, dep)
+			}
+			if f, ok := fixIndex[target]; ok {
+				deps[d] = fixes[f].NewID
+				fixes[f].ReferencedBy = append(fixes[f].ReferencedBy, job.Filename)
+				changed = true
+			}
+		}
+		if changed {
+			if updates[job.Filename] == nil {
+				updates[job.Filename] = make(map[string]interface{})
+			}
+			updates[job.Filename]["depends_on"] = deps
+		}
+	}
+
+	for _, job := range jobs {
+		jobUpdates, ok := updates[job.Filename]
+		if !ok {
+			continue
+		}
+		content, err := os.ReadFile(job.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading job file %s: %w", job.Filename, err)
+		}
+		newContent, err := UpdateFrontmatter(content, jobUpdates)
+		if err != nil {
+			return nil, fmt.Errorf("updating %s: %w", job.Filename, err)
+		}
+		if err := writeFileAtomic(job.FilePath, newContent); err != nil {
+			return nil, fmt.Errorf("writing job file %s: %w", job.Filename, err)
+		}
+	}
+	return fixes, nil
+}
@@ -0,0 +1,84 @@
+package orchestration
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFixDuplicateJobIDs(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"01-spec.md":   "---\nid: spec\ntitle: Spec\nstatus: completed\ntype: oneshot\n---\nWrite the spec.\n",
+		"02-spec.md":   "---\nid: spec\ntitle: Spec copy\nstatus: pending\ntype: oneshot\n---\nRevise the spec.\n",
+		"03-build.md":  "---\nid: build\ntitle: Build\nstatus: pending\ntype: oneshot\ndepends_on:\n  - spec\n---\nBuild it.\n",
+		"04-review.md": "---\nid: spec\ntitle: Review\nstatus: pending\ntype: oneshot\n---\nReview.\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := LoadPlan(dir)
+	var dupErr *DuplicateJobIDError
+	if !errors.As(err, &dupErr) {
+		t.Fatalf("LoadPlan error = %v, want DuplicateJobIDError", err)
+	}
+	if got := strings.Join(dupErr.Files["spec"], ","); got != "01-spec.md,02-spec.md,04-review.md" {
+		t.Errorf("duplicate files = %s", got)
+	}
+
+	fixes, err := FixDuplicateJobIDs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixes) != 2 || fixes[0].Filename != "02-spec.md" || fixes[1].Filename != "04-review.md" {
+		t.Fatalf("fixes = %+v", fixes)
+	}
+	if fixes[0].NewID == fixes[1].NewID || fixes[0].NewID == "spec" {
+		t.Errorf("new IDs are not unique: %+v", fixes)
+	}
+	if strings.Join(fixes[0].ReferencedBy, ",") != "03-build.md" {
+		t.Errorf("ReferencedBy = %v, want 03-build.md", fixes[0].ReferencedBy)
+	}
+
+	plan, err := LoadPlan(dir)
+	if err != nil {
+		t.Fatalf("plan still fails to load after fixing IDs: %v", err)
+	}
+	// build pointed at the closest spec before it, the renamed copy
+	build, _ := plan.GetJobByID("build")
+	if len(build.Dependencies) != 1 || build.Dependencies[0].Filename != "02-spec.md" {
+		t.Errorf("build should depend on 02-spec.md, got %v", build.DependsOn)
+	}
+	copyJob, _ := LoadJob(filepath.Join(dir, "02-spec.md"))
+	if copyJob.ID != fixes[0].NewID || !strings.Contains(copyJob.PromptBody, "Revise the spec.") {
+		t.Errorf("rewritten copy = %q / %q", copyJob.ID, copyJob.PromptBody)
+	}
+}
+
+func TestFixDuplicateJobIDs_RefusesAmbiguousReference(t *testing.T) {
+	dir := t.TempDir()
+	// 01-early depends on spec, but both jobs with that ID come after it
+	files := map[string]string{
+		"01-early.md": "---\nid: early\ntitle: Early\nstatus: pending\ntype: oneshot\ndepends_on:\n  - spec\n---\nEarly.\n",
+		"02-spec.md":  "---\nid: spec\ntitle: Spec\nstatus: pending\ntype: oneshot\n---\nSpec.\n",
+		"03-spec.md":  "---\nid: spec\ntitle: Spec copy\nstatus: pending\ntype: oneshot\n---\nSpec.\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := FixDuplicateJobIDs(dir)
+	if err == nil || !strings.Contains(err.Error(), "01-early.md") {
+		t.Errorf("FixDuplicateJobIDs() error = %v, want ambiguous reference from 01-early.md", err)
+	}
+	if copyJob, _ := LoadJob(filepath.Join(dir, "03-spec.md")); copyJob.ID != "spec" {
+		t.Errorf("03-spec.md was changed to %q despite the error", copyJob.ID)
+	}
+}
@@ -0,0 +1,109 @@
+package orchestration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+)
+
+// hashPrompt returns the SHA-256 of an assembled prompt, hex encoded.
+func hashPrompt(prompt string) string {
+	sum := sha256.Sum256([]byte(prompt))
+	return hex.EncodeToString(sum[:])
+}
+
+// recordRunProvenance saves the model a job ran with and the hash of the prompt
+// it was sent to the job's frontmatter as ran_model and prompt_hash.
+func recordRunProvenance(job *Job, model, promptHash string) error {
+	job.RanModel = model
+	job.PromptHash = promptHash
+	content, err := os.ReadFile(job.FilePath)
+	if err != nil {
+		return fmt.Errorf("reading job file: %w", err)
+	}
+	newContent, err := UpdateFrontmatter(content, map[string]interface{}{
+		"ran_model":   model,
+		"prompt_hash": promptHash,
+	})
+	if err != nil {
+		return fmt.Errorf("updating frontmatter: %w", err)
+	}
+	return writeFileAtomic(job.FilePath, newContent)
+}
+
+// currentPromptHash assembles the prompt job would be sent now, without
+// calling an LLM, and returns its hash as recordRunProvenance would have
+// stored it.
+func (e *OneShotExecutor) currentPromptHash(job *Job, plan *Plan) (string, error) {
+	return e.promptHash(job, plan, ScopeToSubProject(estimateWorkDir(job, plan), job))
+}
+
+// promptHash returns the hash recorded as prompt_hash. It covers the prompt
+// built from the job file and its inputs only: output appended by earlier
+// runs, the run-only --prompt-prefix/--prompt-suffix wrapping and dependency
+// summaries from the summary model are left out, since a later status check
+// can reproduce none of them.
+func (e *OneShotExecutor) promptHash(job *Job, plan *Plan, workDir string) (string, error) {
+	plan = withoutURLFetches(plan)
+	plan.Orchestration.PromptPrefix = ""
+	plan.Orchestration.PromptSuffix = ""
+
+	current := *job
+	current.PromptBody = string(stripAppendedOutput([]byte(job.PromptBody)))
+	current.DependencySummaries = nil
+
+	_, _, contextFiles, err := e.buildPrompt(&current, plan, workDir)
+	if err != nil {
+		return "", fmt.Errorf("determining context files: %w", err)
+	}
+	prompt, _, err := BuildXMLPrompt(&current, plan, workDir, contextFiles)
+	if err != nil {
+		return "", fmt.Errorf("building XML prompt: %w", err)
+	}
+	return hashPrompt(prompt), nil
+}
+
+// assemblePrompt builds the XML prompt job would be sent now, leaving out any
+// output appended to the job file by earlier runs. URL prompt sources are not
+// fetched, so status views can call it on every refresh.
+func (e *OneShotExecutor) assemblePrompt(job *Job, plan *Plan) (string, error) {
+	plan = withoutURLFetches(plan)
+	current := *job
+	current.PromptBody = string(stripAppendedOutput([]byte(job.PromptBody)))
+	workDir := ScopeToSubProject(estimateWorkDir(&current, plan), &current)
+
+	_, _, contextFiles, err := e.buildPrompt(&current, plan, workDir)
+	if err != nil {
+		return "", fmt.Errorf("determining context files: %w", err)
+	}
+	prompt, _, err := BuildXMLPrompt(&current, plan, workDir, contextFiles)
+	if err != nil {
+		return "", fmt.Errorf("building XML prompt: %w", err)
+	}
+	return prompt, nil
+}
+
+// AssemblePrompt returns the XML prompt job would be sent if it ran now,
+// without calling an LLM, e.g. to inspect or copy it.
+func AssemblePrompt(job *Job, plan *Plan) (string, error) {
+	return NewOneShotExecutor(NewMockLLMClient(), nil).assemblePrompt(job, plan)
+}
+
+// StalePromptJobs returns the IDs of completed oneshot jobs whose prompt has
+// changed since they last ran, i.e. whose recorded prompt_hash no longer
+// matches the prompt they would be sent now.
+func StalePromptJobs(plan *Plan) map[string]bool {
+	stale := make(map[string]bool)
+	e := NewOneShotExecutor(NewMockLLMClient(), nil)
+	for _, job := range plan.Jobs {
+		if job.Type != JobTypeOneshot || job.Status != JobStatusCompleted || job.PromptHash == "" {
+			continue
+		}
+		hash, err := e.currentPromptHash(job, plan)
+		if err == nil && hash != job.PromptHash {
+			stale[job.ID] = true
+		}
+	}
+	return stale
+}
@@ -0,0 +1,90 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStalePromptJobs(t *testing.T) {
+	dir := t.TempDir()
+	job := &Job{
+		ID:         "summarize",
+		Type:       JobTypeOneshot,
+		Status:     JobStatusCompleted,
+		PromptBody: "Summarize the design doc.",
+		FilePath:   filepath.Join(dir, "01-summarize.md"),
+	}
+	plan := &Plan{Name: "plan", Directory: dir, Jobs: []*Job{job}}
+
+	hash, err := NewOneShotExecutor(NewMockLLMClient(), nil).currentPromptHash(job, plan)
+	if err != nil {
+		t.Fatalf("currentPromptHash() error = %v", err)
+	}
+	job.PromptHash = hash
+
+	job.PromptBody += outputSectionSeparator + "The doc proposes..."
+	if stale := StalePromptJobs(plan); stale[job.ID] {
+		t.Error("appended output should not make the prompt stale")
+	}
+
+	job.PromptBody = "Summarize the design doc in three bullets."
+	if stale := StalePromptJobs(plan); !stale[job.ID] {
+		t.Error("expected an edited prompt to be reported as stale")
+	}
+}
+
+func TestPromptHash_IgnoresRunOnlyParts(t *testing.T) {
+	dir := t.TempDir()
+	job := &Job{
+		ID:         "review",
+		Type:       JobTypeOneshot,
+		Status:     JobStatusCompleted,
+		PromptBody: "Review the change.",
+		FilePath:   filepath.Join(dir, "01-review.md"),
+	}
+	plan := &Plan{Name: "plan", Directory: dir, Jobs: []*Job{job}, Orchestration: &Config{}}
+	e := NewOneShotExecutor(NewMockLLMClient(), nil)
+
+	want, err := e.promptHash(job, plan, dir)
+	if err != nil {
+		t.Fatalf("promptHash() error = %v", err)
+	}
+
+	// What a run with --prompt-prefix/--prompt-suffix and a summarized
+	// dependency would have recorded
+	runPlan := &Plan{Name: "plan", Directory: dir, Jobs: []*Job{job}, Orchestration: &Config{
+		PromptPrefix: "Respond in French.",
+		PromptSuffix: "Be brief.",
+	}}
+	job.DependencySummaries = map[string]string{filepath.Join(dir, "00-dep.md"): "summary"}
+	got, err := e.promptHash(job, runPlan, dir)
+	if err != nil {
+		t.Fatalf("promptHash() error = %v", err)
+	}
+	if got != want {
+		t.Error("run-only prompt parts should not change the recorded hash")
+	}
+	if runPlan.Orchestration.PromptPrefix == "" {
+		t.Error("promptHash should not modify the plan's configuration")
+	}
+}
+
+func TestRecordRunProvenance(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "01-job.md")
+	if err := os.WriteFile(path, []byte("---\nid: job\ntype: oneshot\nstatus: completed\n---\nDo it.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	job := &Job{ID: "job", FilePath: path}
+
+	if err := recordRunProvenance(job, "gemini-2.5-pro", hashPrompt("prompt")); err != nil {
+		t.Fatalf("recordRunProvenance() error = %v", err)
+	}
+	loaded, err := LoadJob(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.RanModel != "gemini-2.5-pro" || loaded.PromptHash != hashPrompt("prompt") {
+		t.Errorf("got ran_model=%q prompt_hash=%q", loaded.RanModel, loaded.PromptHash)
+	}
+}
@@ -0,0 +1,158 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Strategies for the prompt_overflow frontmatter field, applied when the
+// assembled prompt exceeds ExecutorConfig.MaxPromptLength. Jobs that don't set
+// one only log a warning and send the prompt as-is.
+const (
+	PromptOverflowFail            = "fail"             // Fail the job
+	PromptOverflowTruncateContext = "truncate-context" // Drop repository context files, last first
+	PromptOverflowDropOldestDeps  = "drop-oldest-deps" // Drop dependency outputs, oldest first
+)
+
+// PromptOverflowStrategies returns every value prompt_overflow accepts.
+func PromptOverflowStrategies() []string {
+	return []string{PromptOverflowFail, PromptOverflowTruncateContext, PromptOverflowDropOldestDeps}
+}
+
+// promptBudget describes the final size of an assembled prompt and what was
+// removed to bring it under the configured limit.
+type promptBudget struct {
+	Chars   int
+	Limit   int
+	Dropped []string
+}
+
+// EstimatedTokens approximates the token count (rough estimate: 1 token ≈ 4 chars).
+func (b promptBudget) EstimatedTokens() int {
+	return b.Chars / 4
+}
+
+// assembledPromptSize returns the size in characters of the prompt plus every
+// file that will be sent alongside it.
+func assembledPromptSize(prompt string, fileLists ...[]string) int {
+	size := len(prompt)
+	seen := make(map[string]bool)
+	for _, files := range fileLists {
+		for _, f := range files {
+			if seen[f] {
+				continue
+			}
+			seen[f] = true
+			if info, err := os.Stat(f); err == nil {
+				size += int(info.Size())
+			}
+		}
+	}
+	return size
+}
+
+// fitPromptToLimit enforces MaxPromptLength on an assembled oneshot prompt using
+// the job's prompt_overflow strategy. It returns the (possibly rebuilt) prompt
+// and file lists along with the final budget.
+func (e *OneShotExecutor) fitPromptToLimit(ctx context.Context, job *Job, plan *Plan, workDir, prompt string, promptSourceFiles, contextFiles []string) (string, []string, []string, promptBudget, error) {
+	budget := promptBudget{
+		Chars: assembledPromptSize(prompt, promptSourceFiles, contextFiles),
+		Limit: e.config.MaxPromptLength,
+	}
+	if budget.Limit <= 0 || budget.Chars <= budget.Limit {
+		return prompt, promptSourceFiles, contextFiles, budget, nil
+	}
+
+	strategy := job.PromptOverflow
+	if strategy == "" {
+		ulog.Warn("Prompt exceeds max prompt length").
+			Field("job_id", job.ID).
+			Field("prompt_chars", budget.Chars).
+			Field("limit", budget.Limit).
+			Pretty(fmt.Sprintf("Prompt is %d chars (~%d tokens), over the %d char limit; set prompt_overflow to trim or reject it", budget.Chars, budget.EstimatedTokens(), budget.Limit)).
+			Log(ctx)
+		return prompt, promptSourceFiles, contextFiles, budget, nil
+	}
+
+	trimmed := *job
+	deps := make([]*Job, 0, len(job.Dependencies))
+	for _, dep := range job.Dependencies {
+		if dep != nil {
+			deps = append(deps, dep)
+		}
+	}
+	sort.Slice(deps, func(i, j int) bool { return deps[i].Filename < deps[j].Filename })
+
+	for budget.Chars > budget.Limit {
+		var dropped string
+		switch strategy {
+		case PromptOverflowFail:
+			return "", nil, nil, budget, fmt.Errorf("prompt is %d chars (~%d tokens), exceeding max prompt length of %d chars; set prompt_overflow to %q or %q to trim it",
+				budget.Chars, budget.EstimatedTokens(), budget.Limit, PromptOverflowTruncateContext, PromptOverflowDropOldestDeps)
+		case PromptOverflowTruncateContext:
+			if len(contextFiles) == 0 {
+				return "", nil, nil, budget, fmt.Errorf("prompt is %d chars after dropping all context files, still exceeding max prompt length of %d chars", budget.Chars, budget.Limit)
+			}
+			dropped = contextFiles[len(contextFiles)-1]
+			contextFiles = contextFiles[:len(contextFiles)-1]
+		case PromptOverflowDropOldestDeps:
+			if len(deps) == 0 {
+				return "", nil, nil, budget, fmt.Errorf("prompt is %d chars after dropping all dependencies, still exceeding max prompt length of %d chars", budget.Chars, budget.Limit)
+			}
+			dropped = deps[0].Filename
+			deps = deps[1:]
+			trimmed.Dependencies = deps
+		default:
+			return "", nil, nil, budget, fmt.Errorf("unknown prompt_overflow strategy %q (expected %s, %s, or %s)",
+				strategy, PromptOverflowFail, PromptOverflowTruncateContext, PromptOverflowDropOldestDeps)
+		}
+
+		var err error
+		prompt, promptSourceFiles, err = BuildXMLPrompt(&trimmed, plan, workDir, contextFiles)
+		if err != nil {
+			return "", nil, nil, budget, fmt.Errorf("rebuilding prompt: %w", err)
+		}
+		before := budget.Chars
+		budget.Chars = assembledPromptSize(prompt, promptSourceFiles, contextFiles)
+		budget.Dropped = append(budget.Dropped, filepath.Base(dropped))
+
+		ulog.Warn("Dropped file to fit prompt length limit").
+			Field("job_id", job.ID).
+			Field("strategy", strategy).
+			Field("dropped", dropped).
+			Field("chars_before", before).
+			Field("chars_after", budget.Chars).
+			Field("limit", budget.Limit).
+			Log(ctx)
+	}
+
+	ulog.Info("Trimmed prompt to fit length limit").
+		Field("job_id", job.ID).
+		Field("strategy", strategy).
+		Field("dropped", strings.Join(budget.Dropped, ", ")).
+		Field("prompt_chars", budget.Chars).
+		Field("tokens_est", budget.EstimatedTokens()).
+		Pretty(fmt.Sprintf("Prompt exceeded %d chars; dropped %s (now ~%d tokens)", budget.Limit, strings.Join(budget.Dropped, ", "), budget.EstimatedTokens())).
+		Log(ctx)
+
+	return prompt, promptSourceFiles, contextFiles, budget, nil
+}
+
+// briefingSizeComment renders the final prompt size as an XML comment appended
+// to the briefing file for auditing.
+func briefingSizeComment(budget promptBudget) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!-- prompt_chars: %d, estimated_tokens: %d", budget.Chars, budget.EstimatedTokens())
+	if budget.Limit > 0 {
+		fmt.Fprintf(&b, ", max_prompt_length: %d", budget.Limit)
+	}
+	if len(budget.Dropped) > 0 {
+		fmt.Fprintf(&b, ", dropped: %s", strings.Join(budget.Dropped, ", "))
+	}
+	b.WriteString(" -->\n")
+	return b.String()
+}
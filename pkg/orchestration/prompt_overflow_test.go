@@ -0,0 +1,108 @@
+package orchestration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOneShotExecutor_FitPromptToLimit(t *testing.T) {
+	dir := t.TempDir()
+	writeDep := func(name string, size int) *Job {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(strings.Repeat("x", size)), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return &Job{ID: strings.TrimSuffix(name, ".md"), Filename: name, FilePath: path, Status: JobStatusCompleted}
+	}
+	oldDep := writeDep("01-old.md", 400)
+	newDep := writeDep("02-new.md", 100)
+	plan := &Plan{Directory: dir}
+
+	newJob := func(strategy string) *Job {
+		return &Job{
+			ID:             "target",
+			Type:           JobTypeOneshot,
+			PromptBody:     "Summarize the dependencies.",
+			PromptOverflow: strategy,
+			Dependencies:   []*Job{newDep, oldDep},
+		}
+	}
+
+	executor := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{MaxPromptLength: 800})
+
+	t.Run("under limit is untouched", func(t *testing.T) {
+		roomy := NewOneShotExecutor(NewMockLLMClient(), &ExecutorConfig{MaxPromptLength: 100000})
+		job := newJob("")
+		prompt, files, err := BuildXMLPrompt(job, plan, dir, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, gotFiles, _, budget, err := roomy.fitPromptToLimit(context.Background(), job, plan, dir, prompt, files, nil)
+		if err != nil {
+			t.Fatalf("fitPromptToLimit() error = %v", err)
+		}
+		if len(gotFiles) != 2 || len(budget.Dropped) != 0 {
+			t.Errorf("expected no files dropped, got files=%v dropped=%v", gotFiles, budget.Dropped)
+		}
+	})
+
+	t.Run("unset strategy sends large context as-is", func(t *testing.T) {
+		contextFile := filepath.Join(dir, "context")
+		if err := os.WriteFile(contextFile, []byte(strings.Repeat("y", 5000)), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		job := newJob("")
+		prompt, files, err := BuildXMLPrompt(job, plan, dir, []string{contextFile})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, gotFiles, gotContext, budget, err := executor.fitPromptToLimit(context.Background(), job, plan, dir, prompt, files, []string{contextFile})
+		if err != nil {
+			t.Fatalf("fitPromptToLimit() error = %v", err)
+		}
+		if len(gotFiles) != len(files) || len(gotContext) != 1 || len(budget.Dropped) != 0 {
+			t.Errorf("expected nothing dropped, got files=%v context=%v dropped=%v", gotFiles, gotContext, budget.Dropped)
+		}
+	})
+
+	t.Run("fail strategy errors", func(t *testing.T) {
+		job := newJob(PromptOverflowFail)
+		prompt, files, err := BuildXMLPrompt(job, plan, dir, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, _, _, err := executor.fitPromptToLimit(context.Background(), job, plan, dir, prompt, files, nil); err == nil {
+			t.Fatal("expected an error for an oversized prompt")
+		}
+	})
+
+	t.Run("drop-oldest-deps drops the oldest dependency first", func(t *testing.T) {
+		job := newJob(PromptOverflowDropOldestDeps)
+		prompt, files, err := BuildXMLPrompt(job, plan, dir, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotPrompt, gotFiles, _, budget, err := executor.fitPromptToLimit(context.Background(), job, plan, dir, prompt, files, nil)
+		if err != nil {
+			t.Fatalf("fitPromptToLimit() error = %v", err)
+		}
+		if len(budget.Dropped) != 1 || budget.Dropped[0] != "01-old.md" {
+			t.Errorf("dropped = %v, want [01-old.md]", budget.Dropped)
+		}
+		if len(gotFiles) != 1 || gotFiles[0] != newDep.FilePath {
+			t.Errorf("files = %v, want only %s", gotFiles, newDep.FilePath)
+		}
+		if strings.Contains(gotPrompt, "01-old.md") {
+			t.Error("rebuilt prompt still references the dropped dependency")
+		}
+		if budget.Chars > budget.Limit {
+			t.Errorf("final size %d still exceeds limit %d", budget.Chars, budget.Limit)
+		}
+		if len(job.Dependencies) != 2 {
+			t.Error("original job dependencies should not be modified")
+		}
+	})
+}
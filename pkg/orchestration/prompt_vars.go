@@ -0,0 +1,111 @@
+package orchestration
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Built-in prompt variables. They take precedence over environment variables
+// of the same name.
+const (
+	PromptVarPlanName = "FLOW_PLAN_NAME"
+	PromptVarWorktree = "FLOW_WORKTREE"
+	PromptVarBranch   = "FLOW_GIT_BRANCH"
+)
+
+// promptVarPattern matches ${VAR} and {{.Env.VAR}}; the name is in group 1 or 2.
+var promptVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}|\{\{\s*\.Env\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// promptVarLookup resolves a variable name for prompt interpolation.
+type promptVarLookup func(name string) (string, bool)
+
+// newPromptVarLookup returns a lookup over the built-ins for a job, then the
+// variables declared in the job's and plan's env, then FLOW_* variables from the
+// process environment. Other environment variables are never exposed, so a
+// prompt can't leak secrets such as API keys. workDir is used to find the
+// current git branch, which is queried at most once and only if a prompt
+// references it.
+func newPromptVarLookup(job *Job, plan *Plan, workDir string) promptVarLookup {
+	var branchOnce sync.Once
+	var branch string
+	var branchOK bool
+	return func(name string) (string, bool) {
+		switch name {
+		case PromptVarPlanName:
+			if plan != nil {
+				return plan.Name, true
+			}
+		case PromptVarWorktree:
+			if job.Worktree != "" {
+				return job.Worktree, true
+			}
+			if plan != nil && plan.Config != nil {
+				return plan.Config.Worktree, true
+			}
+			return "", true
+		case PromptVarBranch:
+			branchOnce.Do(func() {
+				if workDir == "" {
+					return
+				}
+				if out, err := exec.Command("git", "-C", workDir, "rev-parse", "--abbrev-ref", "HEAD").Output(); err == nil {
+					branch, branchOK = strings.TrimSpace(string(out)), true
+				}
+			})
+			if branchOK {
+				return branch, true
+			}
+		}
+		if value, ok := job.Env[name]; ok {
+			return value, true
+		}
+		if plan != nil && plan.Config != nil {
+			if value, ok := plan.Config.Env[name]; ok {
+				return value, true
+			}
+		}
+		if strings.HasPrefix(name, "FLOW_") {
+			return os.LookupEnv(name)
+		}
+		return "", false
+	}
+}
+
+// expandPromptVars substitutes ${VAR} and {{.Env.VAR}} references in text.
+// Unknown variables are left intact and reported with a single warning, since
+// prompts often contain shell or template snippets that are not meant for us.
+func expandPromptVars(ctx context.Context, text string, lookup promptVarLookup, job *Job) string {
+	if !strings.Contains(text, "${") && !strings.Contains(text, "{{") {
+		return text
+	}
+
+	// A single pass, so values containing variable syntax are never re-expanded
+	unknown := make(map[string]bool)
+	expanded := promptVarPattern.ReplaceAllStringFunc(text, func(match string) string {
+		groups := promptVarPattern.FindStringSubmatch(match)
+		name := groups[1] + groups[2]
+		if value, ok := lookup(name); ok {
+			return value
+		}
+		unknown[name] = true
+		return match
+	})
+
+	if len(unknown) > 0 {
+		names := make([]string, 0, len(unknown))
+		for name := range unknown {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		ulog.Warn("Leaving unknown prompt variables unexpanded").
+			Field("job_id", job.ID).
+			Field("variables", strings.Join(names, ", ")).
+			Log(ctx)
+	}
+	return expanded
+}
@@ -0,0 +1,58 @@
+package orchestration
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExpandPromptVars(t *testing.T) {
+	t.Setenv("FLOW_TEST_TICKET", "ABC-123")
+	t.Setenv(PromptVarPlanName, "from-env")
+	t.Setenv("TEST_SECRET_TOKEN", "hunter2")
+
+	job := &Job{ID: "job", Worktree: "feature-x", Env: map[string]string{"TICKET": "XYZ-9"}}
+	plan := &Plan{Name: "my-plan", Config: &PlanConfig{Env: map[string]string{"TICKET": "plan", "REGION": "eu"}}}
+	lookup := newPromptVarLookup(job, plan, "")
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"dollar syntax", "Fix ${FLOW_TEST_TICKET}", "Fix ABC-123"},
+		{"template syntax", "Fix {{ .Env.FLOW_TEST_TICKET }}", "Fix ABC-123"},
+		{"built-ins shadow env", "${FLOW_PLAN_NAME} on ${FLOW_WORKTREE}", "my-plan on feature-x"},
+		{"declared env", "${TICKET} in ${REGION}", "XYZ-9 in eu"},
+		{"undeclared env not exposed", "${TEST_SECRET_TOKEN}", "${TEST_SECRET_TOKEN}"},
+		{"unknown left intact", "${FLOW_TEST_UNSET} and {{.Env.FLOW_TEST_UNSET}}", "${FLOW_TEST_UNSET} and {{.Env.FLOW_TEST_UNSET}}"},
+		{"other template actions untouched", "{{.Title}} $FLOW_TEST_TICKET", "{{.Title}} $FLOW_TEST_TICKET"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expandPromptVars(context.Background(), tt.in, lookup, job); got != tt.want {
+				t.Errorf("expandPromptVars(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildXMLPrompt_ExpandsOnlyJobText(t *testing.T) {
+	t.Setenv("FLOW_TEST_TICKET", "ABC-123")
+	dir := t.TempDir()
+	job := &Job{
+		ID:         "job",
+		Type:       JobTypeOneshot,
+		PromptBody: "Ticket ${FLOW_TEST_TICKET}",
+	}
+
+	prompt, _, err := BuildXMLPrompt(job, &Plan{Directory: dir}, dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !contains(prompt, "Ticket ABC-123") {
+		t.Errorf("prompt body was not expanded:\n%s", prompt)
+	}
+	if job.PromptBody != "Ticket ${FLOW_TEST_TICKET}" {
+		t.Error("job.PromptBody should not be modified")
+	}
+}
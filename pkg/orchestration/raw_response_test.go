@@ -0,0 +1,30 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveRawResponse(t *testing.T) {
+	planDir := t.TempDir()
+	t.Chdir(planDir)
+	plan := &Plan{Name: "raw-plan", Directory: planDir}
+	job := &Job{ID: "job-1"}
+	response := "```go\npackage main\n```\n"
+
+	rawPath, err := saveRawResponse(plan, job, response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(rawPath) != "job-1.raw.md" {
+		t.Errorf("raw path = %s, want job-1.raw.md", rawPath)
+	}
+	data, err := os.ReadFile(rawPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != response {
+		t.Errorf("raw file = %q, want verbatim response %q", data, response)
+	}
+}
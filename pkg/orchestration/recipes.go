@@ -67,6 +67,7 @@ func loadRecipeMetadata(recipe *Recipe, recipeDir string, fs embed.FS) error {
 	var initConfig struct {
 		Description       string                  `yaml:"description"`
 		DefaultNoteTarget string                  `yaml:"default_note_target"`
+		Vars              []RecipeVar             `yaml:"vars"`    // Declared template variables
 		Init              []InitAction            `yaml:"init"`    // Actions that run with --init flag
 		Actions           map[string][]InitAction `yaml:"actions"` // Named, on-demand action groups
 	}
@@ -77,6 +78,7 @@ func loadRecipeMetadata(recipe *Recipe, recipeDir string, fs embed.FS) error {
 
 	recipe.Description = initConfig.Description
 	recipe.DefaultNoteTarget = initConfig.DefaultNoteTarget
+	recipe.Vars = initConfig.Vars
 	recipe.InitActions = initConfig.Init
 	recipe.NamedActions = initConfig.Actions
 
@@ -96,9 +98,19 @@ func loadRecipeMetadata(recipe *Recipe, recipeDir string, fs embed.FS) error {
 	return nil
 }
 
+// RecipeVar declares a variable a recipe's job templates expect, as listed under
+// `vars` in the recipe's workspace_init.yml.
+type RecipeVar struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	Required    bool   `yaml:"required,omitempty" json:"required,omitempty"`
+	Default     string `yaml:"default,omitempty" json:"default,omitempty"`
+}
+
 type Recipe struct {
 	Name              string                      `json:"name"`
 	Description       string                      `json:"description"`
+	Vars              []RecipeVar                 `json:"vars,omitempty"`
 	Source            string                      `json:"source,omitempty"`  // [Built-in], [User], [Dynamic], or [Project]
 	Domain            string                      `json:"domain,omitempty"`  // "generic" or "grove"
 	DefaultNoteTarget string                      `json:"-"`                 // This will be populated from recipe.yml
@@ -188,6 +200,44 @@ func GetBuiltinRecipe(name string) (*Recipe, error) {
 	return nil, fmt.Errorf("recipe '%s' not found", name)
 }
 
+// ValidateVars checks vars against the recipe's declared variables and fills in
+// declared defaults. It returns an error naming every missing required variable,
+// and the names of supplied variables the recipe does not declare. Recipes that
+// declare no variables accept anything.
+func (r *Recipe) ValidateVars(vars map[string]string) ([]string, error) {
+	if len(r.Vars) == 0 {
+		return nil, nil
+	}
+
+	declared := make(map[string]bool, len(r.Vars))
+	var missing []string
+	for _, v := range r.Vars {
+		declared[v.Name] = true
+		if _, ok := vars[v.Name]; ok {
+			continue
+		}
+		if v.Default != "" {
+			vars[v.Name] = v.Default
+		} else if v.Required {
+			missing = append(missing, v.Name)
+		}
+	}
+
+	var unknown []string
+	for name := range vars {
+		if !declared[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+
+	if len(missing) > 0 {
+		return unknown, fmt.Errorf("recipe '%s' requires variable(s) %s; set them with --recipe-vars key=value",
+			r.Name, strings.Join(missing, ", "))
+	}
+	return unknown, nil
+}
+
 // RenderJob renders a single job template from a recipe.
 func (r *Recipe) RenderJob(filename string, data interface{}) ([]byte, error) {
 	content, ok := r.Jobs[filename]
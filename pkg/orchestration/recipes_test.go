@@ -0,0 +1,40 @@
+package orchestration
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRecipeValidateVars(t *testing.T) {
+	recipe := &Recipe{
+		Name: "feature",
+		Vars: []RecipeVar{
+			{Name: "ticket", Required: true},
+			{Name: "model", Default: "gemini-2.5-pro"},
+			{Name: "notes"},
+		},
+	}
+
+	vars := map[string]string{"ticket": "ABC-1", "tickt": "typo"}
+	unknown, err := recipe.ValidateVars(vars)
+	if err != nil {
+		t.Fatalf("ValidateVars() error = %v", err)
+	}
+	if strings.Join(unknown, ",") != "tickt" {
+		t.Errorf("unknown = %v, want [tickt]", unknown)
+	}
+	if vars["model"] != "gemini-2.5-pro" {
+		t.Errorf("default not applied: model = %q", vars["model"])
+	}
+	if _, ok := vars["notes"]; ok {
+		t.Error("optional variable without a default should stay unset")
+	}
+
+	if _, err := recipe.ValidateVars(map[string]string{}); err == nil || !strings.Contains(err.Error(), "ticket") {
+		t.Errorf("expected missing required variable error naming ticket, got %v", err)
+	}
+
+	if unknown, err := (&Recipe{Name: "legacy"}).ValidateVars(map[string]string{"anything": "x"}); err != nil || unknown != nil {
+		t.Errorf("recipes without declared vars should accept anything, got %v, %v", unknown, err)
+	}
+}
@@ -0,0 +1,72 @@
+package orchestration
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// redactedPlaceholder replaces each secret found by a redaction pass.
+const redactedPlaceholder = "***REDACTED***"
+
+// builtinSecretPatterns match common API token and key formats.
+var builtinSecretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`AKIA[0-9A-Z]{16}`),                      // AWS access key ID
+	regexp.MustCompile(`AIza[0-9A-Za-z_\-]{35}`),                // Google API key
+	regexp.MustCompile(`sk-(?:ant-|proj-)?[A-Za-z0-9_\-]{20,}`), // Anthropic and OpenAI API keys
+	regexp.MustCompile(`gh[pousr]_[A-Za-z0-9]{36,}`),            // GitHub tokens
+	regexp.MustCompile(`github_pat_[A-Za-z0-9_]{22,}`),          // GitHub fine-grained tokens
+	regexp.MustCompile(`xox[abposr]-[A-Za-z0-9\-]{10,}`),        // Slack tokens
+
+	// PEM private keys
+	regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`),
+}
+
+// RedactConfig controls redaction of secrets from briefing files and the
+// audit log. Redaction is off unless Enabled is set.
+type RedactConfig struct {
+	Enabled         bool     `yaml:"enabled,omitempty"`
+	Patterns        []string `yaml:"patterns,omitempty"`         // Extra regular expressions to redact
+	DisableBuiltins bool     `yaml:"disable_builtins,omitempty"` // Only redact Patterns, not the built-in token formats
+}
+
+// Validate checks that every pattern compiles.
+func (c *RedactConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, pattern := range c.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid redact pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Apply replaces every match of the built-in and configured patterns in s with
+// ***REDACTED***. It returns s unchanged when redaction is disabled.
+func (c *RedactConfig) Apply(s string) string {
+	if c == nil || !c.Enabled {
+		return s
+	}
+	if !c.DisableBuiltins {
+		for _, re := range builtinSecretPatterns {
+			s = re.ReplaceAllString(s, redactedPlaceholder)
+		}
+	}
+	for _, pattern := range c.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			continue // Rejected by Validate when the config is loaded
+		}
+		s = re.ReplaceAllString(s, redactedPlaceholder)
+	}
+	return s
+}
+
+// redactSecrets applies the plan's redaction settings to s.
+func redactSecrets(plan *Plan, s string) string {
+	if plan == nil || plan.Orchestration == nil {
+		return s
+	}
+	return plan.Orchestration.Redact.Apply(s)
+}
@@ -0,0 +1,162 @@
+package orchestration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// runCheckpointPath returns the location of a plan's run checkpoint.
+func runCheckpointPath(planDir string) string {
+	return filepath.Join(planDir, ".grove", "plan-run-state.yml")
+}
+
+// RunCheckpoint records progress of a `plan run` so an interrupted run can resume
+// where it left off instead of re-evaluating the whole plan.
+type RunCheckpoint struct {
+	StartedAt time.Time            `yaml:"started_at"`
+	UpdatedAt time.Time            `yaml:"updated_at"`
+	Completed map[string]time.Time `yaml:"completed"` // job ID -> completion time
+}
+
+// LoadRunCheckpoint reads a plan's checkpoint. It returns nil without an error
+// when the plan has no checkpoint.
+func LoadRunCheckpoint(planDir string) (*RunCheckpoint, error) {
+	data, err := os.ReadFile(runCheckpointPath(planDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading run checkpoint: %w", err)
+	}
+
+	var checkpoint RunCheckpoint
+	if err := yaml.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("parsing run checkpoint: %w", err)
+	}
+	if checkpoint.Completed == nil {
+		checkpoint.Completed = make(map[string]time.Time)
+	}
+	return &checkpoint, nil
+}
+
+// RemoveRunCheckpoint deletes a plan's checkpoint, if any.
+func RemoveRunCheckpoint(planDir string) error {
+	err := os.Remove(runCheckpointPath(planDir))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// newRunCheckpoint returns an empty checkpoint for a run starting now.
+func newRunCheckpoint() *RunCheckpoint {
+	now := time.Now()
+	return &RunCheckpoint{StartedAt: now, UpdatedAt: now, Completed: make(map[string]time.Time)}
+}
+
+// save writes the checkpoint atomically so a kill mid-write never leaves it truncated.
+func (c *RunCheckpoint) save(planDir string) error {
+	path := runCheckpointPath(planDir)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating checkpoint directory: %w", err)
+	}
+
+	c.UpdatedAt = time.Now()
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("marshaling run checkpoint: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing run checkpoint: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// recordCompleted marks a job as completed in the run checkpoint.
+func (o *Orchestrator) recordCompleted(job *Job) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.checkpoint == nil || job.ID == "" {
+		return
+	}
+	o.checkpoint.Completed[job.ID] = time.Now()
+	if err := o.checkpoint.save(o.Plan.Directory); err != nil {
+		o.logger.Error("Failed to save run checkpoint", "job", job.ID, "error", err)
+	}
+}
+
+// resumeFromCheckpoint prepares a RunAll. With Restart set, any existing checkpoint
+// is discarded. Jobs left `running` by a killed process (their lock file names a
+// dead PID) are always reset: to `completed` if a resumed checkpoint recorded them
+// finishing before the kill, otherwise to `pending`. Jobs in any other status keep
+// their frontmatter status, so a job reset with `plan rerun` runs again.
+func (o *Orchestrator) resumeFromCheckpoint() error {
+	var checkpoint *RunCheckpoint
+	if o.config.Restart {
+		if err := RemoveRunCheckpoint(o.Plan.Directory); err != nil {
+			return fmt.Errorf("removing run checkpoint: %w", err)
+		}
+	} else {
+		var err error
+		if checkpoint, err = LoadRunCheckpoint(o.Plan.Directory); err != nil {
+			return err
+		}
+	}
+	resumed := checkpoint != nil
+	if !resumed {
+		checkpoint = newRunCheckpoint()
+	}
+	o.checkpoint = checkpoint
+
+	if err := o.resetStaleRunningJobs(); err != nil {
+		return err
+	}
+	if !resumed {
+		return nil
+	}
+
+	var next string
+	for _, job := range o.Plan.GetJobsSortedByFilename() {
+		if job.Status != JobStatusCompleted && job.Status != JobStatusAbandoned {
+			next = job.Filename
+			break
+		}
+	}
+	o.logger.Info("Resuming plan run from checkpoint",
+		"completed", len(checkpoint.Completed),
+		"next", next)
+	return nil
+}
+
+// resetStaleRunningJobs resets jobs whose lock names a dead PID, using the run
+// checkpoint to tell jobs that finished before the kill from those that didn't.
+// Interactive agent jobs are skipped, as in RecoverStaleLock.
+func (o *Orchestrator) resetStaleRunningJobs() error {
+	for _, job := range o.Plan.Jobs {
+		if job.Status != JobStatusRunning || job.Type == JobTypeInteractiveAgent || job.Type == JobTypeAgent {
+			continue
+		}
+		pid, stale := staleLockPID(job.FilePath)
+		if !stale {
+			continue
+		}
+		RemoveLockFile(job.FilePath)
+
+		status := JobStatusPending
+		if _, done := o.checkpoint.Completed[job.ID]; done {
+			status = JobStatusCompleted
+		}
+		o.logger.Info("Resetting job left running by a dead process", "job", job.ID, "pid", pid, "status", status)
+		if err := o.UpdateJobStatus(job, status); err != nil {
+			return fmt.Errorf("resetting stale job %s: %w", job.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package orchestration
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// RunIfCondition gates a job on the output of one of its dependencies. Exactly
+// one of Contains or Matches should be set.
+type RunIfCondition struct {
+	Job      string `yaml:"job" json:"job"`                               // Dependency ID or filename
+	Contains string `yaml:"contains,omitempty" json:"contains,omitempty"` // Substring the output must contain
+	Matches  string `yaml:"matches,omitempty" json:"matches,omitempty"`   // Regular expression the output must match
+}
+
+// Validate checks the condition's shape without evaluating it.
+func (c *RunIfCondition) Validate() error {
+	if c.Job == "" {
+		return fmt.Errorf("run_if.job is required")
+	}
+	switch {
+	case c.Contains != "" && c.Matches != "":
+		return fmt.Errorf("run_if: set only one of contains or matches")
+	case c.Contains == "" && c.Matches == "":
+		return fmt.Errorf("run_if: one of contains or matches is required")
+	}
+	if c.Matches != "" {
+		if _, err := regexp.Compile(c.Matches); err != nil {
+			return fmt.Errorf("run_if.matches: %w", err)
+		}
+	}
+	return nil
+}
+
+// dependency returns the job's dependency named by the condition.
+func (c *RunIfCondition) dependency(job *Job) (*Job, error) {
+	for _, dep := range job.Dependencies {
+		if dep != nil && (dep.ID == c.Job || dep.Filename == c.Job) {
+			return dep, nil
+		}
+	}
+	return nil, fmt.Errorf("run_if.job %q is not in depends_on", c.Job)
+}
+
+// String describes the condition for logs and skip reasons.
+func (c *RunIfCondition) String() string {
+	if c.Matches != "" {
+		return fmt.Sprintf("output of %s matches %q", c.Job, c.Matches)
+	}
+	return fmt.Sprintf("output of %s contains %q", c.Job, c.Contains)
+}
+
+// evaluateRunIf reports whether job should run. Jobs without run_if always run.
+// The condition is tested against the output appended to the dependency's job
+// file (or the file it appends to), not its prompt.
+func evaluateRunIf(job *Job, plan *Plan) (bool, error) {
+	cond := job.RunIf
+	if cond == nil {
+		return true, nil
+	}
+	if err := cond.Validate(); err != nil {
+		return false, err
+	}
+	dep, err := cond.dependency(job)
+	if err != nil {
+		return false, err
+	}
+
+	content, err := os.ReadFile(jobOutputPath(dep, plan))
+	if err != nil {
+		return false, fmt.Errorf("reading output of %s: %w", dep.ID, err)
+	}
+	output := appendedOutput(string(content))
+
+	if cond.Matches != "" {
+		re, err := regexp.Compile(cond.Matches)
+		if err != nil {
+			return false, fmt.Errorf("run_if.matches: %w", err)
+		}
+		return re.MatchString(output), nil
+	}
+	return strings.Contains(output, cond.Contains), nil
+}
+
+// appendedOutput returns the output appended after a job's prompt, without
+// the leading section marker, or "" if none has been written. It is the
+// complement of stripAppendedOutput.
+func appendedOutput(content string) string {
+	start, skip := -1, 0
+	for _, marker := range []string{outputSectionSeparator, appendedOutputMarker} {
+		if idx := strings.Index(content, marker); idx != -1 && (start == -1 || idx < start) {
+			start, skip = idx, len(marker)
+		}
+	}
+	if start == -1 {
+		return ""
+	}
+	return content[start+skip:]
+}
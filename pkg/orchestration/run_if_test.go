@@ -0,0 +1,121 @@
+package orchestration
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAppendedOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"no output", "---\nid: a\n---\nPrompt mentioning CHANGES NEEDED\n", ""},
+		{"output section", "Prompt\n\n---\n\n## Output\n\nLGTM\n", "LGTM\n"},
+		{"appended from another job", "Prompt\n\n---\n\n## Output from review (2024-01-02 10:00)\n\nCHANGES NEEDED\n", "review (2024-01-02 10:00)\n\nCHANGES NEEDED\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := appendedOutput(tt.content); got != tt.want {
+				t.Errorf("appendedOutput() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunIfCondition_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cond    RunIfCondition
+		wantErr bool
+	}{
+		{"contains", RunIfCondition{Job: "review", Contains: "CHANGES NEEDED"}, false},
+		{"matches", RunIfCondition{Job: "review", Matches: `(?i)changes\s+needed`}, false},
+		{"missing job", RunIfCondition{Contains: "x"}, true},
+		{"no matcher", RunIfCondition{Job: "review"}, true},
+		{"both matchers", RunIfCondition{Job: "review", Contains: "x", Matches: "x"}, true},
+		{"bad regex", RunIfCondition{Job: "review", Matches: "("}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cond.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrchestrator_RunIfSkipsJobAndDependents(t *testing.T) {
+	plan := loadTestPlan(t, map[string]string{
+		"01-review.md": "---\nid: review\ntitle: Review\nstatus: completed\ntype: oneshot\n---\nReview for CHANGES NEEDED.\n\n---\n\n## Output\n\nLGTM\n",
+		"02-fix.md":    "---\nid: fix\ntitle: Fix\nstatus: pending\ntype: oneshot\ndepends_on:\n  - review\nrun_if:\n  job: review\n  contains: CHANGES NEEDED\n---\nFix it.\n",
+		"03-verify.md": "---\nid: verify\ntitle: Verify\nstatus: pending\ntype: oneshot\ndepends_on:\n  - fix\n---\nVerify.\n",
+	})
+
+	orch, err := NewOrchestrator(plan, nil)
+	if err != nil {
+		t.Fatalf("Failed to create orchestrator: %v", err)
+	}
+	mockExec := &mockExecutor{}
+	orch.executors[JobTypeOneshot] = mockExec
+
+	if err := orch.RunAll(context.Background()); err != nil {
+		t.Fatalf("RunAll() error = %v", err)
+	}
+	if mockExec.executeCalls != 0 {
+		t.Errorf("executor called %d times, want 0", mockExec.executeCalls)
+	}
+
+	want := map[string]string{
+		"fix":    `run_if not met: output of review contains "CHANGES NEEDED"`,
+		"verify": "dependency fix skipped",
+	}
+	for id, reason := range want {
+		job, _ := plan.GetJobByID(id)
+		onDisk, err := LoadJob(job.FilePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if onDisk.Status != JobStatusSkipped || onDisk.SkipReason != reason {
+			t.Errorf("%s on disk = %s (%q), want skipped (%q)", id, onDisk.Status, onDisk.SkipReason, reason)
+		}
+	}
+}
+
+func TestOrchestrator_RunNextAndReadySkipDependents(t *testing.T) {
+	runs := map[string]func(*Orchestrator, context.Context) error{
+		"RunNext":  (*Orchestrator).RunNext,
+		"RunReady": (*Orchestrator).RunReady,
+	}
+	for name, run := range runs {
+		t.Run(name, func(t *testing.T) {
+			plan := loadTestPlan(t, map[string]string{
+				"01-review.md": "---\nid: review\ntitle: Review\nstatus: completed\ntype: oneshot\n---\nReview.\n\n---\n\n## Output\n\nLGTM\n",
+				"02-fix.md":    "---\nid: fix\ntitle: Fix\nstatus: pending\ntype: oneshot\ndepends_on:\n  - review\nrun_if:\n  job: review\n  contains: CHANGES NEEDED\n---\nFix it.\n",
+				"03-verify.md": "---\nid: verify\ntitle: Verify\nstatus: pending\ntype: oneshot\ndepends_on:\n  - fix\n---\nVerify.\n",
+			})
+			orch, err := NewOrchestrator(plan, nil)
+			if err != nil {
+				t.Fatalf("Failed to create orchestrator: %v", err)
+			}
+			mockExec := &mockExecutor{}
+			orch.executors[JobTypeOneshot] = mockExec
+
+			if err := run(orch, context.Background()); err != nil {
+				t.Fatalf("%s() error = %v", name, err)
+			}
+			if mockExec.executeCalls != 0 {
+				t.Errorf("executor called %d times, want 0", mockExec.executeCalls)
+			}
+			verify, _ := plan.GetJobByID("verify")
+			onDisk, err := LoadJob(verify.FilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if onDisk.Status != JobStatusSkipped || onDisk.SkipReason != "dependency fix skipped" {
+				t.Errorf("verify on disk = %s (%q), want skipped", onDisk.Status, onDisk.SkipReason)
+			}
+		})
+	}
+}
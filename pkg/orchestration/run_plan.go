@@ -0,0 +1,177 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RunOptions configures RunPlan. The zero value runs every pending job, up
+// to three at a time, using each job's own model.
+//
+// Settings read from grove.yml by the CLI are not part of RunOptions: callers
+// set them on plan.Orchestration before calling RunPlan. That includes the
+// run-wide prompt prefix and suffix, the dependency summary model, the
+// OpenAI-compatible endpoint and secret redaction.
+type RunOptions struct {
+	// Parallelism is the maximum number of jobs run at once. Zero uses the
+	// plan's max_parallel, or 3. Chat and interactive agent jobs always run
+	// one at a time.
+	Parallelism int
+
+	// SkipInteractive skips jobs that need a terminal (interactive agents and
+	// chats), for unattended use.
+	SkipInteractive bool
+
+	// ModelOverride, if set, replaces the model of every LLM job.
+	ModelOverride string
+
+	// DryRun assembles each job's prompt and writes its briefing file without
+	// calling the LLM or changing job status.
+	DryRun bool
+
+	// ContinueOnFailure keeps running jobs that don't depend on a failed job
+	// instead of stopping after the first failure.
+	ContinueOnFailure bool
+
+	// OnlyReady runs just the jobs that are runnable when the call starts and
+	// returns without running the jobs they unblock.
+	OnlyReady bool
+
+	// Progress, if set, is called with the plan's status every
+	// ProgressInterval (2s if zero) while jobs run.
+	Progress         func(*PlanStatus)
+	ProgressInterval time.Duration
+
+	// Config, if set, is the base orchestrator configuration, for settings
+	// not covered above. The fields above take precedence over it.
+	Config *OrchestratorConfig
+}
+
+// JobOutcome is the final state of one job after RunPlan.
+type JobOutcome struct {
+	ID       string
+	Filename string
+	Title    string
+	Status   JobStatus
+	Error    string        // Failure message, for failed jobs
+	Duration time.Duration // Run time, for jobs that ran
+}
+
+// RunResult reports the outcome of every job in a plan after RunPlan.
+type RunResult struct {
+	Jobs []JobOutcome
+}
+
+// Failed returns the outcomes of the jobs that ended in failed.
+func (r *RunResult) Failed() []JobOutcome {
+	var failed []JobOutcome
+	for _, job := range r.Jobs {
+		if job.Status == JobStatusFailed {
+			failed = append(failed, job)
+		}
+	}
+	return failed
+}
+
+// RunPlan runs a loaded plan's jobs in dependency order, as `flow plan run
+// --all` does, and reports each job's outcome. It is the entry point for
+// programs embedding plan execution. The result is returned even when the run
+// fails, alongside the error, so callers can see which jobs completed. Call
+// RemoveFetchedSources once done to delete any URL prompt sources it downloaded.
+func RunPlan(ctx context.Context, plan *Plan, opts RunOptions) (*RunResult, error) {
+	orch, err := NewOrchestrator(plan, opts.orchestratorConfig(plan))
+	if err != nil {
+		return nil, fmt.Errorf("create orchestrator: %w", err)
+	}
+
+	if opts.Progress != nil {
+		stop := reportProgress(orch, opts.Progress, opts.ProgressInterval)
+		defer stop()
+	}
+
+	if opts.OnlyReady {
+		err = orch.RunReady(ctx)
+	} else {
+		err = orch.RunAll(ctx)
+	}
+	return newRunResult(plan), err
+}
+
+// reportProgress calls progress with orch's status every interval until the
+// returned function is called, which waits for any call in flight.
+func reportProgress(orch *Orchestrator, progress func(*PlanStatus), interval time.Duration) func() {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				progress(orch.GetStatus())
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+// orchestratorConfig merges the options over opts.Config, filling in the
+// defaults the CLI uses.
+func (opts RunOptions) orchestratorConfig(plan *Plan) *OrchestratorConfig {
+	config := &OrchestratorConfig{}
+	if opts.Config != nil {
+		copied := *opts.Config
+		config = &copied
+	}
+
+	if opts.Parallelism > 0 {
+		config.MaxParallelJobs = opts.Parallelism
+	}
+	if config.MaxParallelJobs <= 0 {
+		config.MaxParallelJobs = 3
+		if plan.Config != nil && plan.Config.MaxParallel > 0 {
+			config.MaxParallelJobs = plan.Config.MaxParallel
+		}
+	}
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = 5 * time.Second
+	}
+	if opts.ModelOverride != "" {
+		config.ModelOverride = opts.ModelOverride
+	}
+	config.SkipInteractive = config.SkipInteractive || opts.SkipInteractive
+	config.DryRun = config.DryRun || opts.DryRun
+	config.ContinueOnFailure = config.ContinueOnFailure || opts.ContinueOnFailure
+	return config
+}
+
+// newRunResult snapshots the status of each job in plan.
+func newRunResult(plan *Plan) *RunResult {
+	result := &RunResult{Jobs: make([]JobOutcome, 0, len(plan.Jobs))}
+	for _, job := range plan.Jobs {
+		outcome := JobOutcome{
+			ID:       job.ID,
+			Filename: job.Filename,
+			Title:    job.Title,
+			Status:   job.Status,
+			Duration: job.Duration,
+		}
+		if job.Status == JobStatusFailed {
+			outcome.Error = job.LastError
+		}
+		result.Jobs = append(result.Jobs, outcome)
+	}
+	return result
+}
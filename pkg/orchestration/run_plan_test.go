@@ -0,0 +1,85 @@
+package orchestration
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRunOptionsOrchestratorConfig(t *testing.T) {
+	plan := &Plan{Config: &PlanConfig{MaxParallel: 5}}
+
+	config := RunOptions{}.orchestratorConfig(plan)
+	if config.MaxParallelJobs != 5 || config.CheckInterval != 5*time.Second {
+		t.Errorf("defaults = %d jobs every %s, want the plan's 5 every 5s", config.MaxParallelJobs, config.CheckInterval)
+	}
+
+	base := &OrchestratorConfig{MaxParallelJobs: 2, NoContext: true, ModelOverride: "base-model"}
+	config = RunOptions{
+		Parallelism:     4,
+		SkipInteractive: true,
+		ModelOverride:   "gemini-2.5-flash",
+		DryRun:          true,
+		Config:          base,
+	}.orchestratorConfig(plan)
+	if config.MaxParallelJobs != 4 || config.ModelOverride != "gemini-2.5-flash" || !config.SkipInteractive || !config.DryRun {
+		t.Errorf("options did not override the base config: %+v", config)
+	}
+	if !config.NoContext {
+		t.Error("settings only in the base config should be kept")
+	}
+	if base.MaxParallelJobs != 2 || base.DryRun {
+		t.Error("the caller's base config should not be modified")
+	}
+}
+
+func TestNewRunResult(t *testing.T) {
+	plan := &Plan{Jobs: []*Job{
+		{ID: "spec", Filename: "01-spec.md", Status: JobStatusCompleted, Duration: time.Minute},
+		{ID: "impl", Filename: "02-impl.md", Status: JobStatusFailed, LastError: "timed out after 5m0s"},
+		{ID: "review", Filename: "03-review.md", Status: JobStatusBlocked, LastError: "stale"},
+	}}
+
+	result := newRunResult(plan)
+	if len(result.Jobs) != 3 || result.Jobs[0].Duration != time.Minute {
+		t.Fatalf("Jobs = %+v", result.Jobs)
+	}
+	failed := result.Failed()
+	if len(failed) != 1 || failed[0].ID != "impl" || failed[0].Error != "timed out after 5m0s" {
+		t.Errorf("Failed() = %+v", failed)
+	}
+	if result.Jobs[2].Error != "" {
+		t.Errorf("only failed jobs should carry an error, got %q", result.Jobs[2].Error)
+	}
+}
+
+func TestReportProgress(t *testing.T) {
+	plan := &Plan{Jobs: []*Job{
+		{ID: "a", Status: JobStatusCompleted},
+		{ID: "b", Status: JobStatusPending},
+	}}
+	orch, err := NewOrchestrator(plan, &OrchestratorConfig{MaxParallelJobs: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var calls int
+	var last *PlanStatus
+	stop := reportProgress(orch, func(status *PlanStatus) {
+		calls++
+		last = status
+	}, 5*time.Millisecond)
+	time.Sleep(30 * time.Millisecond)
+	stop()
+	after := calls
+	time.Sleep(20 * time.Millisecond)
+
+	if after == 0 {
+		t.Fatal("progress was never reported")
+	}
+	if calls != after {
+		t.Error("progress was reported after stop returned")
+	}
+	if last.Total != 2 || last.Completed != 1 || last.Pending != 1 {
+		t.Errorf("status = %+v, want 2 total, 1 completed, 1 pending", last)
+	}
+}
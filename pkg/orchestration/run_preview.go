@@ -0,0 +1,58 @@
+package orchestration
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// RunPreviewJob describes how a job is about to run, for the confirmation
+// shown before `flow plan run`.
+type RunPreviewJob struct {
+	Filename string
+	Title    string
+	Type     JobType
+	Model    string // Effective model, or "" for jobs that don't call an LLM
+	Worktree string // Worktree the job runs in, or "" for the project root
+	Context  string // How context will be generated, or "" for none
+}
+
+// BuildRunPreview resolves the model, worktree and context of each job using
+// the same precedence as the executors. modelOverride and noContext are the
+// run's --model and --no-context values.
+func BuildRunPreview(plan *Plan, jobs []*Job, modelOverride string, noContext bool) []RunPreviewJob {
+	preview := make([]RunPreviewJob, 0, len(jobs))
+	for _, job := range jobs {
+		entry := RunPreviewJob{
+			Filename: job.Filename,
+			Title:    job.Title,
+			Type:     job.Type,
+			Model:    reportModel(job, plan, modelOverride),
+			Worktree: job.Worktree,
+			Context:  previewContext(job, noContext),
+		}
+		if job.Repository != "" {
+			entry.Worktree = filepath.Join(entry.Worktree, job.Repository)
+		}
+		preview = append(preview, entry)
+	}
+	return preview
+}
+
+// previewContext describes the context regenerateContextInWorktree builds for
+// job, or "" if the job's executor doesn't generate one.
+func previewContext(job *Job, noContext bool) string {
+	switch job.Type {
+	case JobTypeOneshot, JobTypeChat, JobTypeShell:
+	default:
+		return ""
+	}
+	switch {
+	case noContext:
+		return ""
+	case job.ContextSince != "":
+		return fmt.Sprintf("changed since %s", job.ContextSince)
+	case job.RulesFile != "":
+		return fmt.Sprintf("rules: %s", job.RulesFile)
+	}
+	return ".grove/rules"
+}
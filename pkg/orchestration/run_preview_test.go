@@ -0,0 +1,37 @@
+package orchestration
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildRunPreview(t *testing.T) {
+	plan := &Plan{
+		Name:   "my-plan",
+		Config: &PlanConfig{Model: "plan-model"},
+	}
+	jobs := []*Job{
+		{Filename: "01-spec.md", Title: "Spec", Type: JobTypeOneshot},
+		{Filename: "02-review.md", Title: "Review", Type: JobTypeOneshot, Model: "job-model", Worktree: "feature", ContextSince: "main"},
+		{Filename: "03-build.md", Title: "Build", Type: JobTypeShell, Worktree: "feature", Repository: "api", RulesFile: "build.rules"},
+		{Filename: "04-impl.md", Title: "Implement", Type: JobTypeHeadlessAgent, Worktree: "feature"},
+	}
+
+	got := BuildRunPreview(plan, jobs, "", false)
+	want := []RunPreviewJob{
+		{Filename: "01-spec.md", Title: "Spec", Type: JobTypeOneshot, Model: "plan-model", Context: ".grove/rules"},
+		{Filename: "02-review.md", Title: "Review", Type: JobTypeOneshot, Model: "job-model", Worktree: "feature", Context: "changed since main"},
+		{Filename: "03-build.md", Title: "Build", Type: JobTypeShell, Worktree: "feature/api", Context: "rules: build.rules"},
+		{Filename: "04-impl.md", Title: "Implement", Type: JobTypeHeadlessAgent, Model: "plan-model", Worktree: "feature"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildRunPreview() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	got = BuildRunPreview(plan, jobs[:2], "cli-model", true)
+	for _, job := range got {
+		if job.Model != "cli-model" || job.Context != "" {
+			t.Errorf("with --model and --no-context, got %+v", job)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package orchestration
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RunReportJob is one job's entry in a run report.
+type RunReportJob struct {
+	ID         string        `json:"id"`
+	Filename   string        `json:"filename"`
+	Title      string        `json:"title"`
+	Type       JobType       `json:"type"`
+	Status     JobStatus     `json:"status"`
+	Duration   time.Duration `json:"duration_ns,omitempty"`
+	Model      string        `json:"model,omitempty"`
+	OutputPath string        `json:"output_path"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// RunReport summarizes the final state of a plan's jobs after `flow plan run`.
+type RunReport struct {
+	Plan          string            `json:"plan"`
+	GeneratedAt   time.Time         `json:"generated_at"`
+	Jobs          []RunReportJob    `json:"jobs"`
+	StatusCounts  map[JobStatus]int `json:"status_counts"`
+	TotalDuration time.Duration     `json:"total_duration_ns"`
+}
+
+// Failed returns the number of jobs that ended in the failed status.
+func (r *RunReport) Failed() int {
+	return r.StatusCounts[JobStatusFailed]
+}
+
+// BuildRunReport collects the status, duration, model, output path and error of
+// every job in plan. modelOverride is the --model value the run used, if any.
+func BuildRunReport(plan *Plan, modelOverride string) *RunReport {
+	report := &RunReport{
+		Plan:         plan.Name,
+		GeneratedAt:  time.Now(),
+		StatusCounts: make(map[JobStatus]int),
+	}
+
+	for _, job := range plan.Jobs {
+		entry := RunReportJob{
+			ID:         job.ID,
+			Filename:   job.Filename,
+			Title:      job.Title,
+			Type:       job.Type,
+			Status:     job.Status,
+			Duration:   job.Duration,
+			Model:      reportModel(job, plan, modelOverride),
+			OutputPath: jobOutputPath(job, plan),
+			Error:      job.LastError,
+		}
+		// ran_model records what a successful run actually used, which can differ
+		// from today's resolution if the plan or flags changed since
+		if job.Status == JobStatusCompleted && job.RanModel != "" {
+			entry.Model = job.RanModel
+		}
+		if entry.Duration == 0 && !job.StartTime.IsZero() && !job.EndTime.IsZero() {
+			entry.Duration = job.EndTime.Sub(job.StartTime)
+		}
+
+		report.Jobs = append(report.Jobs, entry)
+		report.StatusCounts[job.Status]++
+		report.TotalDuration += entry.Duration
+	}
+	return report
+}
+
+// reportModel returns the model an LLM job runs with, following the same
+// precedence as the oneshot executor. Jobs that don't call an LLM get "".
+func reportModel(job *Job, plan *Plan, modelOverride string) string {
+	switch job.Type {
+	case JobTypeShell, JobTypeFile:
+		return ""
+	}
+	_, model := ExplainModel(job, plan, modelOverride)
+	return model
+}
+
+// Markdown renders the report as a markdown document.
+func (r *RunReport) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Run Report: %s\n\n", r.Plan)
+	fmt.Fprintf(&b, "Generated: %s\n\n", r.GeneratedAt.Format(time.RFC3339))
+
+	b.WriteString("| Job | Status | Duration | Model | Output | Error |\n")
+	b.WriteString("|-----|--------|----------|-------|--------|-------|\n")
+	for _, j := range r.Jobs {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n",
+			markdownCell(j.Filename),
+			j.Status,
+			formatReportDuration(j.Duration),
+			markdownCell(orDash(j.Model)),
+			markdownCell(j.OutputPath),
+			markdownCell(orDash(j.Error)))
+	}
+
+	b.WriteString("\n## Totals\n\n")
+	fmt.Fprintf(&b, "- Jobs: %d\n", len(r.Jobs))
+	statuses := make([]string, 0, len(r.StatusCounts))
+	for status := range r.StatusCounts {
+		statuses = append(statuses, string(status))
+	}
+	sort.Strings(statuses)
+	for _, status := range statuses {
+		fmt.Fprintf(&b, "- %s: %d\n", status, r.StatusCounts[JobStatus(status)])
+	}
+	fmt.Fprintf(&b, "- Total duration: %s\n", formatReportDuration(r.TotalDuration))
+	return b.String()
+}
+
+// WriteRunReport writes the report to path as "markdown" or "json".
+func WriteRunReport(report *RunReport, path, format string) error {
+	var data []byte
+	switch format {
+	case "", "markdown", "md":
+		data = []byte(report.Markdown())
+	case "json":
+		var err error
+		data, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshaling report: %w", err)
+		}
+		data = append(data, '\n')
+	default:
+		return fmt.Errorf("unsupported report format '%s' (use markdown or json)", format)
+	}
+
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("creating report directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
+
+func formatReportDuration(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return d.Round(time.Second).String()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// markdownCell keeps a value on one table row.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.Join(strings.Fields(s), " ")
+}
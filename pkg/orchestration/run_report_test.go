@@ -0,0 +1,66 @@
+package orchestration
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunReport(t *testing.T) {
+	dir := t.TempDir()
+	plan := &Plan{
+		Name:      "my-plan",
+		Directory: dir,
+		Config:    &PlanConfig{Model: "plan-model"},
+		Jobs: []*Job{
+			{ID: "a", Filename: "01-a.md", FilePath: filepath.Join(dir, "01-a.md"), Type: JobTypeOneshot, Status: JobStatusCompleted, Duration: 2 * time.Second},
+			{ID: "b", Filename: "02-b.md", FilePath: filepath.Join(dir, "02-b.md"), Type: JobTypeShell, Status: JobStatusFailed, LastError: "exit status 1 | boom"},
+		},
+	}
+
+	report := BuildRunReport(plan, "")
+	if report.Failed() != 1 {
+		t.Errorf("Failed() = %d, want 1", report.Failed())
+	}
+	if report.Jobs[0].Model != "plan-model" || report.Jobs[1].Model != "" {
+		t.Errorf("unexpected models: %q, %q", report.Jobs[0].Model, report.Jobs[1].Model)
+	}
+	if report.TotalDuration != 2*time.Second {
+		t.Errorf("TotalDuration = %s, want 2s", report.TotalDuration)
+	}
+
+	md := report.Markdown()
+	for _, want := range []string{"| 01-a.md | completed | 2s | plan-model |", `exit status 1 \| boom`, "- failed: 1"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown report missing %q:\n%s", want, md)
+		}
+	}
+
+	path := filepath.Join(dir, "reports", "run.json")
+	if err := WriteRunReport(BuildRunReport(plan, "override"), path, "json"); err != nil {
+		t.Fatalf("WriteRunReport() error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded RunReport
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("invalid JSON report: %v", err)
+	}
+	if decoded.Jobs[0].Model != "override" {
+		t.Errorf("model override not applied: %q", decoded.Jobs[0].Model)
+	}
+
+	plan.Jobs[0].RanModel = "recorded-model"
+	if got := BuildRunReport(plan, "override").Jobs[0].Model; got != "recorded-model" {
+		t.Errorf("completed job model = %q, want the recorded ran_model", got)
+	}
+
+	if err := WriteRunReport(report, path, "xml"); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
@@ -74,6 +74,12 @@ func (e *ShellExecutor) Execute(ctx context.Context, job *Job, plan *Plan) error
 		workDir = ResolveWorkingDirectory(plan)
 	}
 
+	if err := ValidateJobWorkDir(workDir, job); err != nil {
+		job.Status = JobStatusFailed
+		job.EndTime = time.Now()
+		return err
+	}
+
 	// Scope to sub-project if job.Repository is set (for ecosystem worktrees)
 	workDir = ScopeToSubProject(workDir, job)
 
@@ -102,7 +108,7 @@ func (e *ShellExecutor) Execute(ctx context.Context, job *Job, plan *Plan) error
 	cmd.Dir = workDir
 
 	// Set up environment for better debugging
-	cmd.Env = append(os.Environ(),
+	cmd.Env = jobEnvironment(plan, job,
 		fmt.Sprintf("GROVE_PLAN_DIR=%s", plan.Directory),
 		fmt.Sprintf("GROVE_WORK_DIR=%s", workDir),
 	)
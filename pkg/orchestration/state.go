@@ -105,6 +105,20 @@ func (sp *StatePersister) UpdateJobStatus(job *Job, newStatus JobStatus) error {
 			"updated_at": time.Now().Format(time.RFC3339),
 		}
 
+		// Only blocked and skipped jobs carry a reason; any other status clears it
+		if newStatus == JobStatusBlocked {
+			updates["blocked_reason"] = job.BlockedReason
+		} else {
+			updates["blocked_reason"] = ""
+			job.BlockedReason = ""
+		}
+		if newStatus == JobStatusSkipped {
+			updates["skip_reason"] = job.SkipReason
+		} else {
+			updates["skip_reason"] = ""
+			job.SkipReason = ""
+		}
+
 		// Add started_at for running status
 		if newStatus == JobStatusRunning && job.StartTime.IsZero() {
 			updates["started_at"] = time.Now().Format(time.RFC3339)
@@ -432,6 +446,12 @@ func (fl *FileLock) Unlock() error {
 // Atomic file operations
 
 func (sp *StatePersister) writeAtomic(path string, content []byte) error {
+	return writeFileAtomic(path, content)
+}
+
+// writeFileAtomic writes content to a temp file in path's directory and renames
+// it over path, so a crash mid-write never leaves a truncated file behind.
+func writeFileAtomic(path string, content []byte) error {
 	// Get current file permissions if file exists
 	var perm os.FileMode = 0644
 	if info, err := os.Stat(path); err == nil {
@@ -518,7 +538,7 @@ func isValidStatus(status JobStatus) bool {
 	case JobStatusPending, JobStatusRunning, JobStatusCompleted,
 		JobStatusFailed, JobStatusBlocked, JobStatusNeedsReview,
 		JobStatusPendingUser, JobStatusPendingLLM, JobStatusAbandoned,
-		JobStatusHold, JobStatusTodo, JobStatusIdle:
+		JobStatusHold, JobStatusTodo, JobStatusIdle, JobStatusSkipped:
 		return true
 	}
 	return false
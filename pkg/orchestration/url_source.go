@@ -0,0 +1,153 @@
+package orchestration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultFetchTimeout bounds each download of a URL prompt source.
+const defaultFetchTimeout = 30 * time.Second
+
+// IsURLSource reports whether a prompt source is an http(s) URL to fetch at
+// run time rather than a file path.
+func IsURLSource(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+// urlSourceCache holds the files URL prompt sources were downloaded to during
+// this process, so jobs in the same run that include the same URL share one
+// download.
+type urlSourceCache struct {
+	mu    sync.Mutex
+	dir   string
+	files map[string]string
+}
+
+var fetchedSources = &urlSourceCache{files: make(map[string]string)}
+
+// fetchURLSource downloads rawURL into a temporary file and returns its path.
+// The plan's FetchTimeout bounds the download, and Offline refuses to fetch.
+// With NoFetch, an earlier download is reused if there is one and the URL is
+// returned unchanged otherwise.
+func fetchURLSource(rawURL string, plan *Plan) (string, error) {
+	timeout := defaultFetchTimeout
+	noFetch := false
+	if plan != nil && plan.Orchestration != nil {
+		noFetch = plan.Orchestration.NoFetch
+		if plan.Orchestration.Offline && !noFetch {
+			return "", fmt.Errorf("prompt source %s needs to be fetched but --offline is set", rawURL)
+		}
+		if plan.Orchestration.FetchTimeout > 0 {
+			timeout = plan.Orchestration.FetchTimeout
+		}
+	}
+
+	// Held for the whole download so concurrent jobs don't fetch the same URL twice
+	fetchedSources.mu.Lock()
+	defer fetchedSources.mu.Unlock()
+
+	if file, ok := fetchedSources.files[rawURL]; ok {
+		if _, err := os.Stat(file); err == nil {
+			return file, nil
+		}
+	}
+	if noFetch {
+		return rawURL, nil
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing prompt source URL %s: %w", rawURL, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("creating request for %s: %w", rawURL, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetching prompt source %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("fetching prompt source %s: server returned %s", rawURL, resp.Status)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading prompt source %s: %w", rawURL, err)
+	}
+
+	if fetchedSources.dir == "" {
+		dir, err := os.MkdirTemp("", "grove-flow-sources-")
+		if err != nil {
+			return "", fmt.Errorf("creating directory for fetched sources: %w", err)
+		}
+		fetchedSources.dir = dir
+	}
+	file := filepath.Join(fetchedSources.dir, urlSourceFilename(parsed, rawURL))
+	if err := os.WriteFile(file, content, 0o644); err != nil {
+		return "", fmt.Errorf("writing fetched prompt source %s: %w", rawURL, err)
+	}
+	fetchedSources.files[rawURL] = file
+	return file, nil
+}
+
+// RemoveFetchedSources deletes the files URL prompt sources were downloaded to
+// during this process. Call it once the run that needed them is over.
+func RemoveFetchedSources() error {
+	fetchedSources.mu.Lock()
+	defer fetchedSources.mu.Unlock()
+
+	if fetchedSources.dir == "" {
+		return nil
+	}
+	if err := os.RemoveAll(fetchedSources.dir); err != nil {
+		return fmt.Errorf("removing fetched prompt sources: %w", err)
+	}
+	fetchedSources.dir = ""
+	fetchedSources.files = make(map[string]string)
+	return nil
+}
+
+// withoutURLFetches returns a shallow copy of plan whose URL prompt sources are
+// not fetched (see Config.NoFetch). Prompts name include files rather than
+// embedding them, so a prompt assembled this way matches the one sent at run
+// time.
+func withoutURLFetches(plan *Plan) *Plan {
+	copied := *plan
+	var config Config
+	if plan.Orchestration != nil {
+		config = *plan.Orchestration
+	}
+	config.NoFetch = true
+	copied.Orchestration = &config
+	return &copied
+}
+
+// urlSourceFilename names the download after the last path segment so the
+// attachment keeps a recognizable name and extension, prefixed with a hash of
+// the full URL to keep different URLs apart.
+func urlSourceFilename(parsed *url.URL, rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	base := path.Base(parsed.Path)
+	if base == "." || base == "/" || base == "" {
+		base = parsed.Hostname()
+	}
+	if path.Ext(base) == "" {
+		base += ".txt"
+	}
+	return hex.EncodeToString(sum[:4]) + "-" + base
+}
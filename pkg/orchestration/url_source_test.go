@@ -0,0 +1,95 @@
+package orchestration
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestResolvePromptSource_URL(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/missing.md" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "# Runbook\n")
+	}))
+	defer server.Close()
+
+	plan := &Plan{Directory: t.TempDir(), Orchestration: &Config{}}
+
+	first, err := ResolvePromptSource(server.URL+"/docs/runbook.md", plan)
+	if err != nil {
+		t.Fatalf("ResolvePromptSource() error = %v", err)
+	}
+	if !strings.HasSuffix(filepath.Base(first), "-runbook.md") {
+		t.Errorf("fetched file %s should keep the URL's filename", first)
+	}
+	content, err := os.ReadFile(first)
+	if err != nil || string(content) != "# Runbook\n" {
+		t.Errorf("fetched content = %q, %v", content, err)
+	}
+
+	second, err := ResolvePromptSource(server.URL+"/docs/runbook.md", plan)
+	if err != nil || second != first {
+		t.Errorf("second resolve = %s, %v; want cached %s", second, err, first)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("server saw %d requests, want 1", n)
+	}
+
+	if _, err := ResolvePromptSource(server.URL+"/missing.md", plan); err == nil {
+		t.Error("expected an error for a 404 response")
+	}
+
+	offline := &Plan{Directory: plan.Directory, Orchestration: &Config{Offline: true}}
+	if _, err := ResolvePromptSource(server.URL+"/other.md", offline); err == nil || !strings.Contains(err.Error(), "--offline") {
+		t.Errorf("expected --offline error, got %v", err)
+	}
+}
+
+func TestResolvePromptSource_URLNoFetch(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		fmt.Fprint(w, "# Notes\n")
+	}))
+	defer server.Close()
+	defer RemoveFetchedSources()
+
+	source := server.URL + "/notes.md"
+	plan := &Plan{Directory: t.TempDir(), Orchestration: &Config{}}
+	inspect := withoutURLFetches(plan)
+
+	if got, err := ResolvePromptSource(source, inspect); err != nil || got != source {
+		t.Errorf("before any download = %s, %v; want the URL unchanged", got, err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Fatalf("server saw %d requests, want none", n)
+	}
+	if plan.Orchestration.NoFetch {
+		t.Error("withoutURLFetches should not modify the original plan")
+	}
+
+	fetched, err := ResolvePromptSource(source, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ResolvePromptSource(source, inspect); err != nil || got != fetched {
+		t.Errorf("after the download = %s, %v; want %s", got, err, fetched)
+	}
+
+	if err := RemoveFetchedSources(); err != nil {
+		t.Fatalf("RemoveFetchedSources() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Dir(fetched)); !os.IsNotExist(err) {
+		t.Errorf("fetched sources directory still exists: %v", err)
+	}
+}
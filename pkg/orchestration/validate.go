@@ -0,0 +1,257 @@
+package orchestration
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidationProblem is a single issue found while validating a plan.
+type ValidationProblem struct {
+	File    string `json:"file,omitempty"` // Job filename; empty for plan-level problems
+	Message string `json:"message"`
+}
+
+// jobSchemaData is flow-job.schema.json, generated from Job by `make schema`.
+//
+//go:embed flow-job.schema.json
+var jobSchemaData []byte
+
+var (
+	jobSchemaOnce sync.Once
+	jobSchema     *jsonschema.Schema
+	jobSchemaErr  error
+)
+
+// compiledJobSchema compiles the embedded job schema on first use.
+func compiledJobSchema() (*jsonschema.Schema, error) {
+	jobSchemaOnce.Do(func() {
+		compiler := jsonschema.NewCompiler()
+		if err := compiler.AddResource("flow-job.schema.json", bytes.NewReader(jobSchemaData)); err != nil {
+			jobSchemaErr = fmt.Errorf("loading job schema: %w", err)
+			return
+		}
+		jobSchema, jobSchemaErr = compiler.Compile("flow-job.schema.json")
+	})
+	return jobSchema, jobSchemaErr
+}
+
+// ValidatePlanDir checks every job file in dir without running anything: the
+// frontmatter against the Job schema, depends_on references, template names, and
+// include paths. Problems are returned sorted by file; the error is reserved for
+// failures to read the directory itself.
+func ValidatePlanDir(dir string) ([]ValidationProblem, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading plan directory: %w", err)
+	}
+
+	var problems []ValidationProblem
+	seenIDs := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+		for _, msg := range validateJobFrontmatter(filepath.Join(dir, entry.Name()), seenIDs) {
+			problems = append(problems, ValidationProblem{File: entry.Name(), Message: msg})
+		}
+	}
+
+	plan, err := LoadPlan(dir)
+	if err != nil {
+		// Frontmatter problems already explain most load failures; only report
+		// what they don't, such as dependency cycles.
+		if len(problems) == 0 {
+			problems = append(problems, ValidationProblem{Message: err.Error()})
+		}
+		return sortProblems(problems), nil
+	}
+
+	templateManager := NewTemplateManager()
+	for _, job := range plan.Jobs {
+		for i, dep := range job.Dependencies {
+			if dep == nil {
+				problems = append(problems, ValidationProblem{
+					File:    job.Filename,
+					Message: fmt.Sprintf("depends_on: %q does not match any job ID or filename in the plan", job.DependsOn[i]),
+				})
+			}
+		}
+
+		if job.RunIf != nil && job.RunIf.Job != "" {
+			if _, err := job.RunIf.dependency(job); err != nil {
+				problems = append(problems, ValidationProblem{File: job.Filename, Message: err.Error()})
+			}
+		}
+
+		if job.Template != "" {
+			if _, err := templateManager.FindTemplate(job.Template); err != nil {
+				problems = append(problems, ValidationProblem{
+					File:    job.Filename,
+					Message: fmt.Sprintf("template: %q not found", job.Template),
+				})
+			}
+		}
+
+		for _, source := range job.Include {
+			if !includeResolves(source, plan) {
+				problems = append(problems, ValidationProblem{
+					File:    job.Filename,
+					Message: fmt.Sprintf("include: %q could not be resolved", source),
+				})
+			}
+		}
+	}
+
+	return sortProblems(problems), nil
+}
+
+// validateJobFrontmatter checks a single file's frontmatter. Files without a
+// type field are not jobs and are skipped.
+func validateJobFrontmatter(path string, seenIDs map[string]string) []string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return []string{fmt.Sprintf("reading file: %v", err)}
+	}
+	frontmatter, _, err := ParseFrontmatter(content)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid frontmatter: %v", err)}
+	}
+	if typeField, ok := frontmatter["type"]; !ok || typeField == nil {
+		return nil
+	}
+
+	problems := validateAgainstJobSchema(frontmatter)
+
+	yamlBytes, err := yaml.Marshal(frontmatter)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid frontmatter: %v", err)}
+	}
+	var job Job
+	if err := yaml.Unmarshal(yamlBytes, &job); err != nil {
+		// The schema already explains most decoding failures
+		if len(problems) > 0 {
+			return problems
+		}
+		var typeErr *yaml.TypeError
+		if errors.As(err, &typeErr) {
+			return typeErr.Errors
+		}
+		return []string{fmt.Sprintf("invalid frontmatter: %v", err)}
+	}
+
+	if job.ID == "" {
+		problems = append(problems, "id: required field is missing")
+	} else if other, dup := seenIDs[job.ID]; dup {
+		problems = append(problems, fmt.Sprintf("id: %q is already used by %s", job.ID, other))
+	} else {
+		seenIDs[job.ID] = filepath.Base(path)
+	}
+	if job.Title == "" {
+		problems = append(problems, "title: required field is missing")
+	}
+	if job.Status == "" {
+		problems = append(problems, "status: required field is missing")
+	}
+
+	if job.RetryCount != nil && *job.RetryCount < 0 {
+		problems = append(problems, fmt.Sprintf("retry_count: must be 0 or greater, got %d", *job.RetryCount))
+	}
+
+	if job.Output.Type == OutputTypeAppendTo && job.Output.Target == "" {
+		problems = append(problems, fmt.Sprintf("output.target: required when output.type is %q", OutputTypeAppendTo))
+	}
+	if job.Output.Path != "" {
+		planDir := filepath.Dir(path)
+		if _, err := renderOutputPath(&job, &Plan{Name: filepath.Base(planDir), Directory: planDir}); err != nil {
+			problems = append(problems, fmt.Sprintf("output.path: %v", err))
+		}
+	}
+
+	if job.RunIf != nil {
+		if err := job.RunIf.Validate(); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	return problems
+}
+
+// validateAgainstJobSchema checks frontmatter against the embedded job schema,
+// returning one "field: message" problem per failing value.
+func validateAgainstJobSchema(frontmatter map[string]interface{}) []string {
+	schema, err := compiledJobSchema()
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	// Round-trip through JSON so values have the types the validator expects
+	data, err := json.Marshal(frontmatter)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid frontmatter: %v", err)}
+	}
+	var instance interface{}
+	if err := json.Unmarshal(data, &instance); err != nil {
+		return []string{fmt.Sprintf("invalid frontmatter: %v", err)}
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if err := schema.Validate(instance); err == nil {
+		return nil
+	} else if !errors.As(err, &validationErr) {
+		return []string{err.Error()}
+	}
+
+	var problems []string
+	var collect func(*jsonschema.ValidationError)
+	collect = func(ve *jsonschema.ValidationError) {
+		if len(ve.Causes) > 0 {
+			for _, cause := range ve.Causes {
+				collect(cause)
+			}
+			return
+		}
+		field := strings.ReplaceAll(strings.TrimPrefix(ve.InstanceLocation, "/"), "/", ".")
+		if field == "type" {
+			problems = append(problems, fmt.Sprintf("type: unknown job type %q; the file is ignored by the plan", frontmatter["type"]))
+			return
+		}
+		problems = append(problems, fmt.Sprintf("%s: %s", field, ve.Message))
+	}
+	collect(validationErr)
+	return problems
+}
+
+// includeResolves reports whether an include entry can be found, trying the
+// project root first as the executors do, then ResolvePromptSource. URLs are
+// not fetched.
+func includeResolves(source string, plan *Plan) bool {
+	// URLs are only fetched at run time
+	if IsURLSource(source) {
+		return true
+	}
+	if !filepath.IsAbs(source) {
+		if _, err := os.Stat(filepath.Join(GetProjectRootSafe(plan.Directory), source)); err == nil {
+			return true
+		}
+	}
+	_, err := ResolvePromptSource(source, plan)
+	return err == nil
+}
+
+func sortProblems(problems []ValidationProblem) []ValidationProblem {
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].File < problems[j].File
+	})
+	return problems
+}
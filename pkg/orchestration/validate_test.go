@@ -0,0 +1,90 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidatePlanDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"01-ok.md":      "---\nid: ok\ntitle: OK\nstatus: pending\ntype: oneshot\n---\nBody\n",
+		"02-deps.md":    "---\nid: deps\ntitle: Deps\nstatus: pending\ntype: oneshot\ndepends_on:\n  - 99-missing.md\ninclude:\n  - does/not/exist.go\n---\nBody\n",
+		"03-typo.md":    "---\nid: typo\ntitle: Typo\nstatus: pending\ntype: oneshoot\n---\nBody\n",
+		"04-output.md":  "---\nid: out\ntitle: Out\nstatus: pending\ntype: oneshot\noutput:\n  type: append-to\n---\nBody\n",
+		"notes.md":      "Just notes, not a job.\n",
+		"05-timeout.md": "---\nid: slow\ntitle: Slow\nstatus: pending\ntype: oneshot\ntimeout: 10m\n---\nBody\n",
+		"06-path.md":    "---\nid: path\ntitle: Path\nstatus: pending\ntype: oneshot\noutput:\n  path: ../{{.ID}}.md\n---\nBody\n",
+		"07-inline.md":  "---\nid: inline\ntitle: Inline\nstatus: pending\ntype: oneshot\ninline: all\n---\nBody\n",
+		"08-retry.md":   "---\nid: retry\ntitle: Retry\nstatus: pending\ntype: oneshot\nretry_count: -1\n---\nBody\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	problems, err := ValidatePlanDir(dir)
+	if err != nil {
+		t.Fatalf("ValidatePlanDir() error = %v", err)
+	}
+
+	byFile := make(map[string][]string)
+	for _, p := range problems {
+		byFile[p.File] = append(byFile[p.File], p.Message)
+	}
+
+	expect := map[string]string{
+		"02-deps.md":   "99-missing.md",
+		"03-typo.md":   "unknown job type",
+		"04-output.md": "output.target",
+		"06-path.md":   "output.path",
+		"08-retry.md":  "retry_count",
+	}
+
+	for file, fragment := range expect {
+		found := false
+		for _, msg := range byFile[file] {
+			if strings.Contains(msg, fragment) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected a problem mentioning %q for %s, got %v", fragment, file, byFile[file])
+		}
+	}
+	if len(byFile["02-deps.md"]) != 2 {
+		t.Errorf("expected dependency and include problems for 02-deps.md, got %v", byFile["02-deps.md"])
+	}
+	for _, clean := range []string{"01-ok.md", "05-timeout.md", "07-inline.md", "notes.md"} {
+		if len(byFile[clean]) > 0 {
+			t.Errorf("unexpected problems for %s: %v", clean, byFile[clean])
+		}
+	}
+}
+
+func TestValidatePlanDir_SchemaEnums(t *testing.T) {
+	dir := t.TempDir()
+	content := "---\nid: enums\ntitle: Enums\nstatus: waiting\ntype: oneshot\nprompt_overflow: shrink\noutput:\n  type: email\n---\nBody\n"
+	if err := os.WriteFile(filepath.Join(dir, "01-enums.md"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	problems, err := ValidatePlanDir(dir)
+	if err != nil {
+		t.Fatalf("ValidatePlanDir() error = %v", err)
+	}
+	for _, field := range []string{"status:", "prompt_overflow:", "output.type:"} {
+		found := false
+		for _, p := range problems {
+			if strings.HasPrefix(p.Message, field) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected a schema problem for %s, got %v", field, problems)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package orchestration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// matrixDirName holds the per-worktree copies of a plan made for matrix runs.
+const matrixDirName = ".matrix"
+
+// MatrixPlanDir returns the directory holding a plan's copy for worktree.
+func MatrixPlanDir(plan *Plan, worktree string) string {
+	return filepath.Join(plan.Directory, matrixDirName, worktree)
+}
+
+// PrepareMatrixPlan returns the copy of src that runs against worktree. Job
+// files are copied into MatrixPlanDir, with pending jobs pointed at worktree;
+// jobs that already finished keep their status and output so dependents can
+// use them. Copies that have not started in this worktree are regenerated on
+// every call so edits to the source plan reach them, while copies that have
+// run are kept, so re-running the matrix resumes each worktree where it
+// stopped and every worktree's output lands in its own job files.
+func PrepareMatrixPlan(src *Plan, worktree string) (*Plan, error) {
+	if worktree == "" || filepath.Base(worktree) != worktree {
+		return nil, fmt.Errorf("invalid worktree name %q", worktree)
+	}
+	dir := MatrixPlanDir(src, worktree)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating matrix plan directory: %w", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, ".grove-plan.yml")); os.IsNotExist(err) {
+		if err := clonePlanConfig(src.Directory, dir, worktree); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, job := range src.Jobs {
+		destPath := filepath.Join(dir, job.Filename)
+		if matrixCopyStarted(destPath) {
+			continue
+		}
+		content, err := os.ReadFile(job.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading job file %s: %w", job.Filename, err)
+		}
+		if job.Status == JobStatusPending || job.Status == "" {
+			content, err = UpdateFrontmatter(content, map[string]interface{}{
+				"worktree": worktree,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("updating frontmatter for %s: %w", job.Filename, err)
+			}
+		}
+		if err := os.WriteFile(destPath, content, 0o644); err != nil {
+			return nil, fmt.Errorf("writing job file %s: %w", job.Filename, err)
+		}
+	}
+
+	plan, err := LoadPlan(dir)
+	if err != nil {
+		return nil, fmt.Errorf("loading matrix plan for %s: %w", worktree, err)
+	}
+	plan.Name = fmt.Sprintf("%s@%s", src.Name, worktree)
+	plan.Orchestration = src.Orchestration
+	return plan, nil
+}
+
+// matrixCopyStarted reports whether the job copy at path exists and has left
+// the pending status, meaning it holds progress that must not be overwritten.
+func matrixCopyStarted(path string) bool {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	frontmatter, _, err := ParseFrontmatter(content)
+	if err != nil {
+		return true
+	}
+	status, _ := frontmatter["status"].(string)
+	return status != "" && JobStatus(status) != JobStatusPending
+}
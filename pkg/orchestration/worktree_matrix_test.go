@@ -0,0 +1,70 @@
+package orchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPrepareMatrixPlan(t *testing.T) {
+	src := loadTestPlan(t, map[string]string{
+		".grove-plan.yml": "model: gemini-2.5-pro\nworktrees: [a, b]\n",
+		"01-design.md":    "---\nid: design-1234\ntitle: Design\nstatus: completed\ntype: oneshot\n---\nDesign it.\n\n---\n\n## Output\n\nThe design.\n",
+		"02-build.md":     "---\nid: build-5678\ntitle: Build\nstatus: pending\ntype: oneshot\ndepends_on:\n  - design-1234\n---\nBuild it.\n",
+	})
+
+	plan, err := PrepareMatrixPlan(src, "a")
+	if err != nil {
+		t.Fatalf("PrepareMatrixPlan() error = %v", err)
+	}
+	if plan.Directory != MatrixPlanDir(src, "a") || plan.Name != src.Name+"@a" {
+		t.Errorf("matrix plan = %s (%s), want %s (%s@a)", plan.Directory, plan.Name, MatrixPlanDir(src, "a"), src.Name)
+	}
+	if plan.Config.Worktree != "a" {
+		t.Errorf("plan worktree = %q, want a", plan.Config.Worktree)
+	}
+
+	design, _ := plan.GetJobByID("design-1234")
+	build, _ := plan.GetJobByID("build-5678")
+	if design.Status != JobStatusCompleted || design.Worktree != "" {
+		t.Errorf("completed job should be copied unchanged, got status %s worktree %q", design.Status, design.Worktree)
+	}
+	if build.Status != JobStatusPending || build.Worktree != "a" {
+		t.Errorf("pending job should target worktree a, got status %s worktree %q", build.Status, build.Worktree)
+	}
+
+	// Copies that have not started pick up edits to the source plan
+	srcBuild, _ := src.GetJobByID("build-5678")
+	srcContent, _ := os.ReadFile(srcBuild.FilePath)
+	edited := strings.Replace(string(srcContent), "Build it.", "Build it carefully.", 1)
+	if err := os.WriteFile(srcBuild.FilePath, []byte(edited), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	plan, err = PrepareMatrixPlan(src, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if build, _ := plan.GetJobByID("build-5678"); !strings.Contains(build.PromptBody, "Build it carefully.") || build.Worktree != "a" {
+		t.Errorf("pending copy was not regenerated: prompt %q, worktree %q", build.PromptBody, build.Worktree)
+	}
+
+	// Copies that have run are reused so progress in a worktree survives re-runs
+	buildPath := filepath.Join(plan.Directory, "02-build.md")
+	content, _ := os.ReadFile(buildPath)
+	updated := strings.Replace(string(content), "status: pending", "status: completed", 1)
+	if err := os.WriteFile(buildPath, []byte(updated), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	plan, err = PrepareMatrixPlan(src, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if build, _ := plan.GetJobByID("build-5678"); build.Status != JobStatusCompleted {
+		t.Errorf("re-prepared job status = %s, want completed", build.Status)
+	}
+
+	if _, err := PrepareMatrixPlan(src, "../escape"); err == nil {
+		t.Error("expected an error for a worktree name containing a path separator")
+	}
+}
@@ -0,0 +1,113 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// worktreeState describes what already existed for a worktree before it was
+// prepared.
+type worktreeState int
+
+const (
+	worktreeNew            worktreeState = iota // Neither the worktree nor its branch exists
+	worktreeExistingBranch                      // The branch exists but no worktree is attached to it
+	worktreeExisting                            // A worktree for the branch is registered
+)
+
+// inspectWorktree reports which worktreeState applies to
+// <gitRoot>/.grove-worktrees/<name> with the given branch, so callers can say
+// what GetOrPrepareWorktree is about to do. Before a worktree is created, it
+// makes sure the directory can be used: if it exists with files in it but is
+// not a registered git worktree, git would refuse to create one there with a
+// less helpful message.
+func inspectWorktree(ctx context.Context, gitRoot, name, branch string) (worktreeState, error) {
+	worktreePath := filepath.Join(gitRoot, ".grove-worktrees", name)
+	if branch == "" {
+		branch = name
+	}
+
+	worktrees, err := listGitWorktrees(ctx, gitRoot)
+	if err != nil {
+		// Not a usable repository; leave it to the regular preparation to report
+		return worktreeNew, nil
+	}
+	for path, checkedOut := range worktrees {
+		if _, err := os.Stat(path); err != nil {
+			continue // stale registration, removed by GetOrPrepareWorktree
+		}
+		if samePath(path, worktreePath) || checkedOut == branch {
+			return worktreeExisting, nil
+		}
+	}
+
+	if entries, err := os.ReadDir(worktreePath); err == nil && len(entries) > 0 {
+		return worktreeNew, fmt.Errorf("%s exists but is not a registered git worktree; remove the directory and retry", worktreePath)
+	}
+	if err := exec.CommandContext(ctx, "git", "-C", gitRoot, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch).Run(); err == nil {
+		return worktreeExistingBranch, nil
+	}
+	return worktreeNew, nil
+}
+
+// listGitWorktrees returns the repository's worktrees as a map of path to
+// checked-out branch name ("" for a detached HEAD).
+func listGitWorktrees(ctx context.Context, gitRoot string) (map[string]string, error) {
+	out, err := exec.CommandContext(ctx, "git", "-C", gitRoot, "worktree", "list", "--porcelain").Output()
+	if err != nil {
+		return nil, fmt.Errorf("listing git worktrees: %w", err)
+	}
+
+	worktrees := make(map[string]string)
+	var current string
+	for _, line := range strings.Split(string(out), "\n") {
+		if path, ok := strings.CutPrefix(line, "worktree "); ok {
+			current = path
+			worktrees[current] = ""
+		} else if branch, ok := strings.CutPrefix(line, "branch "); ok && current != "" {
+			worktrees[current] = strings.TrimPrefix(branch, "refs/heads/")
+		}
+	}
+	return worktrees, nil
+}
+
+// samePath reports whether a and b name the same location, resolving symlinks
+// where possible.
+func samePath(a, b string) bool {
+	if ra, err := filepath.EvalSymlinks(a); err == nil {
+		a = ra
+	}
+	if rb, err := filepath.EvalSymlinks(b); err == nil {
+		b = rb
+	}
+	return filepath.Clean(a) == filepath.Clean(b)
+}
+
+// logWorktreePrepared tells the user whether the worktree at path was newly
+// created, created for a branch left behind by an earlier run, or reused.
+func logWorktreePrepared(ctx context.Context, state worktreeState, name, path string) {
+	switch state {
+	case worktreeExisting:
+		ulog.Info("Reusing existing worktree").
+			Field("worktree", name).
+			Field("path", path).
+			Pretty(fmt.Sprintf("› Reusing existing worktree '%s' at %s", name, path)).
+			Log(ctx)
+	case worktreeExistingBranch:
+		ulog.Info("Created worktree for existing branch").
+			Field("worktree", name).
+			Field("path", path).
+			Pretty(fmt.Sprintf("› Branch '%s' already existed; created a worktree for it at %s", name, path)).
+			Log(ctx)
+	default:
+		ulog.Info("Created new worktree").
+			Field("worktree", name).
+			Field("path", path).
+			Pretty(fmt.Sprintf("› Created worktree '%s' at %s", name, path)).
+			Log(ctx)
+	}
+}
@@ -0,0 +1,69 @@
+package orchestration
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestInspectWorktree(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	ctx := context.Background()
+	root := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", root}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	git("init", "-q")
+	git("commit", "-q", "--allow-empty", "-m", "initial")
+
+	inspect := func(name string) worktreeState {
+		t.Helper()
+		state, err := inspectWorktree(ctx, root, name, "")
+		if err != nil {
+			t.Fatalf("inspectWorktree(%s) error = %v", name, err)
+		}
+		return state
+	}
+
+	// Nothing there yet
+	if got := inspect("feature"); got != worktreeNew {
+		t.Errorf("missing worktree and branch: state = %v, want worktreeNew", got)
+	}
+
+	// A branch left behind by an aborted run, without its worktree
+	git("branch", "leftover")
+	if got := inspect("leftover"); got != worktreeExistingBranch {
+		t.Errorf("branch only: state = %v, want worktreeExistingBranch", got)
+	}
+
+	// A registered worktree is fine to reuse
+	worktreePath := filepath.Join(root, ".grove-worktrees", "feature")
+	git("worktree", "add", "-q", "-b", "feature", worktreePath)
+	if got := inspect("feature"); got != worktreeExisting {
+		t.Errorf("registered worktree: state = %v, want worktreeExisting", got)
+	}
+
+	// Leftover files in an unregistered directory would block git
+	strayPath := filepath.Join(root, ".grove-worktrees", "stray")
+	if err := os.MkdirAll(strayPath, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if got := inspect("stray"); got != worktreeNew {
+		t.Errorf("empty directory: state = %v, want worktreeNew", got)
+	}
+	if err := os.WriteFile(filepath.Join(strayPath, "file.txt"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := inspectWorktree(ctx, root, "stray", ""); err == nil {
+		t.Fatal("expected an error for an unregistered, non-empty directory")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"log"
 	"os"
+	"unicode"
 
 	"github.com/invopop/jsonschema"
 	"github.com/grovetools/flow/cmd"
@@ -44,14 +45,63 @@ func main() {
 	// Make all fields optional - Job frontmatter should not require all fields
 	jobSchema.Required = nil
 
+	// Document the accepted values of enum-like fields; the reflector only sees
+	// a string type. `flow plan validate` checks job files against these.
+	setEnum := func(schema *jsonschema.Schema, name string, values ...string) {
+		if prop, ok := schema.Properties.Get(name); ok {
+			for _, v := range values {
+				prop.Enum = append(prop.Enum, v)
+			}
+		}
+	}
+	for _, s := range orchestration.JobStatuses() {
+		setEnum(jobSchema, "status", string(s))
+	}
+	for _, t := range orchestration.JobTypes() {
+		setEnum(jobSchema, "type", string(t))
+	}
+	setEnum(jobSchema, "prompt_overflow", orchestration.PromptOverflowStrategies()...)
+	if output, ok := jobSchema.Definitions["OutputConfig"]; ok {
+		setEnum(output, "type", orchestration.OutputTypes()...)
+	}
+
+	// Derived fields (Filename, Dependencies, ...) have no yaml tag and never
+	// appear in frontmatter
+	for pair := jobSchema.Properties.Oldest(); pair != nil; {
+		next := pair.Next()
+		if name := pair.Key; name != "" && unicode.IsUpper(rune(name[0])) {
+			jobSchema.Properties.Delete(name)
+		}
+		pair = next
+	}
+	delete(jobSchema.Definitions, "JobMetadata")
+
+	// Durations are written as strings such as "10m"
+	for _, name := range []string{"timeout", "duration"} {
+		if prop, ok := jobSchema.Properties.Get(name); ok {
+			prop.Type = "string"
+		}
+	}
+
+	// inline accepts a shorthand string or a list of categories
+	jobSchema.Properties.Set("inline", &jsonschema.Schema{
+		AnyOf: []*jsonschema.Schema{
+			{Type: "string"},
+			{Type: "array", Items: &jsonschema.Schema{Type: "string"}},
+		},
+	})
+	delete(jobSchema.Definitions, "InlineConfig")
+
 	jobData, err := json.MarshalIndent(jobSchema, "", "  ")
 	if err != nil {
 		log.Fatalf("Error marshaling job schema: %v", err)
 	}
 
-	// Write to the package root
-	if err := os.WriteFile("flow-job.schema.json", jobData, 0644); err != nil {
-		log.Fatalf("Error writing job schema file: %v", err)
+	// Write to the package root, and next to the validator that embeds it
+	for _, path := range []string{"flow-job.schema.json", "pkg/orchestration/flow-job.schema.json"} {
+		if err := os.WriteFile(path, jobData, 0644); err != nil {
+			log.Fatalf("Error writing job schema file: %v", err)
+		}
 	}
 
 	log.Printf("Successfully generated job schema at flow-job.schema.json")